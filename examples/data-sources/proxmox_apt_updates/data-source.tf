@@ -0,0 +1,15 @@
+data "proxmox_apt_updates" "pve" {
+  node = "pve"
+}
+
+output "pending_update_count" {
+  value = length(data.proxmox_apt_updates.pve.updates)
+}
+
+# Fail the plan if the node has pending updates
+check "node_up_to_date" {
+  assert {
+    condition     = length(data.proxmox_apt_updates.pve.updates) == 0
+    error_message = "Node pve has ${length(data.proxmox_apt_updates.pve.updates)} pending package updates."
+  }
+}
@@ -0,0 +1,9 @@
+data "proxmox_cluster_resources" "all" {}
+
+data "proxmox_cluster_resources" "guests" {
+  type = "vm"
+}
+
+output "running_guests" {
+  value = [for r in data.proxmox_cluster_resources.guests.resources : r.name if r.status == "running"]
+}
@@ -28,6 +28,23 @@ data "proxmox_files" "all_files" {
   datastore_id = "local"
 }
 
+# Find the newest backup of a VM
+data "proxmox_files" "backups" {
+  node_name    = "pve"
+  datastore_id = "local"
+  content_type = "backup"
+}
+
+locals {
+  vm_backups = [for f in data.proxmox_files.backups.files : f if f.vmid == 100]
+
+  # RFC3339 timestamps in UTC sort chronologically
+  latest_backup_id = length(local.vm_backups) > 0 ? [
+    for f in local.vm_backups : f.id
+    if f.creation_time == reverse(sort([for b in local.vm_backups : b.creation_time]))[0]
+  ][0] : null
+}
+
 output "iso_file_count" {
   value = length(data.proxmox_files.iso_files.files)
 }
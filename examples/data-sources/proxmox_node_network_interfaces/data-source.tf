@@ -0,0 +1,19 @@
+# List all network interfaces on a node
+data "proxmox_node_network_interfaces" "all" {
+  node_name = "pve"
+}
+
+# List only the physical NICs
+data "proxmox_node_network_interfaces" "nics" {
+  node_name = "pve"
+  type      = "eth"
+}
+
+# Physical NICs that are not enslaved to a bond yet
+locals {
+  bonds     = [for i in data.proxmox_node_network_interfaces.all.interfaces : i if i.type == "bond"]
+  free_nics = [
+    for i in data.proxmox_node_network_interfaces.nics.interfaces : i.iface
+    if !contains(flatten(local.bonds[*].bond_slaves), i.iface)
+  ]
+}
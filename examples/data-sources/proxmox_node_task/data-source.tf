@@ -0,0 +1,11 @@
+# wait for a backup started by a script, e.g. `vzdump 100 --storage local`
+data "proxmox_node_task" "backup" {
+  node_name = "pve"
+  upid      = var.backup_upid
+  wait      = true
+  timeout   = 3600
+}
+
+output "backup_succeeded" {
+  value = data.proxmox_node_task.backup.exit_status == "OK"
+}
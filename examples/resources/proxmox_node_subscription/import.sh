@@ -0,0 +1,3 @@
+#!/usr/bin/env sh
+# Node subscription is per-node and can be imported by node name:
+terraform import proxmox_node_subscription.example pve
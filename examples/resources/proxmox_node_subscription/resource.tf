@@ -0,0 +1,4 @@
+resource "proxmox_node_subscription" "example" {
+  node_name = "pve"
+  key       = "pve4b-1234567890"
+}
@@ -0,0 +1,3 @@
+#!/usr/bin/env sh
+# AD realms can be imported using the realm identifier, e.g.:
+terraform import proxmox_realm_ad.example example-ad
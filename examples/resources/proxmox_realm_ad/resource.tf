@@ -0,0 +1,19 @@
+resource "proxmox_realm_ad" "example" {
+  realm  = "example-ad"
+  domain = "corp.example.com"
+
+  # Domain controllers
+  server1 = "dc1.corp.example.com"
+  server2 = "dc2.corp.example.com"
+
+  # Bind credentials, used for user and group synchronization
+  base_dn       = "dc=corp,dc=example,dc=com"
+  bind_dn       = "cn=svc_pve,ou=services,dc=corp,dc=example,dc=com"
+  bind_password = var.ad_bind_password
+
+  # SSL/TLS configuration
+  mode   = "ldaps"
+  verify = true
+
+  comment = "Example AD realm managed by Terraform"
+}
@@ -1,3 +1,6 @@
 #!/usr/bin/env sh
 # A USB hardware mapping can be imported using their name, e.g.:
 terraform import proxmox_virtual_environment_hardware_mapping_usb.example example
+# Alternatively, a node-scoped glob in the form of "<node>/<pattern>" can be used. It must match exactly one mapping
+# that has an entry on the given node, otherwise the matching names are reported for use in a "for_each" import block:
+terraform import proxmox_virtual_environment_hardware_mapping_usb.example "pve/example-*"
@@ -9,3 +9,10 @@ resource "proxmox_virtual_environment_replication" "example_replication_1" {
   comment  = "Replication to pve-02 every 30 min"
   schedule = "*/30"
 }
+
+# Replication with the next free job number of the guest
+resource "proxmox_virtual_environment_replication" "example_replication_2" {
+  guest  = 100
+  target = "pve-03"
+  type   = "local"
+}
@@ -0,0 +1,3 @@
+#!/usr/bin/env sh
+# VM disks can be imported using the format `node_name/vm_id/interface`, e.g.:
+terraform import proxmox_vm_disk.data pve/100/scsi1
@@ -0,0 +1,23 @@
+resource "proxmox_virtual_environment_vm" "example" {
+  node_name = "pve"
+  name      = "example"
+
+  # only the disks managed by the VM resource itself are declared here,
+  # `scsi1` is managed by the `proxmox_vm_disk` resource below
+  disk {
+    datastore_id = "local-lvm"
+    interface    = "scsi0"
+    size         = 8
+  }
+}
+
+resource "proxmox_vm_disk" "data" {
+  node_name    = proxmox_virtual_environment_vm.example.node_name
+  vm_id        = proxmox_virtual_environment_vm.example.vm_id
+  interface    = "scsi1"
+  datastore_id = "local-lvm"
+  size         = 32
+  discard      = "on"
+  ssd          = true
+  backup       = false
+}
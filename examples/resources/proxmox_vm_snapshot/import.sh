@@ -0,0 +1,3 @@
+#!/usr/bin/env sh
+# VM snapshots can be imported using the format `node_name/vm_id/name`, e.g.:
+terraform import proxmox_vm_snapshot.before_upgrade pve/100/before-upgrade
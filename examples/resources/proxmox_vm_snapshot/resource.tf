@@ -0,0 +1,18 @@
+resource "proxmox_virtual_environment_vm" "example" {
+  node_name = "pve"
+  name      = "example"
+
+  disk {
+    datastore_id = "local-lvm"
+    interface    = "scsi0"
+    size         = 8
+  }
+}
+
+resource "proxmox_vm_snapshot" "before_upgrade" {
+  node_name   = proxmox_virtual_environment_vm.example.node_name
+  vm_id       = proxmox_virtual_environment_vm.example.vm_id
+  name        = "before-upgrade"
+  description = "State before the OS upgrade"
+  vmstate     = true
+}
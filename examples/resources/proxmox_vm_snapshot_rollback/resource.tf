@@ -0,0 +1,17 @@
+resource "proxmox_vm_snapshot" "baseline" {
+  node_name = "pve"
+  vm_id     = 100
+  name      = "baseline"
+}
+
+# Rolls the VM back to the baseline snapshot, and again whenever `reset` changes.
+resource "proxmox_vm_snapshot_rollback" "reset" {
+  node_name     = proxmox_vm_snapshot.baseline.node_name
+  vm_id         = proxmox_vm_snapshot.baseline.vm_id
+  snapshot_name = proxmox_vm_snapshot.baseline.name
+  start         = true
+
+  triggers = {
+    reset = "2026-10-14"
+  }
+}
@@ -0,0 +1,203 @@
+/*
+ * This Source Code Form is subject to the terms of the Mozilla Public
+ * License, v. 2.0. If a copy of the MPL was not distributed with this
+ * file, You can obtain one at https://mozilla.org/MPL/2.0/.
+ */
+
+package access
+
+import (
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+
+	"github.com/bpg/terraform-provider-proxmox/proxmox/access"
+	proxmoxtypes "github.com/bpg/terraform-provider-proxmox/proxmox/types"
+)
+
+type realmADModel struct {
+	ID                  types.String `tfsdk:"id"`
+	Realm               types.String `tfsdk:"realm"`
+	Domain              types.String `tfsdk:"domain"`
+	Server1             types.String `tfsdk:"server1"`
+	Server2             types.String `tfsdk:"server2"`
+	Port                types.Int64  `tfsdk:"port"`
+	BaseDN              types.String `tfsdk:"base_dn"`
+	BindDN              types.String `tfsdk:"bind_dn"`
+	BindPassword        types.String `tfsdk:"bind_password"`
+	Verify              types.Bool   `tfsdk:"verify"`
+	CaPath              types.String `tfsdk:"ca_path"`
+	CertPath            types.String `tfsdk:"cert_path"`
+	CertKeyPath         types.String `tfsdk:"cert_key_path"`
+	Filter              types.String `tfsdk:"filter"`
+	GroupDN             types.String `tfsdk:"group_dn"`
+	GroupFilter         types.String `tfsdk:"group_filter"`
+	GroupClasses        types.String `tfsdk:"group_classes"`
+	GroupNameAttr       types.String `tfsdk:"group_name_attr"`
+	Mode                types.String `tfsdk:"mode"`
+	SSLVersion          types.String `tfsdk:"ssl_version"`
+	UserClasses         types.String `tfsdk:"user_classes"`
+	SyncAttributes      types.String `tfsdk:"sync_attributes"`
+	SyncDefaultsOptions types.String `tfsdk:"sync_defaults_options"`
+	Comment             types.String `tfsdk:"comment"`
+	Default             types.Bool   `tfsdk:"default"`
+	CaseSensitive       types.Bool   `tfsdk:"case_sensitive"`
+}
+
+func (m *realmADModel) toCreateRequest() *access.RealmCreateRequestBody {
+	req := &access.RealmCreateRequestBody{
+		Realm:            m.Realm.ValueString(),
+		Type:             "ad",
+		Domain:           m.Domain.ValueStringPointer(),
+		Server1:          m.Server1.ValueStringPointer(),
+		Server2:          m.Server2.ValueStringPointer(),
+		BaseDN:           m.BaseDN.ValueStringPointer(),
+		BindDN:           m.BindDN.ValueStringPointer(),
+		BindPassword:     m.BindPassword.ValueStringPointer(),
+		CaPath:           m.CaPath.ValueStringPointer(),
+		CertPath:         m.CertPath.ValueStringPointer(),
+		CertKeyPath:      m.CertKeyPath.ValueStringPointer(),
+		Filter:           m.Filter.ValueStringPointer(),
+		GroupDN:          m.GroupDN.ValueStringPointer(),
+		GroupFilter:      m.GroupFilter.ValueStringPointer(),
+		GroupClasses:     m.GroupClasses.ValueStringPointer(),
+		GroupNameAttr:    m.GroupNameAttr.ValueStringPointer(),
+		Mode:             m.Mode.ValueStringPointer(),
+		SSLVersion:       m.SSLVersion.ValueStringPointer(),
+		UserClasses:      m.UserClasses.ValueStringPointer(),
+		SyncAttributes:   m.SyncAttributes.ValueStringPointer(),
+		SyncDefaultsOpts: m.SyncDefaultsOptions.ValueStringPointer(),
+		Comment:          m.Comment.ValueStringPointer(),
+		Verify:           proxmoxtypes.CustomBoolPtr(m.Verify.ValueBoolPointer()),
+		Default:          proxmoxtypes.CustomBoolPtr(m.Default.ValueBoolPointer()),
+		CaseSensitive:    proxmoxtypes.CustomBoolPtr(m.CaseSensitive.ValueBoolPointer()),
+	}
+
+	if !m.Port.IsNull() {
+		port := int(m.Port.ValueInt64())
+		req.Port = &port
+	}
+
+	return req
+}
+
+func (m *realmADModel) toUpdateRequest(state *realmADModel) *access.RealmUpdateRequestBody {
+	req := &access.RealmUpdateRequestBody{}
+	var toDelete []string
+
+	// Required fields: update directly.
+	if !m.Domain.Equal(state.Domain) {
+		req.Domain = m.Domain.ValueStringPointer()
+	}
+
+	if !m.Server1.Equal(state.Server1) {
+		req.Server1 = m.Server1.ValueStringPointer()
+	}
+
+	// Optional fields: support unsetting using the API's `delete` parameter.
+	updateStringAttribute(&req.Server2, m.Server2, state.Server2, &toDelete, "server2")
+	updateInt64Attribute(&req.Port, m.Port, state.Port, &toDelete, "port")
+	updateStringAttribute(&req.BaseDN, m.BaseDN, state.BaseDN, &toDelete, "base_dn")
+	updateStringAttribute(&req.BindDN, m.BindDN, state.BindDN, &toDelete, "bind_dn")
+	// The API field name for BindPassword is `password`.
+	updateStringAttribute(&req.BindPassword, m.BindPassword, state.BindPassword, &toDelete, "password")
+
+	if !m.Verify.Equal(state.Verify) {
+		req.Verify = proxmoxtypes.CustomBoolPtr(m.Verify.ValueBoolPointer())
+	}
+
+	updateStringAttribute(&req.CaPath, m.CaPath, state.CaPath, &toDelete, "capath")
+	updateStringAttribute(&req.CertPath, m.CertPath, state.CertPath, &toDelete, "cert")
+	updateStringAttribute(&req.CertKeyPath, m.CertKeyPath, state.CertKeyPath, &toDelete, "certkey")
+	updateStringAttribute(&req.Filter, m.Filter, state.Filter, &toDelete, "filter")
+	updateStringAttribute(&req.GroupDN, m.GroupDN, state.GroupDN, &toDelete, "group_dn")
+	updateStringAttribute(&req.GroupFilter, m.GroupFilter, state.GroupFilter, &toDelete, "group_filter")
+	updateStringAttribute(&req.GroupClasses, m.GroupClasses, state.GroupClasses, &toDelete, "group_classes")
+	updateStringAttribute(&req.GroupNameAttr, m.GroupNameAttr, state.GroupNameAttr, &toDelete, "group_name_attr")
+	updateStringAttribute(&req.Mode, m.Mode, state.Mode, &toDelete, "mode")
+	updateStringAttribute(&req.SSLVersion, m.SSLVersion, state.SSLVersion, &toDelete, "sslversion")
+	updateStringAttribute(&req.UserClasses, m.UserClasses, state.UserClasses, &toDelete, "user_classes")
+	updateStringAttribute(&req.SyncAttributes, m.SyncAttributes, state.SyncAttributes, &toDelete, "sync_attributes")
+	updateStringAttribute(&req.SyncDefaultsOpts, m.SyncDefaultsOptions, state.SyncDefaultsOptions, &toDelete, "sync-defaults-options")
+	updateStringAttribute(&req.Comment, m.Comment, state.Comment, &toDelete, "comment")
+
+	if !m.Default.Equal(state.Default) {
+		req.Default = proxmoxtypes.CustomBoolPtr(m.Default.ValueBoolPointer())
+	}
+
+	if !m.CaseSensitive.Equal(state.CaseSensitive) {
+		req.CaseSensitive = proxmoxtypes.CustomBoolPtr(m.CaseSensitive.ValueBoolPointer())
+	}
+
+	if len(toDelete) > 0 {
+		req.Delete = toDelete
+	}
+
+	return req
+}
+
+func (m *realmADModel) fromAPIResponse(data *access.RealmGetResponseData, diags *diag.Diagnostics) {
+	if data.Type != "ad" {
+		diags.AddError(
+			"Unexpected Realm Type",
+			fmt.Sprintf("Realm %q is of type %q, expected \"ad\"", data.Realm, data.Type),
+		)
+
+		return
+	}
+
+	if data.Server1 == nil || data.Domain == nil {
+		diags.AddError(
+			"Missing Required Field",
+			"API response is missing required field 'server1' or 'domain' for AD realm",
+		)
+
+		return
+	}
+
+	m.Domain = types.StringPointerValue(data.Domain)
+	m.Server1 = types.StringPointerValue(data.Server1)
+	m.Server2 = types.StringPointerValue(data.Server2)
+
+	if data.Port != nil {
+		m.Port = types.Int64Value(int64(*data.Port))
+	} else {
+		m.Port = types.Int64Null()
+	}
+
+	m.BaseDN = types.StringPointerValue(data.BaseDN)
+	m.BindDN = types.StringPointerValue(data.BindDN)
+
+	// Note: bind_password is never returned by the API, preserve from state
+
+	m.Verify = types.BoolPointerValue(data.Verify.PointerBool())
+	if m.Verify.IsNull() {
+		m.Verify = types.BoolValue(false)
+	}
+
+	m.CaPath = types.StringPointerValue(data.CaPath)
+	m.CertPath = types.StringPointerValue(data.CertPath)
+	m.CertKeyPath = types.StringPointerValue(data.CertKeyPath)
+	m.Filter = types.StringPointerValue(data.Filter)
+	m.GroupDN = types.StringPointerValue(data.GroupDN)
+	m.GroupFilter = types.StringPointerValue(data.GroupFilter)
+	m.GroupClasses = types.StringPointerValue(data.GroupClasses)
+	m.GroupNameAttr = types.StringPointerValue(data.GroupNameAttr)
+	m.Mode = types.StringPointerValue(data.Mode)
+	m.SSLVersion = types.StringPointerValue(data.SSLVersion)
+	m.UserClasses = types.StringPointerValue(data.UserClasses)
+	m.SyncAttributes = types.StringPointerValue(data.SyncAttributes)
+	m.SyncDefaultsOptions = types.StringPointerValue(data.SyncDefaultsOpts)
+	m.Comment = types.StringPointerValue(data.Comment)
+
+	m.Default = types.BoolPointerValue(data.Default.PointerBool())
+	if m.Default.IsNull() {
+		m.Default = types.BoolValue(false)
+	}
+
+	m.CaseSensitive = types.BoolPointerValue(data.CaseSensitive.PointerBool())
+	if m.CaseSensitive.IsNull() {
+		m.CaseSensitive = types.BoolValue(true)
+	}
+}
@@ -0,0 +1,389 @@
+/*
+ * This Source Code Form is subject to the terms of the Mozilla Public
+ * License, v. 2.0. If a copy of the MPL was not distributed with this
+ * file, You can obtain one at https://mozilla.org/MPL/2.0/.
+ */
+
+package access
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"regexp"
+
+	"github.com/hashicorp/terraform-plugin-framework-validators/int64validator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/booldefault"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+
+	"github.com/bpg/terraform-provider-proxmox/fwprovider/attribute"
+	"github.com/bpg/terraform-provider-proxmox/fwprovider/config"
+	"github.com/bpg/terraform-provider-proxmox/proxmox"
+	"github.com/bpg/terraform-provider-proxmox/proxmox/api"
+)
+
+var (
+	_ resource.Resource                = (*realmADResource)(nil)
+	_ resource.ResourceWithConfigure   = (*realmADResource)(nil)
+	_ resource.ResourceWithImportState = (*realmADResource)(nil)
+)
+
+type realmADResource struct {
+	client proxmox.Client
+}
+
+// NewRealmADResource creates a new Active Directory realm resource.
+func NewRealmADResource() resource.Resource {
+	return &realmADResource{}
+}
+
+func (r *realmADResource) Metadata(
+	_ context.Context,
+	_ resource.MetadataRequest,
+	resp *resource.MetadataResponse,
+) {
+	resp.TypeName = "proxmox_realm_ad"
+}
+
+func (r *realmADResource) Schema(
+	_ context.Context,
+	_ resource.SchemaRequest,
+	resp *resource.SchemaResponse,
+) {
+	resp.Schema = schema.Schema{
+		Description: "Manages an Active Directory authentication realm in Proxmox VE.",
+		MarkdownDescription: "Manages an Active Directory authentication realm in Proxmox VE.\n\n" +
+			"AD realms allow Proxmox to authenticate users against a Microsoft Active Directory domain.",
+		Attributes: map[string]schema.Attribute{
+			"id": attribute.ResourceID("Realm identifier (same as realm)"),
+			"realm": schema.StringAttribute{
+				Description: "Realm identifier (e.g., 'example.com').",
+				Required:    true,
+				Validators: []validator.String{
+					stringvalidator.LengthAtMost(32),
+					stringvalidator.RegexMatches(
+						regexp.MustCompile(`^[A-Za-z][A-Za-z0-9.\-_]+$`),
+						"must be a valid realm identifier",
+					),
+				},
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"domain": schema.StringAttribute{
+				Description: "AD domain name (e.g., 'corp.example.com').",
+				Required:    true,
+			},
+			"server1": schema.StringAttribute{
+				Description: "Primary domain controller hostname or IP address.",
+				Required:    true,
+			},
+			"server2": schema.StringAttribute{
+				Description: "Fallback domain controller hostname or IP address.",
+				Optional:    true,
+			},
+			"port": schema.Int64Attribute{
+				Description: "Domain controller port. Default: 389 (LDAP) or 636 (LDAPS).",
+				Optional:    true,
+				Validators: []validator.Int64{
+					int64validator.Between(1, 65535),
+				},
+			},
+			"base_dn": schema.StringAttribute{
+				Description: "LDAP base DN for user searches, used for synchronization (e.g., 'dc=corp,dc=example,dc=com').",
+				Optional:    true,
+			},
+			"bind_dn": schema.StringAttribute{
+				Description: "LDAP bind DN used for synchronization (e.g., 'cn=svc_pve,ou=services,dc=corp,dc=example,dc=com').",
+				Optional:    true,
+			},
+			"bind_password": schema.StringAttribute{
+				Description: "Password for the bind DN. Note: stored in Proxmox but not returned by API.",
+				Optional:    true,
+				Sensitive:   true,
+				Validators: []validator.String{
+					stringvalidator.AlsoRequires(path.MatchRoot("bind_dn")),
+				},
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"verify": schema.BoolAttribute{
+				Description: "Verify the domain controller SSL certificate.",
+				Optional:    true,
+				Computed:    true,
+				Default:     booldefault.StaticBool(false),
+			},
+			"ca_path": schema.StringAttribute{
+				Description: "Path to CA certificate file for SSL verification.",
+				Optional:    true,
+			},
+			"cert_path": schema.StringAttribute{
+				Description: "Path to client certificate for SSL authentication.",
+				Optional:    true,
+				Validators: []validator.String{
+					stringvalidator.AlsoRequires(path.MatchRoot("cert_key_path")),
+				},
+			},
+			"cert_key_path": schema.StringAttribute{
+				Description: "Path to client certificate key.",
+				Optional:    true,
+				Validators: []validator.String{
+					stringvalidator.AlsoRequires(path.MatchRoot("cert_path")),
+				},
+			},
+			"filter": schema.StringAttribute{
+				Description: "LDAP filter for user synchronization.",
+				Optional:    true,
+			},
+			"group_dn": schema.StringAttribute{
+				Description: "LDAP base DN for group searches.",
+				Optional:    true,
+			},
+			"group_filter": schema.StringAttribute{
+				Description: "LDAP filter for group searches.",
+				Optional:    true,
+			},
+			"group_classes": schema.StringAttribute{
+				Description: "LDAP objectClasses for groups (comma-separated).",
+				Optional:    true,
+			},
+			"group_name_attr": schema.StringAttribute{
+				Description: "LDAP attribute representing the group name.",
+				Optional:    true,
+			},
+			"mode": schema.StringAttribute{
+				Description: "LDAP connection mode (ldap, ldaps, ldap+starttls).",
+				Optional:    true,
+				Validators: []validator.String{
+					stringvalidator.OneOf("ldap", "ldaps", "ldap+starttls"),
+				},
+			},
+			"ssl_version": schema.StringAttribute{
+				Description: "SSL/TLS version (tlsv1, tlsv1_1, tlsv1_2, tlsv1_3).",
+				Optional:    true,
+				Validators: []validator.String{
+					stringvalidator.OneOf("tlsv1", "tlsv1_1", "tlsv1_2", "tlsv1_3"),
+				},
+			},
+			"user_classes": schema.StringAttribute{
+				Description: "LDAP objectClasses for users (comma-separated).",
+				Optional:    true,
+			},
+			"sync_attributes": schema.StringAttribute{
+				Description: "Comma-separated list of attributes to sync (e.g., 'email=mail,firstname=givenName').",
+				Optional:    true,
+			},
+			"sync_defaults_options": schema.StringAttribute{
+				Description: "Default synchronization options. Format: comma-separated 'key=value' pairs. " +
+					"Valid keys: 'scope' (users/groups/both), 'enable-new' (1/0), 'remove-vanished' (semicolon-separated: entry/acl/properties). " +
+					"Example: 'scope=users,enable-new=1,remove-vanished=entry;acl'.",
+				Optional: true,
+			},
+			"comment": schema.StringAttribute{
+				Description: "Description of the realm.",
+				Optional:    true,
+			},
+			"default": schema.BoolAttribute{
+				Description: "Use this realm as the default for login.",
+				Optional:    true,
+				Computed:    true,
+				Default:     booldefault.StaticBool(false),
+			},
+			"case_sensitive": schema.BoolAttribute{
+				Description: "Enable case-sensitive username matching.",
+				Optional:    true,
+				Computed:    true,
+				Default:     booldefault.StaticBool(true),
+			},
+		},
+	}
+}
+
+func (r *realmADResource) Configure(
+	_ context.Context,
+	req resource.ConfigureRequest,
+	resp *resource.ConfigureResponse,
+) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	cfg, ok := req.ProviderData.(config.Resource)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Resource Configure Type",
+			fmt.Sprintf("Expected config.Resource, got: %T", req.ProviderData),
+		)
+
+		return
+	}
+
+	r.client = cfg.Client
+}
+
+func (r *realmADResource) Create(
+	ctx context.Context,
+	req resource.CreateRequest,
+	resp *resource.CreateResponse,
+) {
+	var plan realmADModel
+
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Convert Terraform model to API request
+	createReq := plan.toCreateRequest()
+
+	err := r.client.Access().CreateRealm(ctx, createReq)
+	if err != nil {
+		resp.Diagnostics.AddError("Error creating AD realm", err.Error())
+		return
+	}
+
+	// Read back the created resource
+	plan.ID = plan.Realm
+
+	err = r.read(ctx, &plan, &resp.Diagnostics)
+	if err != nil {
+		resp.Diagnostics.AddError("Error reading AD realm after create", err.Error())
+		return
+	}
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, plan)...)
+}
+
+func (r *realmADResource) Read(
+	ctx context.Context,
+	req resource.ReadRequest,
+	resp *resource.ReadResponse,
+) {
+	var state realmADModel
+
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	err := r.read(ctx, &state, &resp.Diagnostics)
+	if err != nil {
+		if errors.Is(err, api.ErrResourceDoesNotExist) {
+			resp.State.RemoveResource(ctx)
+			return
+		}
+
+		resp.Diagnostics.AddError(
+			"Error Reading AD Realm",
+			fmt.Sprintf("Could not read realm %q: %v", state.Realm.ValueString(), err),
+		)
+
+		return
+	}
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, state)...)
+}
+
+func (r *realmADResource) read(
+	ctx context.Context,
+	model *realmADModel,
+	diags *diag.Diagnostics,
+) error {
+	realmData, err := r.client.Access().GetRealm(ctx, model.Realm.ValueString())
+	if err != nil {
+		return err
+	}
+
+	model.fromAPIResponse(realmData, diags)
+
+	return nil
+}
+
+func (r *realmADResource) Update(
+	ctx context.Context,
+	req resource.UpdateRequest,
+	resp *resource.UpdateResponse,
+) {
+	var plan realmADModel
+	var state realmADModel
+
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	updateReq := plan.toUpdateRequest(&state)
+
+	err := r.client.Access().UpdateRealm(ctx, plan.Realm.ValueString(), updateReq)
+	if err != nil {
+		resp.Diagnostics.AddError("Error updating AD realm", err.Error())
+		return
+	}
+
+	err = r.read(ctx, &plan, &resp.Diagnostics)
+	if err != nil {
+		resp.Diagnostics.AddError("Error reading AD realm after update", err.Error())
+		return
+	}
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, plan)...)
+}
+
+func (r *realmADResource) Delete(
+	ctx context.Context,
+	req resource.DeleteRequest,
+	resp *resource.DeleteResponse,
+) {
+	var state realmADModel
+
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	err := r.client.Access().DeleteRealm(ctx, state.Realm.ValueString())
+	if err != nil {
+		// If already deleted, that's fine
+		if errors.Is(err, api.ErrResourceDoesNotExist) {
+			return
+		}
+
+		resp.Diagnostics.AddError("Error deleting AD realm", err.Error())
+
+		return
+	}
+}
+
+func (r *realmADResource) ImportState(
+	ctx context.Context,
+	req resource.ImportStateRequest,
+	resp *resource.ImportStateResponse,
+) {
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("realm"), req.ID)...)
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("id"), req.ID)...)
+}
@@ -0,0 +1,103 @@
+//go:build acceptance || all
+
+//testacc:tier=light
+//testacc:resource=access
+
+/*
+ * This Source Code Form is subject to the terms of the Mozilla Public
+ * License, v. 2.0. If a copy of the MPL was not distributed with this
+ * file, You can obtain one at https://mozilla.org/MPL/2.0/.
+ */
+
+package access_test
+
+import (
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+
+	"github.com/bpg/terraform-provider-proxmox/fwprovider/test"
+)
+
+func TestAccRealmAD(t *testing.T) {
+	te := test.InitEnvironment(t)
+
+	resource.ParallelTest(t, resource.TestCase{
+		ProtoV6ProviderFactories: te.AccProviders,
+		Steps: []resource.TestStep{
+			// Create with minimal required fields
+			{
+				Config: te.RenderConfig(`
+					resource "proxmox_realm_ad" "test" {
+						realm   = "test-ad.local"
+						domain  = "corp.example.com"
+						server1 = "dc1.corp.example.com"
+						comment = "Test AD realm"
+					}
+				`),
+				Check: test.ResourceAttributes("proxmox_realm_ad.test", map[string]string{
+					"realm":          "test-ad.local",
+					"domain":         "corp.example.com",
+					"server1":        "dc1.corp.example.com",
+					"verify":         "false",
+					"default":        "false",
+					"case_sensitive": "true",
+				}),
+			},
+			// Import state
+			{
+				ResourceName:            "proxmox_realm_ad.test",
+				ImportState:             true,
+				ImportStateVerify:       true,
+				ImportStateVerifyIgnore: []string{"bind_password"}, // Password not returned by API
+			},
+			// Update with sync settings added
+			{
+				Config: te.RenderConfig(`
+					resource "proxmox_realm_ad" "test" {
+						realm         = "test-ad.local"
+						domain        = "corp.example.com"
+						server1       = "dc1.corp.example.com"
+						server2       = "dc2.corp.example.com"
+						base_dn       = "dc=corp,dc=example,dc=com"
+						bind_dn       = "cn=svc_pve,dc=corp,dc=example,dc=com"
+						bind_password = "secret"
+						mode          = "ldaps"
+						comment       = "Test AD realm with sync"
+					}
+				`),
+				Check: test.ResourceAttributes("proxmox_realm_ad.test", map[string]string{
+					"server2":       "dc2.corp.example.com",
+					"base_dn":       "dc=corp,dc=example,dc=com",
+					"bind_dn":       "cn=svc_pve,dc=corp,dc=example,dc=com",
+					"bind_password": "secret",
+					"mode":          "ldaps",
+					"comment":       "Test AD realm with sync",
+				}),
+			},
+			// Remove optional fields to verify proper cleanup
+			{
+				Config: te.RenderConfig(`
+					resource "proxmox_realm_ad" "test" {
+						realm   = "test-ad.local"
+						domain  = "corp.example.com"
+						server1 = "dc1.corp.example.com"
+						comment = "Updated test AD realm"
+					}
+				`),
+				Check: resource.ComposeTestCheckFunc(
+					test.ResourceAttributes("proxmox_realm_ad.test", map[string]string{
+						"comment": "Updated test AD realm",
+					}),
+					test.NoResourceAttributesSet("proxmox_realm_ad.test", []string{
+						"server2",
+						"base_dn",
+						"bind_dn",
+						"bind_password",
+						"mode",
+					}),
+				),
+			},
+		},
+	})
+}
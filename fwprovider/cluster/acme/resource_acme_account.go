@@ -96,7 +96,10 @@ func (r *acmeAccountResource) Schema(
 				Computed:    true,
 			},
 			"directory": schema.StringAttribute{
-				Description: "The URL of the ACME CA directory endpoint.",
+				Description: "The URL of the ACME CA directory endpoint. Defaults to the Let's Encrypt production " +
+					"directory (`https://acme-v02.api.letsencrypt.org/directory`), use " +
+					"`https://acme-staging-v02.api.letsencrypt.org/directory` for the staging environment. " +
+					"Changing the directory registers a new account.",
 				Validators: []validator.String{
 					stringvalidator.RegexMatches(
 						regexp.MustCompile(`^https?://.*$`),
@@ -104,6 +107,11 @@ func (r *acmeAccountResource) Schema(
 					),
 				},
 				Optional: true,
+				Computed: true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+					stringplanmodifier.RequiresReplaceIfConfigured(),
+				},
 			},
 			"eab_hmac_key": schema.StringAttribute{
 				Description: "The HMAC key for External Account Binding.",
@@ -68,7 +68,10 @@ func (r *acmePluginResource) Schema(
 			},
 			"data": schema.MapAttribute{
 				Description: "DNS plugin data.",
+				MarkdownDescription: "DNS plugin data, e.g. the credentials of the DNS provider API. " +
+					"Values not returned by the Proxmox VE API are kept from the configuration.",
 				Optional:    true,
+				Sensitive:   true,
 				ElementType: types.StringType,
 			},
 			"data_wo": schema.MapAttribute{
@@ -260,7 +263,18 @@ func (r *acmePluginResource) Read(ctx context.Context, req resource.ReadRequest,
 	// write-only `data_wo`, `data` is null in state and must stay null; otherwise GET
 	// (which returns the stored data) would create a perpetual diff against null config.
 	if imported || !state.Data.IsNull() {
-		mapValue, diags := types.MapValueFrom(ctx, types.StringType, plugin.Data)
+		stateData := make(map[string]string)
+
+		if !state.Data.IsNull() {
+			resp.Diagnostics.Append(state.Data.ElementsAs(ctx, &stateData, false)...)
+		}
+
+		var apiData plugins.DNSPluginData
+		if plugin.Data != nil {
+			apiData = *plugin.Data
+		}
+
+		mapValue, diags := types.MapValueFrom(ctx, types.StringType, mergePluginData(stateData, apiData))
 		resp.Diagnostics.Append(diags...)
 
 		state.Data = mapValue
@@ -416,3 +430,21 @@ func (r *acmePluginShort) MoveState(ctx context.Context) []resource.StateMover {
 		migration.PrefixMoveState("proxmox_virtual_environment_acme_dns_plugin", &schemaResp.Schema),
 	}
 }
+
+// mergePluginData returns the plugin data reported by the API, keeping the values of the keys
+// known from state that the API does not return (e.g. secrets), to avoid a perpetual diff.
+func mergePluginData(stateData, apiData map[string]string) map[string]string {
+	merged := make(map[string]string, len(apiData))
+
+	for key, value := range apiData {
+		merged[key] = value
+	}
+
+	for key, value := range stateData {
+		if _, ok := merged[key]; !ok {
+			merged[key] = value
+		}
+	}
+
+	return merged
+}
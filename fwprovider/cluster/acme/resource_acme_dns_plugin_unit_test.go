@@ -0,0 +1,54 @@
+/*
+ * This Source Code Form is subject to the terms of the Mozilla Public
+ * License, v. 2.0. If a copy of the MPL was not distributed with this
+ * file, You can obtain one at https://mozilla.org/MPL/2.0/.
+ */
+
+package acme
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestMergePluginData(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name      string
+		stateData map[string]string
+		apiData   map[string]string
+		want      map[string]string
+	}{
+		{
+			name:      "api values win",
+			stateData: map[string]string{"CF_Email": "old@example.com"},
+			apiData:   map[string]string{"CF_Email": "new@example.com"},
+			want:      map[string]string{"CF_Email": "new@example.com"},
+		},
+		{
+			name:      "secrets missing from the api are kept",
+			stateData: map[string]string{"CF_Email": "admin@example.com", "CF_Token": "secret"},
+			apiData:   map[string]string{"CF_Email": "admin@example.com"},
+			want:      map[string]string{"CF_Email": "admin@example.com", "CF_Token": "secret"},
+		},
+		{
+			name:    "import without state",
+			apiData: map[string]string{"CF_Email": "admin@example.com"},
+			want:    map[string]string{"CF_Email": "admin@example.com"},
+		},
+		{
+			name: "nothing known",
+			want: map[string]string{},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			assert.Equal(t, tt.want, mergePluginData(tt.stateData, tt.apiData))
+		})
+	}
+}
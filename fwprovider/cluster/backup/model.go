@@ -30,6 +30,7 @@ type backupJobModel struct {
 	Node                   types.String `tfsdk:"node"`
 	VMIDs                  types.List   `tfsdk:"vmid"`
 	All                    types.Bool   `tfsdk:"all"`
+	Exclude                types.List   `tfsdk:"exclude"`
 	Mode                   types.String `tfsdk:"mode"`
 	Compress               types.String `tfsdk:"compress"`
 	StartTime              types.String `tfsdk:"starttime"`
@@ -153,6 +154,7 @@ func (m *backupJobModel) toAPIUpdate(
 
 	attribute.CheckDelete(m.Node, state.Node, &toDelete, "node")
 	attribute.CheckDelete(m.VMIDs, state.VMIDs, &toDelete, "vmid")
+	attribute.CheckDelete(m.Exclude, state.Exclude, &toDelete, "exclude")
 
 	attribute.CheckDelete(m.Mode, state.Mode, &toDelete, "mode")
 	attribute.CheckDelete(m.Compress, state.Compress, &toDelete, "compress")
@@ -270,6 +272,19 @@ func (m *backupJobModel) fillCommonFields(
 		}
 	}
 
+	// Exclude: convert types.List to comma-separated string
+	if !m.Exclude.IsNull() && !m.Exclude.IsUnknown() {
+		var vmids []string
+
+		d := m.Exclude.ElementsAs(ctx, &vmids, false)
+		diags.Append(d...)
+
+		if !d.HasError() && len(vmids) > 0 {
+			excludeStr := strings.Join(vmids, ",")
+			common.Exclude = &excludeStr
+		}
+	}
+
 	// ExcludePath: convert types.List to comma-separated string
 	if !m.ExcludePath.IsNull() && !m.ExcludePath.IsUnknown() {
 		var paths []string
@@ -344,6 +359,23 @@ func (m *backupJobModel) fromAPI(
 		m.VMIDs = types.ListNull(types.StringType)
 	}
 
+	// Exclude: convert comma-separated string to list
+	if data.Exclude != nil && *data.Exclude != "" {
+		ids := strings.Split(*data.Exclude, ",")
+		excludeValues := make([]attr.Value, len(ids))
+
+		for i, id := range ids {
+			excludeValues[i] = types.StringValue(strings.TrimSpace(id))
+		}
+
+		listVal, d := types.ListValue(types.StringType, excludeValues)
+		diags.Append(d...)
+
+		m.Exclude = listVal
+	} else {
+		m.Exclude = types.ListNull(types.StringType)
+	}
+
 	m.Mode = types.StringPointerValue(data.Mode)
 	m.Compress = types.StringPointerValue(data.Compress)
 	m.StartTime = types.StringPointerValue(data.StartTime)
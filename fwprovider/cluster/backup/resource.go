@@ -35,6 +35,7 @@ var (
 	_ resource.ResourceWithConfigure        = &backupJobResource{}
 	_ resource.ResourceWithImportState      = &backupJobResource{}
 	_ resource.ResourceWithConfigValidators = &backupJobResource{}
+	_ resource.ResourceWithValidateConfig   = &backupJobResource{}
 )
 
 type backupJobResource struct {
@@ -93,6 +94,57 @@ func (r *backupJobResource) ConfigValidators(_ context.Context) []resource.Confi
 	}
 }
 
+// ValidateConfig validates that exactly one guest selection mode is configured.
+func (r *backupJobResource) ValidateConfig(
+	ctx context.Context,
+	req resource.ValidateConfigRequest,
+	resp *resource.ValidateConfigResponse,
+) {
+	var data backupJobModel
+
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Skip validation until all selection attributes are known.
+	if data.All.IsUnknown() || data.VMIDs.IsUnknown() || data.Pool.IsUnknown() {
+		return
+	}
+
+	selections := 0
+
+	if data.All.ValueBool() {
+		selections++
+	}
+
+	if !data.VMIDs.IsNull() {
+		selections++
+	}
+
+	if !data.Pool.IsNull() {
+		selections++
+	}
+
+	// More than one selection is already reported by the conflicting validators.
+	if selections == 0 {
+		resp.Diagnostics.AddAttributeError(
+			path.Root("vmid"),
+			"Missing guest selection",
+			"Exactly one of `vmid`, `pool`, or `all = true` must be set to select the guests to back up.",
+		)
+	}
+
+	if !data.Exclude.IsNull() && !data.All.ValueBool() {
+		resp.Diagnostics.AddAttributeError(
+			path.Root("exclude"),
+			"Invalid attribute combination",
+			"The `exclude` attribute is only valid when `all` is `true`.",
+		)
+	}
+}
+
 func (r *backupJobResource) Schema(
 	_ context.Context,
 	_ resource.SchemaRequest,
@@ -141,6 +193,11 @@ func (r *backupJobResource) Schema(
 					boolplanmodifier.UseStateForUnknown(),
 				},
 			},
+			"exclude": schema.ListAttribute{
+				Description: "A list of guest VM/CT IDs to exclude from the backup job. Only valid when `all` is `true`.",
+				Optional:    true,
+				ElementType: types.StringType,
+			},
 			"mode": schema.StringAttribute{
 				Description: "The backup mode (snapshot, suspend, or stop).",
 				Optional:    true,
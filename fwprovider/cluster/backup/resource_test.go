@@ -12,6 +12,7 @@
 package backup_test
 
 import (
+	"regexp"
 	"testing"
 
 	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
@@ -168,6 +169,47 @@ func TestAccResourceBackupJob(t *testing.T) {
 				ImportStateVerify: true,
 			},
 		}},
+		{"backup all guests with exclusions", []resource.TestStep{
+			{
+				Config: te.RenderConfig(`
+				resource "proxmox_backup_job" "test_exclude" {
+					id       = "acc-test-excl"
+					schedule = "*-*-* 06:30"
+					storage  = "local"
+					all      = true
+					exclude  = ["100", "101"]
+				}`),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("proxmox_backup_job.test_exclude", "all", "true"),
+					resource.TestCheckResourceAttr("proxmox_backup_job.test_exclude", "exclude.#", "2"),
+					resource.TestCheckResourceAttr("proxmox_backup_job.test_exclude", "exclude.0", "100"),
+					resource.TestCheckResourceAttr("proxmox_backup_job.test_exclude", "exclude.1", "101"),
+				),
+			},
+			{
+				Config: te.RenderConfig(`
+				resource "proxmox_backup_job" "test_exclude" {
+					id       = "acc-test-excl"
+					schedule = "*-*-* 06:30"
+					storage  = "local"
+					all      = true
+				}`),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckNoResourceAttr("proxmox_backup_job.test_exclude", "exclude"),
+				),
+			},
+		}},
+		{"missing guest selection", []resource.TestStep{
+			{
+				Config: te.RenderConfig(`
+				resource "proxmox_backup_job" "test_no_selection" {
+					id       = "acc-test-nosel"
+					schedule = "*-*-* 06:30"
+					storage  = "local"
+				}`),
+				ExpectError: regexp.MustCompile(`Missing guest selection`),
+			},
+		}},
 		{"backup with retention policy", []resource.TestStep{
 			{
 				Config: te.RenderConfig(`
@@ -20,6 +20,7 @@ import (
 	"github.com/bpg/terraform-provider-proxmox/fwprovider/attribute"
 	"github.com/bpg/terraform-provider-proxmox/fwprovider/config"
 	"github.com/bpg/terraform-provider-proxmox/fwprovider/migration"
+	"github.com/bpg/terraform-provider-proxmox/proxmox/cluster/ha"
 	haresources "github.com/bpg/terraform-provider-proxmox/proxmox/cluster/ha/resources"
 	proxmoxtypes "github.com/bpg/terraform-provider-proxmox/proxmox/types"
 )
@@ -37,7 +38,8 @@ func NewHAResourcesDataSource() datasource.DataSource {
 
 // haResourcesDatasource is the data source implementation for High Availability resources.
 type haResourcesDatasource struct {
-	client *haresources.Client
+	client   *haresources.Client
+	haClient *ha.Client
 }
 
 // haResourcesModel maps the schema data for the High Availability resources data source.
@@ -48,6 +50,21 @@ type haResourcesModel struct {
 	Type types.String `tfsdk:"type"`
 	// The set of HA resource identifiers
 	Resources types.Set `tfsdk:"resource_ids"`
+	// The HA resources with their configuration and current status
+	Details types.List `tfsdk:"resources"`
+}
+
+// haResourcesDetailModel maps a single entry of the `resources` attribute.
+type haResourcesDetailModel struct {
+	ResourceID  types.String `tfsdk:"resource_id"`
+	Type        types.String `tfsdk:"type"`
+	State       types.String `tfsdk:"state"`
+	Comment     types.String `tfsdk:"comment"`
+	Group       types.String `tfsdk:"group"`
+	MaxRelocate types.Int64  `tfsdk:"max_relocate"`
+	MaxRestart  types.Int64  `tfsdk:"max_restart"`
+	CRMState    types.String `tfsdk:"crm_state"`
+	Node        types.String `tfsdk:"node"`
 }
 
 // Metadata returns the data source type name.
@@ -79,6 +96,50 @@ func (d *haResourcesDatasource) Schema(_ context.Context, _ datasource.SchemaReq
 				ElementType: types.StringType,
 				Computed:    true,
 			},
+			"resources": schema.ListNestedAttribute{
+				Description: "The High Availability resources, with their configuration and current status.",
+				Computed:    true,
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						"resource_id": schema.StringAttribute{
+							Description: "The identifier of the High Availability resource.",
+							Computed:    true,
+						},
+						"type": schema.StringAttribute{
+							Description: "The type of the High Availability resource (`vm` or `ct`).",
+							Computed:    true,
+						},
+						"state": schema.StringAttribute{
+							Description: "The requested state of the High Availability resource.",
+							Computed:    true,
+						},
+						"comment": schema.StringAttribute{
+							Description: "The comment associated with the High Availability resource.",
+							Computed:    true,
+						},
+						"group": schema.StringAttribute{
+							Description: "The identifier of the High Availability group the resource is a member of.",
+							Computed:    true,
+						},
+						"max_relocate": schema.Int64Attribute{
+							Description: "The maximal number of relocation attempts.",
+							Computed:    true,
+						},
+						"max_restart": schema.Int64Attribute{
+							Description: "The maximal number of restart attempts.",
+							Computed:    true,
+						},
+						"crm_state": schema.StringAttribute{
+							Description: "The current state of the resource as seen by the cluster resource manager.",
+							Computed:    true,
+						},
+						"node": schema.StringAttribute{
+							Description: "The node the resource is currently assigned to.",
+							Computed:    true,
+						},
+					},
+				},
+			},
 		},
 	}
 }
@@ -103,7 +164,8 @@ func (d *haResourcesDatasource) Configure(
 		return
 	}
 
-	d.client = cfg.Client.Cluster().HA().Resources()
+	d.haClient = cfg.Client.Cluster().HA()
+	d.client = d.haClient.Resources()
 }
 
 // Read fetches the list of HA resources from the Proxmox cluster then converts it to a list of strings.
@@ -149,6 +211,16 @@ func (d *haResourcesDatasource) Read(ctx context.Context, req datasource.ReadReq
 		return
 	}
 
+	status, err := d.haClient.GetCurrentStatus(ctx)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Unable to read High Availability status",
+			err.Error(),
+		)
+
+		return
+	}
+
 	resources := make([]attr.Value, len(list))
 	for i, v := range list {
 		resources[i] = types.StringValue(v.ID.String())
@@ -157,14 +229,68 @@ func (d *haResourcesDatasource) Read(ctx context.Context, req datasource.ReadReq
 	resourcesValue, diags := types.SetValue(types.StringType, resources)
 	resp.Diagnostics.Append(diags...)
 
+	detailsValue, diags := types.ListValueFrom(ctx, types.ObjectType{AttrTypes: map[string]attr.Type{
+		"resource_id":  types.StringType,
+		"type":         types.StringType,
+		"state":        types.StringType,
+		"comment":      types.StringType,
+		"group":        types.StringType,
+		"max_relocate": types.Int64Type,
+		"max_restart":  types.Int64Type,
+		"crm_state":    types.StringType,
+		"node":         types.StringType,
+	}}, haResourcesDetails(list, status))
+	resp.Diagnostics.Append(diags...)
+
 	if resp.Diagnostics.HasError() {
 		return
 	}
 
 	data.Resources = resourcesValue
+	data.Details = detailsValue
 	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
 }
 
+// haResourcesDetails joins the configured HA resources with the service entries of the HA status by resource
+// identifier.
+func haResourcesDetails(
+	list []*haresources.HAResourceListResponseData,
+	status []*ha.StatusCurrentResponseData,
+) []haResourcesDetailModel {
+	services := make(map[string]*ha.StatusCurrentResponseData, len(status))
+
+	for _, s := range status {
+		if s != nil && s.Type == ha.StatusTypeService && s.SID != nil {
+			services[*s.SID] = s
+		}
+	}
+
+	details := make([]haResourcesDetailModel, len(list))
+
+	for i, v := range list {
+		sid := v.ID.String()
+
+		details[i] = haResourcesDetailModel{
+			ResourceID:  types.StringValue(sid),
+			Type:        v.Type.ToValue(),
+			State:       v.State.ToValue(),
+			Comment:     types.StringPointerValue(v.Comment),
+			Group:       types.StringPointerValue(v.Group),
+			MaxRelocate: types.Int64PointerValue(v.MaxRelocate),
+			MaxRestart:  types.Int64PointerValue(v.MaxRestart),
+			CRMState:    types.StringNull(),
+			Node:        types.StringNull(),
+		}
+
+		if s, ok := services[sid]; ok {
+			details[i].CRMState = types.StringPointerValue(s.CRMState)
+			details[i].Node = types.StringPointerValue(s.Node)
+		}
+	}
+
+	return details
+}
+
 // Short-name alias for proxmox_haresources data source (ADR-007).
 
 var (
@@ -31,6 +31,7 @@ func TestAccHAResources(t *testing.T) {
 				`),
 				Check: resource.ComposeTestCheckFunc(
 					resource.TestCheckResourceAttrSet("data.proxmox_haresources.test", "id"),
+					resource.TestCheckResourceAttrSet("data.proxmox_haresources.test", "resources.#"),
 				),
 			},
 		},
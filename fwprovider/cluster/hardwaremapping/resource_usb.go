@@ -10,6 +10,9 @@ import (
 	"context"
 	"errors"
 	"fmt"
+	"path/filepath"
+	"slices"
+	"strings"
 
 	"github.com/hashicorp/terraform-plugin-framework-validators/setvalidator"
 	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
@@ -161,20 +164,105 @@ func (r *usbResource) Delete(ctx context.Context, req resource.DeleteRequest, re
 }
 
 // ImportState imports a USB hardware mapping from the Proxmox VE API.
+// The import ID is either the name of the mapping, or a node-scoped glob in the form of "<node>/<pattern>"
+// (e.g. "node1/*") that is resolved against all USB mappings with at least one entry on the given node.
 func (r *usbResource) ImportState(
 	ctx context.Context,
 	req resource.ImportStateRequest,
 	resp *resource.ImportStateResponse,
 ) {
+	hmName := req.ID
+
+	if nodeName, pattern, ok := strings.Cut(req.ID, "/"); ok {
+		list, err := r.client.List(ctx, proxmoxtypes.TypeUSB, "")
+		if err != nil {
+			resp.Diagnostics.AddError("Could not list USB hardware mappings", err.Error())
+
+			return
+		}
+
+		names, err := matchUSBMappingsOnNode(list, nodeName, pattern)
+		if err != nil {
+			resp.Diagnostics.AddError(fmt.Sprintf("Invalid USB hardware mapping import ID %q", req.ID), err.Error())
+
+			return
+		}
+
+		switch len(names) {
+		case 0:
+			resp.Diagnostics.AddError(
+				fmt.Sprintf("No USB hardware mapping matches %q", req.ID),
+				fmt.Sprintf("No USB hardware mapping with a name matching %q has an entry on node %q.", pattern, nodeName),
+			)
+
+			return
+		case 1:
+			hmName = names[0]
+		default:
+			// Terraform imports exactly one resource instance per import ID, so the matches are reported back to be used in
+			// an "import" block with "for_each".
+			resp.Diagnostics.AddError(
+				fmt.Sprintf("Multiple USB hardware mappings match %q", req.ID),
+				fmt.Sprintf(
+					"Each matching mapping must be imported into its own resource instance, e.g. by using an \"import\" "+
+						"block with \"for_each\" over the following names: %s",
+					strings.Join(names, ", "),
+				),
+			)
+
+			return
+		}
+	}
+
 	data := modelUSB{
-		ID:   types.StringValue(req.ID),
-		Name: types.StringValue(req.ID),
+		ID:   types.StringValue(hmName),
+		Name: types.StringValue(hmName),
+	}
+
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root(schemaAttrNameTerraformID), hmName)...)
+
+	if resp.Diagnostics.HasError() {
+		return
 	}
 
-	resource.ImportStatePassthroughID(ctx, path.Root(schemaAttrNameTerraformID), req, resp)
 	r.readBack(ctx, &data, &resp.Diagnostics, &resp.State)
 }
 
+// matchUSBMappingsOnNode returns the sorted names of all USB hardware mappings matching the given glob pattern that
+// have at least one map entry on the given node.
+// A mapping that exists on multiple nodes is a single Proxmox VE mapping with multiple map entries, so it is only ever
+// returned once.
+func matchUSBMappingsOnNode(list []*mappings.ListResponseData, nodeName string, pattern string) ([]string, error) {
+	if nodeName == "" {
+		return nil, errors.New("the node name must not be empty")
+	}
+
+	if _, err := filepath.Match(pattern, ""); err != nil {
+		return nil, fmt.Errorf("invalid mapping name pattern %q: %w", pattern, err)
+	}
+
+	var names []string
+
+	for _, data := range list {
+		if data == nil {
+			continue
+		}
+
+		// The pattern has already been validated above, so the error can be safely ignored.
+		if matched, _ := filepath.Match(pattern, data.ID); !matched {
+			continue
+		}
+
+		if slices.ContainsFunc(data.Map, func(m proxmoxtypes.Map) bool { return m.Node == nodeName }) {
+			names = append(names, data.ID)
+		}
+	}
+
+	slices.Sort(names)
+
+	return slices.Compact(names), nil
+}
+
 // Metadata defines the name of the USB hardware mapping.
 func (r *usbResource) Metadata(_ context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
 	resp.TypeName = req.ProviderTypeName + "_hardware_mapping_usb"
@@ -0,0 +1,69 @@
+/*
+ * This Source Code Form is subject to the terms of the Mozilla Public
+ * License, v. 2.0. If a copy of the MPL was not distributed with this
+ * file, You can obtain one at https://mozilla.org/MPL/2.0/.
+ */
+
+package hardwaremapping
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	mappings "github.com/bpg/terraform-provider-proxmox/proxmox/cluster/mapping"
+	proxmoxtypes "github.com/bpg/terraform-provider-proxmox/proxmox/types/hardwaremapping"
+)
+
+func usbListEntry(id string, nodes ...string) *mappings.ListResponseData {
+	entry := &mappings.ListResponseData{ID: id, Type: proxmoxtypes.TypeUSB}
+
+	for _, node := range nodes {
+		entry.Map = append(entry.Map, proxmoxtypes.Map{ID: "8086:2668", Node: node})
+	}
+
+	return entry
+}
+
+func TestMatchUSBMappingsOnNode(t *testing.T) {
+	t.Parallel()
+
+	list := []*mappings.ListResponseData{
+		usbListEntry("token-b", "node1", "node2"),
+		usbListEntry("token-a", "node1"),
+		usbListEntry("dongle", "node2"),
+		nil,
+	}
+
+	tests := []struct {
+		name     string
+		node     string
+		pattern  string
+		expected []string
+		wantErr  bool
+	}{
+		{"all on node1", "node1", "*", []string{"token-a", "token-b"}, false},
+		{"multi-node mapping is returned once", "node2", "token-*", []string{"token-b"}, false},
+		{"exact name", "node2", "dongle", []string{"dongle"}, false},
+		{"no match on node", "node3", "*", nil, false},
+		{"empty node", "", "*", nil, true},
+		{"invalid pattern", "node1", "[", nil, true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			names, err := matchUSBMappingsOnNode(list, tt.node, tt.pattern)
+			if tt.wantErr {
+				require.Error(t, err)
+
+				return
+			}
+
+			require.NoError(t, err)
+			assert.Equal(t, tt.expected, names)
+		})
+	}
+}
@@ -12,25 +12,32 @@ import (
 	"fmt"
 	"regexp"
 
+	"github.com/hashicorp/terraform-plugin-framework-validators/int64validator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/resourcevalidator"
 	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/booldefault"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/int64planmodifier"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
 	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
 
 	"github.com/bpg/terraform-provider-proxmox/fwprovider/attribute"
 	"github.com/bpg/terraform-provider-proxmox/fwprovider/config"
 	"github.com/bpg/terraform-provider-proxmox/fwprovider/migration"
 	"github.com/bpg/terraform-provider-proxmox/proxmox/api"
 	"github.com/bpg/terraform-provider-proxmox/proxmox/cluster"
+	"github.com/bpg/terraform-provider-proxmox/proxmox/cluster/replications"
 )
 
 var (
-	_ resource.Resource                = &Resource{}
-	_ resource.ResourceWithConfigure   = &Resource{}
-	_ resource.ResourceWithImportState = &Resource{}
+	_ resource.Resource                     = &Resource{}
+	_ resource.ResourceWithConfigure        = &Resource{}
+	_ resource.ResourceWithConfigValidators = &Resource{}
+	_ resource.ResourceWithImportState      = &Resource{}
 )
 
 type Resource struct {
@@ -73,9 +80,13 @@ func (r *Resource) Schema(_ context.Context, _ resource.SchemaRequest, resp *res
 		Description:        "Manages Proxmox VE Replication.",
 		Attributes: map[string]schema.Attribute{
 			"id": schema.StringAttribute{
-				Description: "Replication Job ID. The ID is composed of a Guest ID and a job number, separated by a hyphen, i.e. '<GUEST>-<JOBNUM>'.",
-				Required:    true,
+				Description: "Replication Job ID. The ID is composed of a Guest ID and a job number, separated by a hyphen, " +
+					"i.e. '<GUEST>-<JOBNUM>'. When not set, `guest` must be set and the next free job number of " +
+					"the guest is used.",
+				Optional: true,
+				Computed: true,
 				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
 					stringplanmodifier.RequiresReplace(),
 				},
 				Validators: []validator.String{
@@ -127,8 +138,17 @@ func (r *Resource) Schema(_ context.Context, _ resource.SchemaRequest, resp *res
 				Description: "For internal use, to detect if the guest was stolen.",
 			},
 			"guest": schema.Int64Attribute{
-				Computed:    true,
-				Description: "Guest ID.",
+				Optional: true,
+				Computed: true,
+				Description: "Guest ID. Set it instead of `id` to create the replication with the next free " +
+					"job number of the guest.",
+				PlanModifiers: []planmodifier.Int64{
+					int64planmodifier.UseStateForUnknown(),
+					int64planmodifier.RequiresReplace(),
+				},
+				Validators: []validator.Int64{
+					int64validator.Between(100, 999999999),
+				},
 			},
 			"jobnum": schema.Int64Attribute{
 				Computed:    true,
@@ -138,6 +158,15 @@ func (r *Resource) Schema(_ context.Context, _ resource.SchemaRequest, resp *res
 	}
 }
 
+func (r *Resource) ConfigValidators(_ context.Context) []resource.ConfigValidator {
+	return []resource.ConfigValidator{
+		resourcevalidator.ExactlyOneOf(
+			path.MatchRoot("id"),
+			path.MatchRoot("guest"),
+		),
+	}
+}
+
 func (r *Resource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
 	var plan model
 
@@ -147,6 +176,16 @@ func (r *Resource) Create(ctx context.Context, req resource.CreateRequest, resp
 		return
 	}
 
+	if plan.ID.IsUnknown() || plan.ID.IsNull() {
+		jobs, err := r.client.Replication("").GetReplications(ctx)
+		if err != nil {
+			resp.Diagnostics.AddError("Unable to List Replications", err.Error())
+			return
+		}
+
+		plan.ID = types.StringValue(nextReplicationID(plan.Guest.ValueInt64(), jobs))
+	}
+
 	repl := plan.toAPICreate()
 
 	err := r.client.Replication(plan.ID.ValueString()).CreateReplication(ctx, repl)
@@ -189,12 +228,43 @@ func (r *Resource) Read(ctx context.Context, req resource.ReadRequest, resp *res
 		return
 	}
 
+	// A job may outlive its guest when the guest is destroyed without purging it,
+	// such a job can't be managed anymore.
+	if _, err = r.client.GetVMNodeName(ctx, int(data.Guest)); err != nil {
+		if errors.Is(err, cluster.ErrVMDoesNotExist) {
+			resp.State.RemoveResource(ctx)
+			return
+		}
+
+		resp.Diagnostics.AddError("Unable to Read Replication Guest", err.Error())
+
+		return
+	}
+
 	readModel := &model{}
 	readModel.fromAPI(state.ID.ValueString(), data)
 
 	resp.Diagnostics.Append(resp.State.Set(ctx, readModel)...)
 }
 
+// nextReplicationID returns the ID of the replication job with the lowest job number not yet used by the guest.
+func nextReplicationID(guest int64, jobs []replications.ReplicationData) string {
+	used := map[int64]bool{}
+
+	for _, job := range jobs {
+		if job.Guest == guest {
+			used[job.JobNum] = true
+		}
+	}
+
+	var jobNum int64
+	for used[jobNum] {
+		jobNum++
+	}
+
+	return fmt.Sprintf("%d-%d", guest, jobNum)
+}
+
 func (r *Resource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
 	var plan model
 
@@ -276,10 +346,11 @@ func (r *Resource) ImportState(ctx context.Context, req resource.ImportStateRequ
 // Short-name alias for the replication resource (ADR-007).
 
 var (
-	_ resource.Resource                = &resourceShort{}
-	_ resource.ResourceWithConfigure   = &resourceShort{}
-	_ resource.ResourceWithImportState = &resourceShort{}
-	_ resource.ResourceWithMoveState   = &resourceShort{}
+	_ resource.Resource                     = &resourceShort{}
+	_ resource.ResourceWithConfigure        = &resourceShort{}
+	_ resource.ResourceWithConfigValidators = &resourceShort{}
+	_ resource.ResourceWithImportState      = &resourceShort{}
+	_ resource.ResourceWithMoveState        = &resourceShort{}
 )
 
 // resourceShort is the short-name alias for the replication resource.
@@ -229,6 +229,30 @@ func TestAccResourceReplication(t *testing.T) {
 				}
 			}(),
 		},
+		{"create replication with the next free job number", []resource.TestStep{
+			func() resource.TestStep {
+				cid := newCID()
+				guest := fmt.Sprintf("%d", cid)
+				return resource.TestStep{
+					Config: renderConfigWithCT(te, cid, `
+
+				resource "proxmox_replication" "test_replication" {
+					guest  = proxmox_virtual_environment_container.test_container.id
+					target = "{{.Node2Name}}"
+					type   = "local"
+				}
+					`),
+					Check: resource.ComposeTestCheckFunc(
+						test.ResourceAttributes("proxmox_replication.test_replication", map[string]string{
+							"id":     fmt.Sprintf("%d-0", cid),
+							"target": te.Node2Name,
+							"jobnum": "0",
+							"guest":  guest,
+						}),
+					),
+				}
+			}(),
+		}},
 		{"create disabled replication", []resource.TestStep{
 			func() resource.TestStep {
 				cid := newCID()
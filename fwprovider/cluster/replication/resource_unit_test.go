@@ -0,0 +1,48 @@
+/*
+ * This Source Code Form is subject to the terms of the Mozilla Public
+ * License, v. 2.0. If a copy of the MPL was not distributed with this
+ * file, You can obtain one at https://mozilla.org/MPL/2.0/.
+ */
+
+package replication
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/bpg/terraform-provider-proxmox/proxmox/cluster/replications"
+)
+
+func TestNextReplicationID(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name     string
+		guest    int64
+		jobs     []replications.ReplicationData
+		expected string
+	}{
+		{"no jobs", 100, nil, "100-0"},
+		{"jobs of other guests only", 100, []replications.ReplicationData{
+			{Guest: 101, JobNum: 0},
+			{Guest: 101, JobNum: 1},
+		}, "100-0"},
+		{"next after existing jobs", 100, []replications.ReplicationData{
+			{Guest: 100, JobNum: 0},
+			{Guest: 100, JobNum: 1},
+		}, "100-2"},
+		{"fills a gap", 100, []replications.ReplicationData{
+			{Guest: 100, JobNum: 0},
+			{Guest: 100, JobNum: 2},
+		}, "100-1"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			assert.Equal(t, tt.expected, nextReplicationID(tt.guest, tt.jobs))
+		})
+	}
+}
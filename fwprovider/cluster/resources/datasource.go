@@ -0,0 +1,175 @@
+/*
+ * This Source Code Form is subject to the terms of the Mozilla Public
+ * License, v. 2.0. If a copy of the MPL was not distributed with this
+ * file, You can obtain one at https://mozilla.org/MPL/2.0/.
+ */
+
+package resources
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+
+	"github.com/bpg/terraform-provider-proxmox/fwprovider/config"
+	"github.com/bpg/terraform-provider-proxmox/proxmox"
+)
+
+// Ensure the implementation satisfies the expected interfaces.
+var (
+	_ datasource.DataSource              = &DataSource{}
+	_ datasource.DataSourceWithConfigure = &DataSource{}
+)
+
+// NewDataSource creates the proxmox_cluster_resources data source.
+func NewDataSource() datasource.DataSource {
+	return &DataSource{}
+}
+
+// DataSource is the proxmox_cluster_resources data source.
+type DataSource struct {
+	client proxmox.Client
+}
+
+// Metadata returns the data source type name.
+func (d *DataSource) Metadata(
+	_ context.Context,
+	_ datasource.MetadataRequest,
+	resp *datasource.MetadataResponse,
+) {
+	resp.TypeName = "proxmox_cluster_resources"
+}
+
+// Schema defines the schema for the data source.
+func (d *DataSource) Schema(
+	_ context.Context,
+	_ datasource.SchemaRequest,
+	resp *datasource.SchemaResponse,
+) {
+	resp.Schema = schema.Schema{
+		Description: "Retrieves the resources of a Proxmox VE cluster (guests, storages, nodes and SDN zones) " +
+			"with a single API call.",
+		Attributes: map[string]schema.Attribute{
+			"type": schema.StringAttribute{
+				Description: "The resource type to filter by: `vm` (both VMs and containers), `storage`, `node` or `sdn`. " +
+					"All resources are returned when omitted.",
+				Optional: true,
+				Validators: []validator.String{
+					stringvalidator.OneOf("vm", "storage", "node", "sdn"),
+				},
+			},
+			"resources": schema.ListNestedAttribute{
+				Description: "The list of cluster resources.",
+				Computed:    true,
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						"id": schema.StringAttribute{
+							Description: "The resource identifier, e.g. `qemu/100` or `storage/pve/local`.",
+							Computed:    true,
+						},
+						"type": schema.StringAttribute{
+							Description: "The resource type, e.g. `qemu`, `lxc`, `storage`, `node` or `sdn`.",
+							Computed:    true,
+						},
+						"name": schema.StringAttribute{
+							Description: "The name of the guest or node.",
+							Computed:    true,
+						},
+						"node_name": schema.StringAttribute{
+							Description: "The name of the node the resource is on.",
+							Computed:    true,
+						},
+						"vm_id": schema.Int64Attribute{
+							Description: "The VM or container identifier.",
+							Computed:    true,
+						},
+						"status": schema.StringAttribute{
+							Description: "The resource status, e.g. `running`, `stopped` or `online`.",
+							Computed:    true,
+						},
+						"cpu": schema.Float64Attribute{
+							Description: "The CPU utilization as a fraction of `max_cpu`.",
+							Computed:    true,
+						},
+						"max_cpu": schema.Float64Attribute{
+							Description: "The number of available CPUs.",
+							Computed:    true,
+						},
+						"mem": schema.Int64Attribute{
+							Description: "The used memory in bytes.",
+							Computed:    true,
+						},
+						"max_mem": schema.Int64Attribute{
+							Description: "The available memory in bytes.",
+							Computed:    true,
+						},
+						"disk": schema.Int64Attribute{
+							Description: "The used disk space in bytes.",
+							Computed:    true,
+						},
+						"max_disk": schema.Int64Attribute{
+							Description: "The available disk space in bytes.",
+							Computed:    true,
+						},
+						"uptime": schema.Int64Attribute{
+							Description: "The uptime in seconds.",
+							Computed:    true,
+						},
+						"template": schema.BoolAttribute{
+							Description: "Whether the guest is a template.",
+							Computed:    true,
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+// Configure adds the provider-configured client to the data source.
+func (d *DataSource) Configure(
+	_ context.Context,
+	req datasource.ConfigureRequest,
+	resp *datasource.ConfigureResponse,
+) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	cfg, ok := req.ProviderData.(config.DataSource)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected DataSource Configure Type",
+			fmt.Sprintf("Expected config.DataSource, got: %T", req.ProviderData),
+		)
+
+		return
+	}
+
+	d.client = cfg.Client
+}
+
+// Read fetches the cluster resources, optionally filtered by type.
+func (d *DataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	var state model
+
+	resp.Diagnostics.Append(req.Config.Get(ctx, &state)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	data, err := d.client.Cluster().GetClusterResources(ctx, state.Type.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddError("Unable to Read Cluster Resources", err.Error())
+		return
+	}
+
+	state.fromAPI(data)
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &state)...)
+}
@@ -0,0 +1,53 @@
+//go:build acceptance || all
+
+//testacc:tier=light
+//testacc:resource=misc
+
+/*
+ * This Source Code Form is subject to the terms of the Mozilla Public
+ * License, v. 2.0. If a copy of the MPL was not distributed with this
+ * file, You can obtain one at https://mozilla.org/MPL/2.0/.
+ */
+
+package resources_test
+
+import (
+	"regexp"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+
+	"github.com/bpg/terraform-provider-proxmox/fwprovider/test"
+)
+
+func TestAccDataSourceClusterResources(t *testing.T) {
+	t.Parallel()
+
+	te := test.InitEnvironment(t)
+
+	resource.Test(t, resource.TestCase{
+		ProtoV6ProviderFactories: te.AccProviders,
+		Steps: []resource.TestStep{
+			{
+				Config: te.RenderConfig(`
+					data "proxmox_cluster_resources" "all" {}
+
+					data "proxmox_cluster_resources" "nodes" {
+						type = "node"
+					}`),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttrSet("data.proxmox_cluster_resources.all", "resources.0.id"),
+					resource.TestCheckTypeSetElemNestedAttrs("data.proxmox_cluster_resources.nodes", "resources.*", map[string]string{
+						"id":        "node/" + te.NodeName,
+						"type":      "node",
+						"node_name": te.NodeName,
+					}),
+				),
+			},
+			{
+				Config:      `data "proxmox_cluster_resources" "invalid" { type = "pool" }`,
+				ExpectError: regexp.MustCompile(`Invalid Attribute Value Match`),
+			},
+		},
+	})
+}
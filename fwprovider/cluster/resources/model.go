@@ -0,0 +1,80 @@
+/*
+ * This Source Code Form is subject to the terms of the Mozilla Public
+ * License, v. 2.0. If a copy of the MPL was not distributed with this
+ * file, You can obtain one at https://mozilla.org/MPL/2.0/.
+ */
+
+package resources
+
+import (
+	"github.com/hashicorp/terraform-plugin-framework/types"
+
+	"github.com/bpg/terraform-provider-proxmox/proxmox/cluster"
+)
+
+// model is the Terraform-side representation of the cluster resources data source.
+type model struct {
+	Type      types.String    `tfsdk:"type"`
+	Resources []resourceModel `tfsdk:"resources"`
+}
+
+// resourceModel maps a single entry of the cluster resources list.
+type resourceModel struct {
+	ID       types.String  `tfsdk:"id"`
+	Type     types.String  `tfsdk:"type"`
+	Name     types.String  `tfsdk:"name"`
+	NodeName types.String  `tfsdk:"node_name"`
+	VMID     types.Int64   `tfsdk:"vm_id"`
+	Status   types.String  `tfsdk:"status"`
+	CPU      types.Float64 `tfsdk:"cpu"`
+	MaxCPU   types.Float64 `tfsdk:"max_cpu"`
+	Mem      types.Int64   `tfsdk:"mem"`
+	MaxMem   types.Int64   `tfsdk:"max_mem"`
+	Disk     types.Int64   `tfsdk:"disk"`
+	MaxDisk  types.Int64   `tfsdk:"max_disk"`
+	Uptime   types.Int64   `tfsdk:"uptime"`
+	Template types.Bool    `tfsdk:"template"`
+}
+
+// fromAPI populates the resources list from the API response, in the order returned by the API.
+// Fields that don't apply to a resource type (e.g. `vm_id` of a storage) are null.
+func (m *model) fromAPI(data []*cluster.ResourcesListResponseData) {
+	m.Resources = make([]resourceModel, 0, len(data))
+
+	for _, r := range data {
+		if r == nil {
+			continue
+		}
+
+		entry := resourceModel{
+			ID:       types.StringValue(r.ID),
+			Type:     types.StringValue(r.Type),
+			Name:     stringOrNull(r.Name),
+			NodeName: stringOrNull(r.NodeName),
+			VMID:     types.Int64Null(),
+			Status:   stringOrNull(r.Status),
+			CPU:      types.Float64Value(r.CPU),
+			MaxCPU:   types.Float64Value(r.MaxCPU),
+			Mem:      types.Int64Value(r.Mem),
+			MaxMem:   types.Int64Value(r.MaxMem),
+			Disk:     types.Int64Value(r.Disk),
+			MaxDisk:  types.Int64Value(r.MaxDisk),
+			Uptime:   types.Int64Value(int64(r.Uptime)),
+			Template: types.BoolValue(bool(r.Template)),
+		}
+
+		if r.VMID != 0 {
+			entry.VMID = types.Int64Value(int64(r.VMID))
+		}
+
+		m.Resources = append(m.Resources, entry)
+	}
+}
+
+func stringOrNull(s string) types.String {
+	if s == "" {
+		return types.StringNull()
+	}
+
+	return types.StringValue(s)
+}
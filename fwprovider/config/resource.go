@@ -7,6 +7,8 @@
 package config
 
 import (
+	"time"
+
 	"github.com/bpg/terraform-provider-proxmox/proxmox"
 	"github.com/bpg/terraform-provider-proxmox/proxmox/cluster"
 )
@@ -16,4 +18,10 @@ type Resource struct {
 	Client proxmox.Client
 
 	IDGenerator cluster.IDGenerator
+
+	// ForceDelete clears the protection flag of VMs and containers before they are deleted.
+	ForceDelete bool
+
+	// VMShutdownTimeout is the time to wait for a VM to shut down gracefully, the resource default is used when 0.
+	VMShutdownTimeout time.Duration
 }
@@ -0,0 +1,179 @@
+/*
+ * This Source Code Form is subject to the terms of the Mozilla Public
+ * License, v. 2.0. If a copy of the MPL was not distributed with this
+ * file, You can obtain one at https://mozilla.org/MPL/2.0/.
+ */
+
+package apt
+
+import (
+	"context"
+	"fmt"
+	"sort"
+
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+
+	"github.com/bpg/terraform-provider-proxmox/fwprovider/config"
+	"github.com/bpg/terraform-provider-proxmox/fwprovider/validators"
+	"github.com/bpg/terraform-provider-proxmox/proxmox"
+	api "github.com/bpg/terraform-provider-proxmox/proxmox/nodes/apt/updates"
+)
+
+// Ensure the implementation satisfies the required interfaces.
+var (
+	_ datasource.DataSource              = &updatesDataSource{}
+	_ datasource.DataSourceWithConfigure = &updatesDataSource{}
+)
+
+// modelUpdates maps the schema data for the APT updates data source.
+type modelUpdates struct {
+	Node    types.String        `tfsdk:"node"`
+	Updates []modelUpdatesEntry `tfsdk:"updates"`
+}
+
+// modelUpdatesEntry maps the schema data for an available package update.
+type modelUpdatesEntry struct {
+	Package    types.String `tfsdk:"package"`
+	OldVersion types.String `tfsdk:"old_version"`
+	NewVersion types.String `tfsdk:"new_version"`
+	Priority   types.String `tfsdk:"priority"`
+}
+
+// importFromAPI imports the contents of the APT updates list from the Proxmox VE API's response data.
+func (m *modelUpdates) importFromAPI(data []*api.GetResponseData) {
+	m.Updates = make([]modelUpdatesEntry, 0, len(data))
+
+	for _, u := range data {
+		if u == nil {
+			continue
+		}
+
+		m.Updates = append(m.Updates, modelUpdatesEntry{
+			Package:    types.StringValue(u.Package),
+			OldVersion: types.StringPointerValue(u.OldVersion),
+			NewVersion: types.StringValue(u.Version),
+			Priority:   types.StringPointerValue(u.Priority),
+		})
+	}
+
+	sort.Slice(m.Updates, func(i, j int) bool {
+		return m.Updates[i].Package.ValueString() < m.Updates[j].Package.ValueString()
+	})
+}
+
+// updatesDataSource is the data source implementation for the available APT package updates.
+type updatesDataSource struct {
+	// client is the Proxmox VE API client.
+	client proxmox.Client
+}
+
+// Configure adds the provider-configured client to the data source.
+func (d *updatesDataSource) Configure(
+	_ context.Context,
+	req datasource.ConfigureRequest,
+	resp *datasource.ConfigureResponse,
+) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	cfg, ok := req.ProviderData.(config.DataSource)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected DataSource Configure Type",
+			fmt.Sprintf("Expected config.DataSource, got: %T", req.ProviderData),
+		)
+
+		return
+	}
+
+	d.client = cfg.Client
+}
+
+// Metadata returns the data source type name.
+func (d *updatesDataSource) Metadata(
+	_ context.Context,
+	_ datasource.MetadataRequest,
+	resp *datasource.MetadataResponse,
+) {
+	resp.TypeName = "proxmox_apt_updates"
+}
+
+// Read fetches the available package updates from the Proxmox VE API.
+func (d *updatesDataSource) Read(
+	ctx context.Context,
+	req datasource.ReadRequest,
+	resp *datasource.ReadResponse,
+) {
+	var model modelUpdates
+
+	resp.Diagnostics.Append(req.Config.Get(ctx, &model)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	data, err := d.client.Node(model.Node.ValueString()).APT().Updates().List(ctx)
+	if err != nil {
+		resp.Diagnostics.AddError("Could not read APT package updates", err.Error())
+
+		return
+	}
+
+	model.importFromAPI(data)
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &model)...)
+}
+
+// Schema defines the schema for the APT updates data source.
+func (d *updatesDataSource) Schema(
+	_ context.Context,
+	_ datasource.SchemaRequest,
+	resp *datasource.SchemaResponse,
+) {
+	resp.Schema = schema.Schema{
+		Description: "Retrieves the list of package updates available on a Proxmox VE node. " +
+			"The list reflects the node's package index as of its last `apt update` run.",
+		Attributes: map[string]schema.Attribute{
+			SchemaAttrNameNode: schema.StringAttribute{
+				Description: "The name of the target Proxmox VE node.",
+				Required:    true,
+				Validators: []validator.String{
+					validators.NonEmptyString(),
+				},
+			},
+			"updates": schema.ListNestedAttribute{
+				Description: "The list of available package updates, sorted by package name.",
+				Computed:    true,
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						"package": schema.StringAttribute{
+							Description: "The name of the package.",
+							Computed:    true,
+						},
+						"old_version": schema.StringAttribute{
+							Description: "The currently installed version of the package.",
+							Computed:    true,
+						},
+						"new_version": schema.StringAttribute{
+							Description: "The version of the package that is available for installation.",
+							Computed:    true,
+						},
+						"priority": schema.StringAttribute{
+							Description: "The priority of the package, e.g. `important` or `optional`.",
+							Computed:    true,
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+// NewUpdatesDataSource returns a new data source for the available APT package updates.
+func NewUpdatesDataSource() datasource.DataSource {
+	return &updatesDataSource{}
+}
@@ -158,6 +158,31 @@ func TestAccDataSourceStandardRepo(t *testing.T) {
 	}
 }
 
+func TestAccDataSourceUpdates(t *testing.T) {
+	t.Parallel()
+
+	te := test.InitEnvironment(t)
+
+	resource.ParallelTest(
+		t, resource.TestCase{
+			ProtoV6ProviderFactories: te.AccProviders,
+			Steps: []resource.TestStep{
+				{
+					Config: te.RenderConfig(`
+					data "proxmox_apt_updates" "test" {
+						node = "{{.NodeName}}"
+					}`),
+					// The list may legitimately be empty on an up-to-date node.
+					Check: test.ResourceAttributesSet("data.proxmox_apt_updates.test", []string{
+						apt.SchemaAttrNameNode,
+						"updates.#",
+					}),
+				},
+			},
+		},
+	)
+}
+
 // Run tests for APT repository resource definitions with valid input where all required attributes are specified.
 // Only the [Create], [Read] and [Update] method implementations of the
 // [github.com/hashicorp/terraform-plugin-framework/resource.Resource] interface are tested in sequential steps because
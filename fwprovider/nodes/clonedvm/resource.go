@@ -23,6 +23,7 @@ import (
 	"github.com/hashicorp/terraform-plugin-log/tflog"
 
 	"github.com/bpg/terraform-provider-proxmox/fwprovider/config"
+	"github.com/bpg/terraform-provider-proxmox/fwprovider/nodes/vm"
 	"github.com/bpg/terraform-provider-proxmox/fwprovider/nodes/vm/cdrom"
 	"github.com/bpg/terraform-provider-proxmox/fwprovider/nodes/vm/cpu"
 	"github.com/bpg/terraform-provider-proxmox/fwprovider/nodes/vm/memory"
@@ -56,6 +57,9 @@ var (
 type Resource struct {
 	client      proxmox.Client
 	idGenerator cluster.IDGenerator
+	forceDelete bool
+	// shutdownTimeout overrides defaultShutdownTimeout when set
+	shutdownTimeout time.Duration
 }
 
 // NewResource creates the cloned VM resource.
@@ -94,6 +98,8 @@ func (r *Resource) Configure(
 
 	r.client = cfg.Client
 	r.idGenerator = cfg.IDGenerator
+	r.forceDelete = cfg.ForceDelete
+	r.shutdownTimeout = cfg.VMShutdownTimeout
 }
 
 // Create clones and configures the VM.
@@ -316,7 +322,7 @@ func (r *Resource) Update(ctx context.Context, req resource.UpdateRequest, resp
 					return
 				}
 			} else {
-				if vmShutdown(ctx, vmAPI).AddDiags(&resp.Diagnostics, "VM shutdown") {
+				if vmShutdown(ctx, vmAPI, r.shutdownTimeout).AddDiags(&resp.Diagnostics, "VM shutdown") {
 					return
 				}
 			}
@@ -370,12 +376,18 @@ func (r *Resource) Delete(ctx context.Context, req resource.DeleteRequest, resp
 		return
 	}
 
+	vm.ClearProtection(ctx, vmAPI, r.forceDelete, &resp.Diagnostics)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
 	if status != nil && status.Status != "stopped" {
 		// Stop/shutdown failures during delete are non-fatal — reported as warnings.
 		if state.StopOnDestroy.ValueBool() {
 			vmStop(ctx, vmAPI).AddDiagsAsWarnings(&resp.Diagnostics, "VM stop/shutdown")
 		} else {
-			vmShutdown(ctx, vmAPI).AddDiagsAsWarnings(&resp.Diagnostics, "VM stop/shutdown")
+			vmShutdown(ctx, vmAPI, r.shutdownTimeout).AddDiagsAsWarnings(&resp.Diagnostics, "VM stop/shutdown")
 		}
 	}
 
@@ -1010,7 +1022,7 @@ func isValidDiskSlot(slot string) bool {
 }
 
 // Shutdown the VM, then wait for it to actually shut down.
-func vmShutdown(ctx context.Context, vmAPI *vms.Client) tasks.TaskResult {
+func vmShutdown(ctx context.Context, vmAPI *vms.Client, shutdownTimeout time.Duration) tasks.TaskResult {
 	tflog.Debug(ctx, "Shutting down VM")
 
 	shutdownTimeoutSec := int(defaultShutdownTimeout.Seconds())
@@ -1019,6 +1031,10 @@ func vmShutdown(ctx context.Context, vmAPI *vms.Client) tasks.TaskResult {
 		shutdownTimeoutSec = int(time.Until(dl).Seconds())
 	}
 
+	if shutdownTimeout > 0 {
+		shutdownTimeoutSec = int(shutdownTimeout.Seconds())
+	}
+
 	result := vmAPI.ShutdownVM(ctx, &vms.ShutdownRequestBody{
 		ForceStop: proxmoxtypes.CustomBool(true).Pointer(),
 		Timeout:   &shutdownTimeoutSec,
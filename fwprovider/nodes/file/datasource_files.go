@@ -11,6 +11,7 @@ import (
 	"fmt"
 	"regexp"
 	"strings"
+	"time"
 
 	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
 	"github.com/hashicorp/terraform-plugin-framework/datasource"
@@ -40,12 +41,13 @@ type listModel struct {
 
 // listFileEntry represents a single file in the list data source output.
 type listFileEntry struct {
-	ID          types.String `tfsdk:"id"`
-	ContentType types.String `tfsdk:"content_type"`
-	FileName    types.String `tfsdk:"file_name"`
-	FileFormat  types.String `tfsdk:"file_format"`
-	FileSize    types.Int64  `tfsdk:"file_size"`
-	VMID        types.Int64  `tfsdk:"vmid"`
+	ID           types.String `tfsdk:"id"`
+	ContentType  types.String `tfsdk:"content_type"`
+	CreationTime types.String `tfsdk:"creation_time"`
+	FileName     types.String `tfsdk:"file_name"`
+	FileFormat   types.String `tfsdk:"file_format"`
+	FileSize     types.Int64  `tfsdk:"file_size"`
+	VMID         types.Int64  `tfsdk:"vmid"`
 }
 
 // listDatasource is the implementation of the files list data source.
@@ -121,6 +123,11 @@ func (d *listDatasource) Schema(
 							Description: "The content type of the file.",
 							Computed:    true,
 						},
+						"creation_time": schema.StringAttribute{
+							Description: "The creation time of the file in RFC3339 format, if reported " +
+								"by the datastore, e.g. to find the newest backup of a VM.",
+							Computed: true,
+						},
 						"file_name": schema.StringAttribute{
 							Description: "The name of the file.",
 							Computed:    true,
@@ -239,6 +246,12 @@ func (d *listDatasource) Read(ctx context.Context, req datasource.ReadRequest, r
 			FileSize:    types.Int64Value(apiFile.FileSize),
 		}
 
+		if apiFile.CreationTime != nil {
+			file.CreationTime = types.StringValue(time.Time(*apiFile.CreationTime).Format(time.RFC3339))
+		} else {
+			file.CreationTime = types.StringNull()
+		}
+
 		if apiFile.VMID != nil {
 			file.VMID = types.Int64Value(int64(*apiFile.VMID))
 		} else {
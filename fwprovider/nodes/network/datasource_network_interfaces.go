@@ -0,0 +1,184 @@
+/*
+ * This Source Code Form is subject to the terms of the Mozilla Public
+ * License, v. 2.0. If a copy of the MPL was not distributed with this
+ * file, You can obtain one at https://mozilla.org/MPL/2.0/.
+ */
+
+package network
+
+import (
+	"context"
+	"fmt"
+	"sort"
+
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+
+	"github.com/bpg/terraform-provider-proxmox/fwprovider/config"
+	"github.com/bpg/terraform-provider-proxmox/proxmox"
+)
+
+var (
+	_ datasource.DataSource              = &networkInterfacesDataSource{}
+	_ datasource.DataSourceWithConfigure = &networkInterfacesDataSource{}
+)
+
+// networkInterfacesDataSource is the implementation of the proxmox_node_network_interfaces data source.
+type networkInterfacesDataSource struct {
+	client proxmox.Client
+}
+
+// NewNetworkInterfacesDataSource creates a new node network interfaces data source.
+func NewNetworkInterfacesDataSource() datasource.DataSource {
+	return &networkInterfacesDataSource{}
+}
+
+// Metadata defines the data source type name.
+func (d *networkInterfacesDataSource) Metadata(
+	_ context.Context,
+	_ datasource.MetadataRequest,
+	resp *datasource.MetadataResponse,
+) {
+	resp.TypeName = "proxmox_node_network_interfaces"
+}
+
+// Schema defines the schema for the data source.
+func (d *networkInterfacesDataSource) Schema(
+	_ context.Context,
+	_ datasource.SchemaRequest,
+	resp *datasource.SchemaResponse,
+) {
+	resp.Schema = schema.Schema{
+		Description: "Retrieves the list of network interfaces configured on a specific Proxmox VE node.",
+		MarkdownDescription: "Retrieves the list of network interfaces configured on a specific Proxmox VE node. " +
+			"This is useful for discovering physical NICs before creating " +
+			"[bridges](https://registry.terraform.io/providers/bpg/proxmox/latest/docs/resources/network_linux_bridge) " +
+			"or bonds, and for detecting which NICs are already enslaved to a bond.",
+		Attributes: map[string]schema.Attribute{
+			"node_name": schema.StringAttribute{
+				Description: "The name of the node to list network interfaces from.",
+				Required:    true,
+			},
+			"type": schema.StringAttribute{
+				Description: "Only return interfaces of this type (e.g. `eth`, `bond`, `bridge` or `vlan`).",
+				Optional:    true,
+			},
+			"interfaces": schema.ListNestedAttribute{
+				Description: "The list of network interfaces, sorted by name.",
+				Computed:    true,
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						"iface": schema.StringAttribute{
+							Description: "The interface name (e.g. `eno1` or `vmbr0`).",
+							Computed:    true,
+						},
+						"type": schema.StringAttribute{
+							Description: "The interface type (e.g. `eth`, `bond`, `bridge` or `vlan`).",
+							Computed:    true,
+						},
+						"active": schema.BoolAttribute{
+							Description: "Whether the interface is active.",
+							Computed:    true,
+						},
+						"autostart": schema.BoolAttribute{
+							Description: "Whether the interface is started automatically on boot.",
+							Computed:    true,
+						},
+						"address": schema.StringAttribute{
+							Description: "The IPv4 address of the interface.",
+							Computed:    true,
+						},
+						"cidr": schema.StringAttribute{
+							Description: "The IPv4 address of the interface in CIDR notation.",
+							Computed:    true,
+						},
+						"gateway": schema.StringAttribute{
+							Description: "The IPv4 gateway of the interface.",
+							Computed:    true,
+						},
+						"bridge_ports": schema.ListAttribute{
+							Description: "The interfaces attached to the bridge. Empty for interfaces other than bridges.",
+							Computed:    true,
+							ElementType: types.StringType,
+						},
+						"bond_slaves": schema.ListAttribute{
+							Description: "The interfaces enslaved to the bond. Empty for interfaces other than bonds.",
+							Computed:    true,
+							ElementType: types.StringType,
+						},
+						"vlan_id": schema.Int64Attribute{
+							Description: "The VLAN tag of a VLAN interface, taken from its `vlan-id` option or its name " +
+								"(e.g. `eth0.100` or `vlan100`). `0` for other interfaces.",
+							Computed: true,
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+// Configure sets the client for the data source.
+func (d *networkInterfacesDataSource) Configure(
+	_ context.Context,
+	req datasource.ConfigureRequest,
+	resp *datasource.ConfigureResponse,
+) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	cfg, ok := req.ProviderData.(config.DataSource)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected DataSource Configure Type",
+			fmt.Sprintf("Expected config.DataSource, got: %T", req.ProviderData),
+		)
+
+		return
+	}
+
+	d.client = cfg.Client
+}
+
+// Read fetches the network interfaces from the Proxmox API.
+func (d *networkInterfacesDataSource) Read(
+	ctx context.Context,
+	req datasource.ReadRequest,
+	resp *datasource.ReadResponse,
+) {
+	var model networkInterfacesDataSourceModel
+
+	resp.Diagnostics.Append(req.Config.Get(ctx, &model)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	ifaces, err := d.client.Node(model.NodeName.ValueString()).ListNetworkInterfaces(ctx)
+	if err != nil {
+		resp.Diagnostics.AddError("Unable to Read Network Interfaces", err.Error())
+		return
+	}
+
+	model.Interfaces = make([]networkInterface, 0, len(ifaces))
+
+	for _, iface := range ifaces {
+		if iface == nil {
+			continue
+		}
+
+		if !model.Type.IsNull() && iface.Type != model.Type.ValueString() {
+			continue
+		}
+
+		model.Interfaces = append(model.Interfaces, networkInterfaceFromAPI(iface))
+	}
+
+	sort.Slice(model.Interfaces, func(i, j int) bool {
+		return model.Interfaces[i].Iface.ValueString() < model.Interfaces[j].Iface.ValueString()
+	})
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &model)...)
+}
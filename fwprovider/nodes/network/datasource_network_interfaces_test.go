@@ -0,0 +1,80 @@
+//go:build acceptance || all
+
+//testacc:tier=light
+//testacc:resource=network
+
+/*
+ * This Source Code Form is subject to the terms of the Mozilla Public
+ * License, v. 2.0. If a copy of the MPL was not distributed with this
+ * file, You can obtain one at https://mozilla.org/MPL/2.0/.
+ */
+
+package network_test
+
+import (
+	"regexp"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+
+	"github.com/bpg/terraform-provider-proxmox/fwprovider/test"
+)
+
+func TestAccDataSourceNodeNetworkInterfaces(t *testing.T) {
+	t.Parallel()
+
+	te := test.InitEnvironment(t)
+
+	tests := []struct {
+		name  string
+		steps []resource.TestStep
+	}{
+		{"read all network interfaces", []resource.TestStep{{
+			Config: te.RenderConfig(`data "proxmox_node_network_interfaces" "test" {
+				node_name = "{{.NodeName}}"
+			}`),
+			Check: resource.ComposeTestCheckFunc(
+				test.ResourceAttributesSet("data.proxmox_node_network_interfaces.test", []string{
+					"node_name",
+					"interfaces.#",
+					"interfaces.0.iface",
+					"interfaces.0.type",
+					"interfaces.0.active",
+					"interfaces.0.autostart",
+					"interfaces.0.vlan_id",
+				}),
+			),
+		}}},
+		{"read bridges only", []resource.TestStep{{
+			Config: te.RenderConfig(`data "proxmox_node_network_interfaces" "test" {
+				node_name = "{{.NodeName}}"
+				type      = "bridge"
+			}`),
+			Check: resource.ComposeTestCheckFunc(
+				resource.TestMatchResourceAttr("data.proxmox_node_network_interfaces.test", "interfaces.#", regexp.MustCompile(`^[1-9]\d*$`)),
+				resource.TestCheckTypeSetElemNestedAttrs("data.proxmox_node_network_interfaces.test", "interfaces.*", map[string]string{
+					"iface": "vmbr0",
+					"type":  "bridge",
+				}),
+			),
+		}}},
+		{"read unknown type", []resource.TestStep{{
+			Config: te.RenderConfig(`data "proxmox_node_network_interfaces" "test" {
+				node_name = "{{.NodeName}}"
+				type      = "does-not-exist"
+			}`),
+			Check: resource.ComposeTestCheckFunc(
+				resource.TestCheckResourceAttr("data.proxmox_node_network_interfaces.test", "interfaces.#", "0"),
+			),
+		}}},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			resource.ParallelTest(t, resource.TestCase{
+				ProtoV6ProviderFactories: te.AccProviders,
+				Steps:                    tt.steps,
+			})
+		})
+	}
+}
@@ -0,0 +1,90 @@
+/*
+ * This Source Code Form is subject to the terms of the Mozilla Public
+ * License, v. 2.0. If a copy of the MPL was not distributed with this
+ * file, You can obtain one at https://mozilla.org/MPL/2.0/.
+ */
+
+package network
+
+import (
+	"strconv"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-framework/types"
+
+	"github.com/bpg/terraform-provider-proxmox/fwprovider/attribute"
+	"github.com/bpg/terraform-provider-proxmox/proxmox/nodes"
+)
+
+// networkInterfacesDataSourceModel is the top-level model for the proxmox_node_network_interfaces data source.
+type networkInterfacesDataSourceModel struct {
+	NodeName   types.String       `tfsdk:"node_name"`
+	Type       types.String       `tfsdk:"type"`
+	Interfaces []networkInterface `tfsdk:"interfaces"`
+}
+
+// networkInterface is the model for a single network interface in the output list.
+type networkInterface struct {
+	Iface       types.String `tfsdk:"iface"`
+	Type        types.String `tfsdk:"type"`
+	Active      types.Bool   `tfsdk:"active"`
+	Autostart   types.Bool   `tfsdk:"autostart"`
+	Address     types.String `tfsdk:"address"`
+	CIDR        types.String `tfsdk:"cidr"`
+	Gateway     types.String `tfsdk:"gateway"`
+	BridgePorts []string     `tfsdk:"bridge_ports"`
+	BondSlaves  []string     `tfsdk:"bond_slaves"`
+	VLANID      types.Int64  `tfsdk:"vlan_id"`
+}
+
+// networkInterfaceFromAPI converts an API network interface to the Terraform model.
+// Values missing from the API response are reported as empty strings, false, empty lists or 0.
+func networkInterfaceFromAPI(iface *nodes.NetworkInterfaceListResponseData) networkInterface {
+	m := networkInterface{
+		Iface:     types.StringValue(iface.Iface),
+		Type:      types.StringValue(iface.Type),
+		Active:    attribute.BoolValueFromCustomBoolPtr(iface.Active),
+		Autostart: attribute.BoolValueFromCustomBoolPtr(iface.Autostart),
+		Address:   attribute.StringValueFromPtr(iface.Address),
+		CIDR:      attribute.StringValueFromPtr(iface.CIDR),
+		Gateway:   attribute.StringValueFromPtr(iface.Gateway),
+		VLANID:    types.Int64Value(networkInterfaceVLANID(iface)),
+	}
+
+	m.BridgePorts = strings.Fields(attribute.StringValueFromPtr(iface.BridgePorts).ValueString())
+	m.BondSlaves = strings.Fields(attribute.StringValueFromPtr(iface.Slaves).ValueString())
+
+	return m
+}
+
+// networkInterfaceVLANID returns the VLAN tag of a VLAN interface, or 0 for other interfaces.
+// The tag is taken from the explicit `vlan-id` option if set. Otherwise it is parsed from the interface name,
+// which is either `<device>.<tag>` (e.g. `eth0.100`) or `vlan<tag>` (e.g. `vlan100`).
+func networkInterfaceVLANID(iface *nodes.NetworkInterfaceListResponseData) int64 {
+	if iface.VLANID != nil {
+		if v, err := strconv.ParseInt(strings.TrimSpace(*iface.VLANID), 10, 64); err == nil {
+			return v
+		}
+	}
+
+	if iface.Type != "vlan" {
+		return 0
+	}
+
+	tag, found := strings.CutPrefix(iface.Iface, "vlan")
+	if !found {
+		idx := strings.LastIndex(iface.Iface, ".")
+		if idx < 0 {
+			return 0
+		}
+
+		tag = iface.Iface[idx+1:]
+	}
+
+	v, err := strconv.ParseInt(tag, 10, 64)
+	if err != nil {
+		return 0
+	}
+
+	return v
+}
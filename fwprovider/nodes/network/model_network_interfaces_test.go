@@ -0,0 +1,48 @@
+/*
+ * This Source Code Form is subject to the terms of the Mozilla Public
+ * License, v. 2.0. If a copy of the MPL was not distributed with this
+ * file, You can obtain one at https://mozilla.org/MPL/2.0/.
+ */
+
+package network
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/bpg/terraform-provider-proxmox/proxmox/nodes"
+)
+
+func TestNetworkInterfaceVLANID(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name   string
+		iface  string
+		typ    string
+		vlanID *string
+		want   int64
+	}{
+		{"explicit vlan-id", "vlan5", "vlan", new("42"), 42},
+		{"dotted subinterface", "eth0.100", "vlan", nil, 100},
+		{"bridge subinterface", "vmbr0.4094", "vlan", nil, 4094},
+		{"vlan prefix", "vlan200", "vlan", nil, 200},
+		{"bridge", "vmbr0", "bridge", nil, 0},
+		{"dotted name of another type", "eth0.100", "eth", nil, 0},
+		{"unparseable name", "vlan.mgmt", "vlan", nil, 0},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			got := networkInterfaceVLANID(&nodes.NetworkInterfaceListResponseData{
+				Iface:  tt.iface,
+				Type:   tt.typ,
+				VLANID: tt.vlanID,
+			})
+			require.Equal(t, tt.want, got)
+		})
+	}
+}
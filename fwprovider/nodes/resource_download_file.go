@@ -344,8 +344,9 @@ func (r *downloadFileResource) Schema(
 			},
 			"overwrite_unmanaged": schema.BoolAttribute{
 				Description: "If `true` and a file with the same name already exists in the datastore, " +
-					"it will be deleted and the new file will be downloaded. If `false` and the file already exists, " +
-					"an error will be returned.",
+					"it will be adopted into the state when its size matches the file size reported by the URL " +
+					"and no `checksum` is set, otherwise it will be deleted and the new file will be downloaded. " +
+					"If `false` and the file already exists, an error will be returned.",
 				Optional: true,
 				Computed: true,
 				Default:  booldefault.StaticBool(false),
@@ -430,6 +431,15 @@ func (r *downloadFileResource) Create(
 
 	storageClient := nodesClient.Storage(plan.Storage.ValueString())
 
+	plan.ID = types.StringValue(plan.Storage.ValueString() + ":" +
+		plan.ContentType.ValueString() + "/" + plan.FileName.ValueString())
+
+	if plan.OverwriteUnmanaged.ValueBool() && r.adoptExistingFile(ctx, &plan, fileMetadata) {
+		resp.Diagnostics.Append(resp.State.Set(ctx, plan)...)
+
+		return
+	}
+
 	err = storageClient.DownloadFileByURL(ctx, &downloadFileReq)
 	if isErrFileAlreadyExists(err) && plan.OverwriteUnmanaged.ValueBool() {
 		fileID := plan.ContentType.ValueString() + "/" + plan.FileName.ValueString()
@@ -477,9 +487,6 @@ func (r *downloadFileResource) Create(
 		return
 	}
 
-	plan.ID = types.StringValue(plan.Storage.ValueString() + ":" +
-		plan.ContentType.ValueString() + "/" + plan.FileName.ValueString())
-
 	// Retry with backoff: distributed storage (Ceph) may not list the file immediately after download.
 	err = retry.New(
 		retry.Context(ctx),
@@ -504,6 +511,48 @@ func (r *downloadFileResource) Create(
 	resp.Diagnostics.Append(diags...)
 }
 
+// adoptExistingFile checks whether the target file already exists in the datastore with the size reported by
+// the URL, and if so, takes it over into the state instead of downloading it again. Files are never adopted when
+// a checksum is configured, as the checksum of an existing file cannot be verified through the API.
+func (r *downloadFileResource) adoptExistingFile(
+	ctx context.Context,
+	plan *downloadFileModel,
+	fileMetadata *nodes.QueryURLMetadataGetResponseData,
+) bool {
+	if !plan.Checksum.IsNull() && plan.Checksum.ValueString() != "" {
+		return false
+	}
+
+	if fileMetadata.Size == nil || *fileMetadata.Size <= 0 {
+		return false
+	}
+
+	existing := *plan
+
+	if err := r.read(ctx, &existing); err != nil {
+		return false
+	}
+
+	if existing.Size.ValueInt64() != *fileMetadata.Size {
+		tflog.Debug(ctx, "Existing file size differs from the URL, downloading it again", map[string]any{
+			"file_id":       plan.ID.ValueString(),
+			"existing_size": existing.Size.ValueInt64(),
+			"url_size":      *fileMetadata.Size,
+		})
+
+		return false
+	}
+
+	tflog.Info(ctx, "File already exists in the datastore with the expected size, skipping download", map[string]any{
+		"file_id": plan.ID.ValueString(),
+		"size":    existing.Size.ValueInt64(),
+	})
+
+	plan.Size = existing.Size
+
+	return true
+}
+
 func (r *downloadFileResource) getURLMetadata(
 	ctx context.Context,
 	model *downloadFileModel,
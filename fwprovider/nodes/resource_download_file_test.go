@@ -353,6 +353,7 @@ func uploadIsoFile(t *testing.T, fileName string) {
 				Port:    int32(sshPort),
 			},
 		},
+		0, 0,
 	)
 	require.NoError(t, err)
 
@@ -0,0 +1,305 @@
+/*
+ * This Source Code Form is subject to the terms of the Mozilla Public
+ * License, v. 2.0. If a copy of the MPL was not distributed with this
+ * file, You can obtain one at https://mozilla.org/MPL/2.0/.
+ */
+
+package subscription
+
+import (
+	"context"
+	"fmt"
+	"regexp"
+
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/booldefault"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+
+	"github.com/bpg/terraform-provider-proxmox/fwprovider/attribute"
+	"github.com/bpg/terraform-provider-proxmox/fwprovider/config"
+	"github.com/bpg/terraform-provider-proxmox/proxmox"
+	"github.com/bpg/terraform-provider-proxmox/proxmox/nodes"
+)
+
+var (
+	_ resource.Resource                = &subscriptionResource{}
+	_ resource.ResourceWithConfigure   = &subscriptionResource{}
+	_ resource.ResourceWithImportState = &subscriptionResource{}
+)
+
+// NewSubscriptionResource creates a new node subscription resource.
+func NewSubscriptionResource() resource.Resource {
+	return &subscriptionResource{}
+}
+
+type subscriptionResource struct {
+	client proxmox.Client
+}
+
+func (r *subscriptionResource) Metadata(
+	_ context.Context,
+	_ resource.MetadataRequest,
+	resp *resource.MetadataResponse,
+) {
+	resp.TypeName = "proxmox_node_subscription"
+}
+
+func (r *subscriptionResource) Schema(
+	_ context.Context,
+	_ resource.SchemaRequest,
+	resp *resource.SchemaResponse,
+) {
+	resp.Schema = schema.Schema{
+		Description: "Manages the subscription key of a Proxmox VE node.",
+		Attributes: map[string]schema.Attribute{
+			"id": attribute.ResourceID(),
+			"node_name": schema.StringAttribute{
+				Description: "The name of the node.",
+				Required:    true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+				Validators: []validator.String{
+					stringvalidator.LengthAtLeast(1),
+				},
+			},
+			"key": schema.StringAttribute{
+				Description: "The subscription key, e.g. `pve4b-1234567890`.",
+				Required:    true,
+				Sensitive:   true,
+				Validators: []validator.String{
+					stringvalidator.RegexMatches(
+						regexp.MustCompile(`^pve[1248][cbsp]-[0-9a-f]{10}$`),
+						"must be a valid Proxmox VE subscription key, e.g. `pve4b-1234567890`",
+					),
+				},
+			},
+			"force": schema.BoolAttribute{
+				Description: "Whether to always contact the subscription server when the key is set, " +
+					"instead of using the cached subscription information. Defaults to `false`.",
+				Optional: true,
+				Computed: true,
+				Default:  booldefault.StaticBool(false),
+			},
+			"status": schema.StringAttribute{
+				Description: "The subscription status, e.g. `active`, `invalid` or `expired`.",
+				Computed:    true,
+			},
+			"level": schema.StringAttribute{
+				Description: "The subscription level, e.g. `c` (Community) or `b` (Basic).",
+				Computed:    true,
+			},
+			"next_due_date": schema.StringAttribute{
+				Description: "The next due date of the subscription.",
+				Computed:    true,
+			},
+		},
+	}
+}
+
+func (r *subscriptionResource) Configure(
+	_ context.Context,
+	req resource.ConfigureRequest,
+	resp *resource.ConfigureResponse,
+) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	cfg, ok := req.ProviderData.(config.Resource)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Resource Configure Type",
+			fmt.Sprintf("Expected config.Resource, got: %T", req.ProviderData),
+		)
+
+		return
+	}
+
+	r.client = cfg.Client
+}
+
+func (r *subscriptionResource) Create(
+	ctx context.Context,
+	req resource.CreateRequest,
+	resp *resource.CreateResponse,
+) {
+	var plan subscriptionModel
+
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	r.set(ctx, &plan, &resp.Diagnostics)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	plan.ID = types.StringValue(plan.NodeName.ValueString())
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, plan)...)
+}
+
+// set uploads the subscription key, checks it against the subscription server and reads back the result.
+func (r *subscriptionResource) set(ctx context.Context, model *subscriptionModel, diags *diag.Diagnostics) {
+	nodeName := model.NodeName.ValueString()
+	nodeClient := r.client.Node(nodeName)
+
+	if err := nodeClient.SetSubscriptionKey(ctx, model.Key.ValueString()); err != nil {
+		diags.AddError(fmt.Sprintf("Unable to Set Subscription Key of Node %q", nodeName), err.Error())
+		return
+	}
+
+	if err := nodeClient.CheckSubscription(ctx, model.Force.ValueBool()); err != nil {
+		diags.AddError(fmt.Sprintf("Unable to Check Subscription of Node %q", nodeName), err.Error())
+		return
+	}
+
+	data := r.read(ctx, model, diags)
+	if data == nil {
+		return
+	}
+
+	if data.Status != "active" {
+		message := ""
+		if data.Message != nil {
+			message = *data.Message
+		}
+
+		diags.AddWarning(
+			fmt.Sprintf("Subscription of Node %q Is Not Active", nodeName),
+			fmt.Sprintf("The subscription status is %q. %s", data.Status, message),
+		)
+	}
+}
+
+// read refreshes the model from the API, and returns nil when the node has no subscription key.
+func (r *subscriptionResource) read(
+	ctx context.Context,
+	model *subscriptionModel,
+	diags *diag.Diagnostics,
+) *nodes.SubscriptionGetResponseData {
+	nodeName := model.NodeName.ValueString()
+
+	data, err := r.client.Node(nodeName).GetSubscription(ctx)
+	if err != nil {
+		diags.AddError(fmt.Sprintf("Unable to Read Subscription of Node %q", nodeName), err.Error())
+		return nil
+	}
+
+	if data.Status == nodes.SubscriptionStatusNotFound {
+		return nil
+	}
+
+	model.fromAPI(data)
+
+	return data
+}
+
+func (r *subscriptionResource) Read(
+	ctx context.Context,
+	req resource.ReadRequest,
+	resp *resource.ReadResponse,
+) {
+	var state subscriptionModel
+
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	data := r.read(ctx, &state, &resp.Diagnostics)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if data == nil {
+		resp.State.RemoveResource(ctx)
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, state)...)
+}
+
+func (r *subscriptionResource) Update(
+	ctx context.Context,
+	req resource.UpdateRequest,
+	resp *resource.UpdateResponse,
+) {
+	var plan subscriptionModel
+
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	r.set(ctx, &plan, &resp.Diagnostics)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, plan)...)
+}
+
+func (r *subscriptionResource) Delete(
+	ctx context.Context,
+	req resource.DeleteRequest,
+	resp *resource.DeleteResponse,
+) {
+	var state subscriptionModel
+
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	nodeName := state.NodeName.ValueString()
+
+	if err := r.client.Node(nodeName).DeleteSubscription(ctx); err != nil {
+		resp.Diagnostics.AddError(fmt.Sprintf("Unable to Delete Subscription of Node %q", nodeName), err.Error())
+	}
+}
+
+func (r *subscriptionResource) ImportState(
+	ctx context.Context,
+	req resource.ImportStateRequest,
+	resp *resource.ImportStateResponse,
+) {
+	nodeName := req.ID
+	state := subscriptionModel{
+		ID:       types.StringValue(nodeName),
+		NodeName: types.StringValue(nodeName),
+		Force:    types.BoolValue(false),
+	}
+
+	data := r.read(ctx, &state, &resp.Diagnostics)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if data == nil {
+		resp.Diagnostics.AddError(
+			fmt.Sprintf("Unable to Import Subscription of Node %q", nodeName),
+			"The node has no subscription key.",
+		)
+
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, state)...)
+}
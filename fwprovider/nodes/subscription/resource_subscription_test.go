@@ -0,0 +1,43 @@
+//go:build acceptance || all
+
+//testacc:tier=light
+//testacc:resource=misc
+
+/*
+ * This Source Code Form is subject to the terms of the Mozilla Public
+ * License, v. 2.0. If a copy of the MPL was not distributed with this
+ * file, You can obtain one at https://mozilla.org/MPL/2.0/.
+ */
+
+package subscription_test
+
+import (
+	"regexp"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+
+	"github.com/bpg/terraform-provider-proxmox/fwprovider/test"
+)
+
+// TestAccResourceNodeSubscriptionInvalidKey verifies that a malformed key is rejected before it is sent
+// to the node, as a real key can't be used in the test environment.
+func TestAccResourceNodeSubscriptionInvalidKey(t *testing.T) {
+	t.Parallel()
+
+	te := test.InitEnvironment(t)
+
+	resource.Test(t, resource.TestCase{
+		ProtoV6ProviderFactories: te.AccProviders,
+		Steps: []resource.TestStep{
+			{
+				Config: te.RenderConfig(`
+					resource "proxmox_node_subscription" "test" {
+						node_name = "{{.NodeName}}"
+						key       = "not-a-key"
+					}`),
+				ExpectError: regexp.MustCompile(`must be a valid Proxmox VE subscription key`),
+			},
+		},
+	})
+}
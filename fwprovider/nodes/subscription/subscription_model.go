@@ -0,0 +1,34 @@
+/*
+ * This Source Code Form is subject to the terms of the Mozilla Public
+ * License, v. 2.0. If a copy of the MPL was not distributed with this
+ * file, You can obtain one at https://mozilla.org/MPL/2.0/.
+ */
+
+package subscription
+
+import (
+	"github.com/hashicorp/terraform-plugin-framework/types"
+
+	"github.com/bpg/terraform-provider-proxmox/proxmox/nodes"
+)
+
+type subscriptionModel struct {
+	ID          types.String `tfsdk:"id"`
+	NodeName    types.String `tfsdk:"node_name"`
+	Key         types.String `tfsdk:"key"`
+	Force       types.Bool   `tfsdk:"force"`
+	Status      types.String `tfsdk:"status"`
+	Level       types.String `tfsdk:"level"`
+	NextDueDate types.String `tfsdk:"next_due_date"`
+}
+
+func (m *subscriptionModel) fromAPI(data *nodes.SubscriptionGetResponseData) {
+	// PVE only reports the key after it has been set, keep the configured one otherwise.
+	if data.Key != nil && *data.Key != "" {
+		m.Key = types.StringValue(*data.Key)
+	}
+
+	m.Status = types.StringValue(data.Status)
+	m.Level = types.StringPointerValue(data.Level)
+	m.NextDueDate = types.StringPointerValue(data.NextDueDate)
+}
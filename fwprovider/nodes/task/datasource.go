@@ -0,0 +1,235 @@
+/*
+ * This Source Code Form is subject to the terms of the Mozilla Public
+ * License, v. 2.0. If a copy of the MPL was not distributed with this
+ * file, You can obtain one at https://mozilla.org/MPL/2.0/.
+ */
+
+package task
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-framework-validators/int64validator"
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+
+	"github.com/bpg/terraform-provider-proxmox/fwprovider/config"
+	"github.com/bpg/terraform-provider-proxmox/proxmox"
+	"github.com/bpg/terraform-provider-proxmox/proxmox/nodes/tasks"
+)
+
+const (
+	defaultTimeout  = 1800
+	defaultLogLines = 50
+	taskRunning     = "running"
+)
+
+// Ensure the implementation satisfies the expected interfaces.
+var (
+	_ datasource.DataSource              = &DataSource{}
+	_ datasource.DataSourceWithConfigure = &DataSource{}
+)
+
+// NewDataSource creates the proxmox_node_task data source.
+func NewDataSource() datasource.DataSource {
+	return &DataSource{}
+}
+
+// DataSource is the proxmox_node_task data source.
+type DataSource struct {
+	client proxmox.Client
+}
+
+// Metadata returns the data source type name.
+func (d *DataSource) Metadata(
+	_ context.Context,
+	_ datasource.MetadataRequest,
+	resp *datasource.MetadataResponse,
+) {
+	resp.TypeName = "proxmox_node_task"
+}
+
+// Schema defines the schema for the data source.
+func (d *DataSource) Schema(
+	_ context.Context,
+	_ datasource.SchemaRequest,
+	resp *datasource.SchemaResponse,
+) {
+	resp.Schema = schema.Schema{
+		Description: "Retrieves the status and log of a task on a Proxmox VE node, optionally waiting for it to complete.",
+		MarkdownDescription: "Retrieves the status and log of a task on a Proxmox VE node, optionally waiting for it " +
+			"to complete. This is useful to wait for operations started outside of Terraform, e.g. by a script, " +
+			"before managing the resources they create.",
+		Attributes: map[string]schema.Attribute{
+			"node_name": schema.StringAttribute{
+				Description: "The name of the node the task runs on.",
+				Required:    true,
+			},
+			"upid": schema.StringAttribute{
+				Description: "The unique task identifier (UPID), e.g. " +
+					"`UPID:pve:0012ABCD:0034EF56:6543210A:qmstart:100:root@pam:`.",
+				Required: true,
+			},
+			"wait": schema.BoolAttribute{
+				Description: "Whether to wait for the task to complete. Defaults to `false`.",
+				Optional:    true,
+			},
+			"timeout": schema.Int64Attribute{
+				Description: fmt.Sprintf("The number of seconds to wait for the task to complete when `wait` is set. "+
+					"Defaults to `%d`.", defaultTimeout),
+				Optional: true,
+				Validators: []validator.Int64{
+					int64validator.AtLeast(1),
+				},
+			},
+			"log_lines": schema.Int64Attribute{
+				Description: fmt.Sprintf("The number of lines to return from the end of the task log. "+
+					"Defaults to `%d`.", defaultLogLines),
+				Optional: true,
+				Validators: []validator.Int64{
+					int64validator.AtLeast(0),
+				},
+			},
+			"status": schema.StringAttribute{
+				Description: "The task status, `running` or `stopped`.",
+				Computed:    true,
+			},
+			"exit_status": schema.StringAttribute{
+				Description: "The exit status of a stopped task, e.g. `OK`, `WARNINGS: 1` or the error message.",
+				Computed:    true,
+			},
+			"type": schema.StringAttribute{
+				Description: "The task type, e.g. `qmstart` or `vzdump`.",
+				Computed:    true,
+			},
+			"start_time": schema.StringAttribute{
+				Description: "The time the task started at, in RFC 3339 format.",
+				Computed:    true,
+			},
+			"end_time": schema.StringAttribute{
+				Description: "The time a stopped task ended at, in RFC 3339 format.",
+				Computed:    true,
+			},
+			"log": schema.ListAttribute{
+				Description: "The last `log_lines` lines of the task log.",
+				Computed:    true,
+				ElementType: types.StringType,
+			},
+		},
+	}
+}
+
+// Configure adds the provider-configured client to the data source.
+func (d *DataSource) Configure(
+	_ context.Context,
+	req datasource.ConfigureRequest,
+	resp *datasource.ConfigureResponse,
+) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	cfg, ok := req.ProviderData.(config.DataSource)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected DataSource Configure Type",
+			fmt.Sprintf("Expected config.DataSource, got: %T", req.ProviderData),
+		)
+
+		return
+	}
+
+	d.client = cfg.Client
+}
+
+// Read fetches the task status and log, waiting for the task to complete if requested.
+func (d *DataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	var state model
+
+	resp.Diagnostics.Append(req.Config.Get(ctx, &state)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	upid := state.UPID.ValueString()
+
+	tid, err := tasks.ParseTaskID(upid)
+	if err != nil {
+		resp.Diagnostics.AddError("Invalid Task ID", err.Error())
+		return
+	}
+
+	if tid.NodeName != state.NodeName.ValueString() {
+		resp.Diagnostics.AddError(
+			"Invalid Task ID",
+			fmt.Sprintf("The task %q belongs to node %q, not %q.", upid, tid.NodeName, state.NodeName.ValueString()),
+		)
+
+		return
+	}
+
+	taskClient := d.client.Node(tid.NodeName).Tasks()
+
+	if state.Wait.ValueBool() {
+		timeout := int64(defaultTimeout)
+		if !state.Timeout.IsNull() {
+			timeout = state.Timeout.ValueInt64()
+		}
+
+		waitCtx, cancel := context.WithTimeout(ctx, time.Duration(timeout)*time.Second)
+
+		// a failed task is not an error of the data source, its outcome is reported in `exit_status`
+		_ = taskClient.WaitForTask(waitCtx, upid)
+
+		cancel()
+	}
+
+	status, err := taskClient.GetTaskStatus(ctx, upid)
+	if err != nil {
+		resp.Diagnostics.AddError("Unable to Read Task Status", err.Error())
+		return
+	}
+
+	if state.Wait.ValueBool() && status.Status == taskRunning {
+		resp.Diagnostics.AddError(
+			"Timeout Waiting for Task",
+			fmt.Sprintf("The task %q did not complete within the timeout.", upid),
+		)
+
+		return
+	}
+
+	endTime := time.Time{}
+
+	if status.Status != taskRunning {
+		endTime, err = taskClient.GetTaskEndTime(ctx, upid)
+		if err != nil {
+			resp.Diagnostics.AddError("Unable to Read Task End Time", err.Error())
+			return
+		}
+	}
+
+	state.fromAPI(status, endTime)
+
+	logLines := int64(defaultLogLines)
+	if !state.LogLines.IsNull() {
+		logLines = state.LogLines.ValueInt64()
+	}
+
+	state.Log = []string{}
+
+	if logLines > 0 {
+		state.Log, err = taskClient.GetTaskLogTail(ctx, upid, int(logLines))
+		if err != nil {
+			resp.Diagnostics.AddError("Unable to Read Task Log", err.Error())
+			return
+		}
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &state)...)
+}
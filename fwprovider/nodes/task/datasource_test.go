@@ -0,0 +1,72 @@
+//go:build acceptance || all
+
+//testacc:tier=light
+//testacc:resource=misc
+
+/*
+ * This Source Code Form is subject to the terms of the Mozilla Public
+ * License, v. 2.0. If a copy of the MPL was not distributed with this
+ * file, You can obtain one at https://mozilla.org/MPL/2.0/.
+ */
+
+package task_test
+
+import (
+	"net/http"
+	"regexp"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/stretchr/testify/require"
+
+	"github.com/bpg/terraform-provider-proxmox/fwprovider/test"
+)
+
+func TestAccDataSourceNodeTask(t *testing.T) {
+	t.Parallel()
+
+	te := test.InitEnvironment(t)
+
+	// start a task outside of Terraform, as a script would
+	var resBody struct {
+		Data *string `json:"data,omitempty"`
+	}
+
+	err := te.NodeClient().DoRequest(t.Context(), http.MethodPost, te.NodeClient().ExpandPath("apt/update"), nil, &resBody)
+	require.NoError(t, err, "failed to start the apt update task")
+	require.NotNil(t, resBody.Data)
+
+	te.AddTemplateVars(map[string]any{"UPID": *resBody.Data})
+
+	resource.Test(t, resource.TestCase{
+		ProtoV6ProviderFactories: te.AccProviders,
+		Steps: []resource.TestStep{
+			{
+				Config: te.RenderConfig(`
+					data "proxmox_node_task" "test" {
+						node_name = "{{.NodeName}}"
+						upid      = "{{.UPID}}"
+						wait      = true
+						timeout   = 300
+						log_lines = 5
+					}`),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("data.proxmox_node_task.test", "status", "stopped"),
+					resource.TestCheckResourceAttr("data.proxmox_node_task.test", "type", "aptupdate"),
+					resource.TestCheckResourceAttrSet("data.proxmox_node_task.test", "exit_status"),
+					resource.TestCheckResourceAttrSet("data.proxmox_node_task.test", "start_time"),
+					resource.TestCheckResourceAttrSet("data.proxmox_node_task.test", "end_time"),
+					resource.TestCheckResourceAttrSet("data.proxmox_node_task.test", "log.0"),
+				),
+			},
+			{
+				Config: te.RenderConfig(`
+					data "proxmox_node_task" "test" {
+						node_name = "{{.NodeName}}-other"
+						upid      = "{{.UPID}}"
+					}`),
+				ExpectError: regexp.MustCompile(`Invalid Task ID`),
+			},
+		},
+	})
+}
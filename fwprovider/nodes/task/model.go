@@ -0,0 +1,48 @@
+/*
+ * This Source Code Form is subject to the terms of the Mozilla Public
+ * License, v. 2.0. If a copy of the MPL was not distributed with this
+ * file, You can obtain one at https://mozilla.org/MPL/2.0/.
+ */
+
+package task
+
+import (
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-framework/types"
+
+	"github.com/bpg/terraform-provider-proxmox/proxmox/nodes/tasks"
+)
+
+// model is the Terraform-side representation of the node task data source.
+type model struct {
+	NodeName   types.String `tfsdk:"node_name"`
+	UPID       types.String `tfsdk:"upid"`
+	Wait       types.Bool   `tfsdk:"wait"`
+	Timeout    types.Int64  `tfsdk:"timeout"`
+	LogLines   types.Int64  `tfsdk:"log_lines"`
+	Status     types.String `tfsdk:"status"`
+	ExitStatus types.String `tfsdk:"exit_status"`
+	Type       types.String `tfsdk:"type"`
+	StartTime  types.String `tfsdk:"start_time"`
+	EndTime    types.String `tfsdk:"end_time"`
+	Log        []string     `tfsdk:"log"`
+}
+
+// fromAPI populates the task attributes from the task status and its end time.
+// The exit status and end time are null while the task is running.
+func (m *model) fromAPI(status *tasks.GetTaskStatusResponseData, endTime time.Time) {
+	m.Status = types.StringValue(status.Status)
+	m.ExitStatus = types.StringNull()
+	m.Type = types.StringValue(status.Type)
+	m.StartTime = types.StringValue(time.Unix(status.StartTime, 0).UTC().Format(time.RFC3339))
+	m.EndTime = types.StringNull()
+
+	if status.ExitCode != "" {
+		m.ExitStatus = types.StringValue(status.ExitCode)
+	}
+
+	if !endTime.IsZero() {
+		m.EndTime = types.StringValue(endTime.Format(time.RFC3339))
+	}
+}
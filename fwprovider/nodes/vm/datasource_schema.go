@@ -31,6 +31,39 @@ func (d *Datasource) Schema(
 		DeprecationMessage: migration.DeprecationMessage("proxmox_vm"),
 		Description:        "Retrieves information about a specific VM.",
 		Attributes: map[string]schema.Attribute{
+			"agent_interfaces": schema.ListNestedAttribute{
+				Description: "The network interfaces reported by the QEMU guest agent. The list is empty, with a " +
+					"warning, when the agent is not enabled, the VM is not running, or the agent does not respond.",
+				Computed: true,
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						"name": schema.StringAttribute{
+							Description: "The interface name.",
+							Computed:    true,
+						},
+						"mac_address": schema.StringAttribute{
+							Description: "The MAC address.",
+							Computed:    true,
+						},
+						"ip_addresses": schema.ListNestedAttribute{
+							Description: "The IP addresses of the interface.",
+							Computed:    true,
+							NestedObject: schema.NestedAttributeObject{
+								Attributes: map[string]schema.Attribute{
+									"address": schema.StringAttribute{
+										Description: "The IP address.",
+										Computed:    true,
+									},
+									"prefix": schema.Int64Attribute{
+										Description: "The prefix length.",
+										Computed:    true,
+									},
+								},
+							},
+						},
+					},
+				},
+			},
 			"cdrom": cdrom.DataSourceSchema(),
 			"cpu":   cpu.DataSourceSchema(),
 			"description": schema.StringAttribute{
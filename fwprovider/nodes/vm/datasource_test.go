@@ -49,6 +49,7 @@ func TestAccDatasourceVM(t *testing.T) {
 					resource.TestCheckResourceAttr(datasourceName, "template", "false"),
 					resource.TestCheckResourceAttr(datasourceName, "tags.#", "2"),
 					resource.TestCheckResourceAttr(datasourceName, "description", ""),
+					resource.TestCheckResourceAttr(datasourceName, "agent_interfaces.#", "0"),
 				),
 			},
 		},
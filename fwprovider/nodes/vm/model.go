@@ -24,6 +24,7 @@ import (
 	"github.com/bpg/terraform-provider-proxmox/fwprovider/types/stringset"
 	"github.com/bpg/terraform-provider-proxmox/proxmox"
 	"github.com/bpg/terraform-provider-proxmox/proxmox/api"
+	"github.com/bpg/terraform-provider-proxmox/proxmox/nodes/vms"
 )
 
 // Model represents the VM model.
@@ -51,18 +52,32 @@ type Model struct {
 // It excludes resource-only lifecycle fields (stop_on_destroy, purge_on_destroy,
 // delete_unreferenced_disks_on_destroy) that have no API representation.
 type DatasourceModel struct {
-	CDROM       cdrom.Value     `tfsdk:"cdrom"`
-	CPU         cpu.Value       `tfsdk:"cpu"`
-	Description types.String    `tfsdk:"description"`
-	ID          types.Int64     `tfsdk:"id"`
-	Name        types.String    `tfsdk:"name"`
-	NodeName    types.String    `tfsdk:"node_name"`
-	RNG         rng.Value       `tfsdk:"rng"`
-	Status      types.String    `tfsdk:"status"`
-	Tags        stringset.Value `tfsdk:"tags"`
-	Template    types.Bool      `tfsdk:"template"`
-	Timeouts    timeouts.Value  `tfsdk:"timeouts"`
-	VGA         vga.Value       `tfsdk:"vga"`
+	AgentInterfaces []agentInterfaceModel `tfsdk:"agent_interfaces"`
+	CDROM           cdrom.Value           `tfsdk:"cdrom"`
+	CPU             cpu.Value             `tfsdk:"cpu"`
+	Description     types.String          `tfsdk:"description"`
+	ID              types.Int64           `tfsdk:"id"`
+	Name            types.String          `tfsdk:"name"`
+	NodeName        types.String          `tfsdk:"node_name"`
+	RNG             rng.Value             `tfsdk:"rng"`
+	Status          types.String          `tfsdk:"status"`
+	Tags            stringset.Value       `tfsdk:"tags"`
+	Template        types.Bool            `tfsdk:"template"`
+	Timeouts        timeouts.Value        `tfsdk:"timeouts"`
+	VGA             vga.Value             `tfsdk:"vga"`
+}
+
+// agentInterfaceModel represents a network interface reported by the QEMU guest agent.
+type agentInterfaceModel struct {
+	Name        types.String          `tfsdk:"name"`
+	MACAddress  types.String          `tfsdk:"mac_address"`
+	IPAddresses []agentIPAddressModel `tfsdk:"ip_addresses"`
+}
+
+// agentIPAddressModel represents an IP address of a network interface reported by the QEMU guest agent.
+type agentIPAddressModel struct {
+	Address types.String `tfsdk:"address"`
+	Prefix  types.Int64  `tfsdk:"prefix"`
 }
 
 // readForDatasource retrieves the VM from the API and populates the datasource model.
@@ -109,9 +124,55 @@ func readForDatasource(ctx context.Context, client proxmox.Client, model *Dataso
 	model.VGA = vga.NewValue(ctx, config, diags)
 	model.CDROM = cdrom.NewValue(ctx, config, diags)
 
+	model.AgentInterfaces = readAgentInterfaces(ctx, vmAPI, status, config, diags)
+
 	return true
 }
 
+// readAgentInterfaces reads the network interfaces from the QEMU guest agent. An unavailable agent is not an error:
+// an empty list is returned and a warning explaining why is added.
+func readAgentInterfaces(
+	ctx context.Context,
+	vmAPI *vms.Client,
+	status *vms.GetStatusResponseData,
+	config *vms.GetResponseData,
+	diags *diag.Diagnostics,
+) []agentInterfaceModel {
+	data, err := vmAPI.GetAgentNetworkInterfaces(ctx, status, config)
+	if err != nil {
+		diags.AddWarning(
+			"QEMU guest agent network interfaces are not available",
+			fmt.Sprintf("The agent interfaces will be empty: %s.", err),
+		)
+	}
+
+	return agentInterfacesFromAPI(data)
+}
+
+// agentInterfacesFromAPI converts the agent interfaces to the agent_interfaces attribute value.
+func agentInterfacesFromAPI(data []vms.AgentNetworkInterface) []agentInterfaceModel {
+	interfaces := make([]agentInterfaceModel, 0, len(data))
+
+	for _, iface := range data {
+		addresses := make([]agentIPAddressModel, 0, len(iface.IPAddresses))
+
+		for _, ip := range iface.IPAddresses {
+			addresses = append(addresses, agentIPAddressModel{
+				Address: types.StringValue(ip.Address),
+				Prefix:  types.Int64Value(int64(ip.Prefix)),
+			})
+		}
+
+		interfaces = append(interfaces, agentInterfaceModel{
+			Name:        types.StringValue(iface.Name),
+			MACAddress:  types.StringValue(iface.MACAddress),
+			IPAddresses: addresses,
+		})
+	}
+
+	return interfaces
+}
+
 // read retrieves the current state of the resource from the API and updates the state.
 // Returns false if the resource does not exist, so the caller can remove it from the state if necessary.
 func read(ctx context.Context, client proxmox.Client, model *Model, diags *diag.Diagnostics) bool {
@@ -0,0 +1,50 @@
+/*
+ * This Source Code Form is subject to the terms of the Mozilla Public
+ * License, v. 2.0. If a copy of the MPL was not distributed with this
+ * file, You can obtain one at https://mozilla.org/MPL/2.0/.
+ */
+
+package vm
+
+import (
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/stretchr/testify/assert"
+
+	"github.com/bpg/terraform-provider-proxmox/proxmox/nodes/vms"
+)
+
+func TestAgentInterfacesFromAPI(t *testing.T) {
+	t.Parallel()
+
+	assert.Empty(t, agentInterfacesFromAPI(nil))
+
+	data := []vms.AgentNetworkInterface{
+		{Name: "lo", MACAddress: "00:00:00:00:00:00"},
+		{
+			Name:       "eth0",
+			MACAddress: "bc:24:11:00:00:01",
+			IPAddresses: []vms.AgentNetworkInterfaceIPAddress{
+				{Address: "192.168.1.10", Prefix: 24},
+				{Address: "fe80::1", Prefix: 64},
+			},
+		},
+	}
+
+	assert.Equal(t, []agentInterfaceModel{
+		{
+			Name:        types.StringValue("lo"),
+			MACAddress:  types.StringValue("00:00:00:00:00:00"),
+			IPAddresses: []agentIPAddressModel{},
+		},
+		{
+			Name:       types.StringValue("eth0"),
+			MACAddress: types.StringValue("bc:24:11:00:00:01"),
+			IPAddresses: []agentIPAddressModel{
+				{Address: types.StringValue("192.168.1.10"), Prefix: types.Int64Value(24)},
+				{Address: types.StringValue("fe80::1"), Prefix: types.Int64Value(64)},
+			},
+		},
+	}, agentInterfacesFromAPI(data))
+}
@@ -55,6 +55,9 @@ var (
 type Resource struct {
 	client      proxmox.Client
 	idGenerator cluster.IDGenerator
+	forceDelete bool
+	// shutdownTimeout overrides defaultShutdownTimeout when set
+	shutdownTimeout time.Duration
 }
 
 // NewResource creates a new resource for managing VMs.
@@ -93,6 +96,8 @@ func (r *Resource) Configure(
 
 	r.client = cfg.Client
 	r.idGenerator = cfg.IDGenerator
+	r.forceDelete = cfg.ForceDelete
+	r.shutdownTimeout = cfg.VMShutdownTimeout
 }
 
 // Create creates a new VM.
@@ -111,7 +116,9 @@ func (r *Resource) Create(ctx context.Context, req resource.CreateRequest, resp
 	ctx, cancel := context.WithTimeout(ctx, timeout)
 	defer cancel()
 
-	if plan.ID.ValueInt64() == 0 {
+	generatedID := plan.ID.ValueInt64() == 0
+
+	if generatedID {
 		id, err := r.idGenerator.NextID(ctx)
 		if err != nil {
 			resp.Diagnostics.AddError("Unable to Generate VM ID", err.Error())
@@ -125,7 +132,7 @@ func (r *Resource) Create(ctx context.Context, req resource.CreateRequest, resp
 		return
 	}
 
-	r.create(ctx, plan, &resp.Diagnostics)
+	r.create(ctx, &plan, generatedID, &resp.Diagnostics)
 
 	if resp.Diagnostics.HasError() {
 		return
@@ -148,7 +155,7 @@ func (r *Resource) Create(ctx context.Context, req resource.CreateRequest, resp
 	resp.Diagnostics.Append(resp.State.Set(ctx, plan)...)
 }
 
-func (r *Resource) create(ctx context.Context, plan Model, diags *diag.Diagnostics) {
+func (r *Resource) create(ctx context.Context, plan *Model, generatedID bool, diags *diag.Diagnostics) {
 	createBody := &vms.CreateRequestBody{
 		Description: plan.Description.ValueStringPointer(),
 		Name:        plan.Name.ValueStringPointer(),
@@ -169,7 +176,28 @@ func (r *Resource) create(ctx context.Context, plan Model, diags *diag.Diagnosti
 	// .VM(0) is used to create a new VM, the VM ID is not used in the API URL
 	vmAPI := r.client.Node(plan.NodeName.ValueString()).VM(0)
 
-	if vmAPI.CreateVM(ctx, createBody).AddDiags(diags, fmt.Sprintf("Unable to Create VM %d", plan.ID.ValueInt64())) {
+	var createResult tasks.TaskResult
+
+	createVM := func(ctx context.Context, id int) error {
+		createBody.VMID = id
+		createResult = vmAPI.CreateVM(ctx, createBody)
+
+		return createResult.Err()
+	}
+
+	if generatedID {
+		// the generated ID may have been taken since it was allocated, retry with a new one
+		id, err := r.idGenerator.CreateWithRetry(ctx, createBody.VMID, createVM)
+		if err != nil {
+			createResult = tasks.TaskFailedWithWarnings(err, createResult.Warnings())
+		}
+
+		plan.ID = types.Int64Value(int64(id))
+	} else {
+		_ = createVM(ctx, createBody.VMID)
+	}
+
+	if createResult.AddDiags(diags, fmt.Sprintf("Unable to Create VM %d", plan.ID.ValueInt64())) {
 		return
 	}
 
@@ -340,6 +368,12 @@ func (r *Resource) Delete(ctx context.Context, req resource.DeleteRequest, resp
 
 	vmAPI := r.client.Node(state.NodeName.ValueString()).VM(int(state.ID.ValueInt64()))
 
+	ClearProtection(ctx, vmAPI, r.forceDelete, &resp.Diagnostics)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
 	// Stop or shut down the virtual machine before deleting it.
 	status, err := vmAPI.GetVMStatus(ctx)
 	if err != nil {
@@ -355,7 +389,7 @@ func (r *Resource) Delete(ctx context.Context, req resource.DeleteRequest, resp
 		if state.StopOnDestroy.ValueBool() {
 			vmStop(ctx, vmAPI).AddDiagsAsWarnings(&resp.Diagnostics, fmt.Sprintf("Unable to Stop VM %d", state.ID.ValueInt64()))
 		} else {
-			vmShutdown(ctx, vmAPI).AddDiagsAsWarnings(&resp.Diagnostics, fmt.Sprintf("Unable to Shutdown VM %d", state.ID.ValueInt64()))
+			vmShutdown(ctx, vmAPI, r.shutdownTimeout).AddDiagsAsWarnings(&resp.Diagnostics, fmt.Sprintf("Unable to Shutdown VM %d", state.ID.ValueInt64()))
 		}
 	}
 
@@ -434,9 +468,42 @@ func (r *Resource) ImportState(
 	resp.Diagnostics.Append(diags...)
 }
 
+// ClearProtection clears the protection flag of a VM before it is deleted, as Proxmox VE rejects the removal
+// of a protected VM. Without the provider's `force_delete` option, a protected VM is reported as an error instead.
+func ClearProtection(ctx context.Context, vmAPI *vms.Client, forceDelete bool, diags *diag.Diagnostics) {
+	vmConfig, err := vmAPI.GetVM(ctx)
+	if err != nil {
+		if !errors.Is(err, api.ErrResourceDoesNotExist) {
+			diags.AddError(fmt.Sprintf("Unable to Read VM %d", vmAPI.VMID), err.Error())
+		}
+
+		return
+	}
+
+	if vmConfig.DeletionProtection == nil || !bool(*vmConfig.DeletionProtection) {
+		return
+	}
+
+	if !forceDelete {
+		diags.AddError(
+			fmt.Sprintf("Unable to Delete VM %d", vmAPI.VMID),
+			"The VM has the protection flag set and cannot be deleted, clear the flag before destroying it, "+
+				"or enable `force_delete` in the provider configuration",
+		)
+
+		return
+	}
+
+	protection := proxmoxtypes.CustomBool(false)
+
+	if err = vmAPI.UpdateVM(ctx, &vms.UpdateRequestBody{DeletionProtection: &protection}); err != nil {
+		diags.AddError(fmt.Sprintf("Unable to Clear the Protection Flag of VM %d", vmAPI.VMID), err.Error())
+	}
+}
+
 // Shutdown the VM, then wait for it to actually shut down (it may not be shut down immediately if
 // running in HA mode).
-func vmShutdown(ctx context.Context, vmAPI *vms.Client) tasks.TaskResult {
+func vmShutdown(ctx context.Context, vmAPI *vms.Client, shutdownTimeout time.Duration) tasks.TaskResult {
 	tflog.Debug(ctx, "Shutting down VM")
 
 	shutdownTimeoutSec := int(defaultShutdownTimeout.Seconds())
@@ -445,6 +512,10 @@ func vmShutdown(ctx context.Context, vmAPI *vms.Client) tasks.TaskResult {
 		shutdownTimeoutSec = int(time.Until(dl).Seconds())
 	}
 
+	if shutdownTimeout > 0 {
+		shutdownTimeoutSec = int(shutdownTimeout.Seconds())
+	}
+
 	result := vmAPI.ShutdownVM(ctx, &vms.ShutdownRequestBody{
 		ForceStop: proxmoxtypes.CustomBool(true).Pointer(),
 		Timeout:   &shutdownTimeoutSec,
@@ -0,0 +1,96 @@
+/*
+ * This Source Code Form is subject to the terms of the Mozilla Public
+ * License, v. 2.0. If a copy of the MPL was not distributed with this
+ * file, You can obtain one at https://mozilla.org/MPL/2.0/.
+ */
+
+package vmdisk
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-framework/types"
+
+	"github.com/bpg/terraform-provider-proxmox/fwprovider/attribute"
+	"github.com/bpg/terraform-provider-proxmox/proxmox/helpers/ptr"
+	"github.com/bpg/terraform-provider-proxmox/proxmox/nodes/vms"
+	proxmoxtypes "github.com/bpg/terraform-provider-proxmox/proxmox/types"
+)
+
+// vmDiskModel maps the proxmox_vm_disk schema.
+type vmDiskModel struct {
+	ID        types.String `tfsdk:"id"`
+	NodeName  types.String `tfsdk:"node_name"`
+	VMID      types.Int64  `tfsdk:"vm_id"`
+	Interface types.String `tfsdk:"interface"`
+
+	DatastoreID types.String `tfsdk:"datastore_id"`
+	Size        types.Int64  `tfsdk:"size"`
+	FileFormat  types.String `tfsdk:"file_format"`
+	Discard     types.String `tfsdk:"discard"`
+	SSD         types.Bool   `tfsdk:"ssd"`
+	Backup      types.Bool   `tfsdk:"backup"`
+
+	// Local-only delete-time flag.
+	DetachOnly types.Bool `tfsdk:"detach_only"`
+
+	// Computed from the VM configuration.
+	PathInDatastore types.String `tfsdk:"path_in_datastore"`
+}
+
+// resourceID returns the composite identifier of the disk.
+func (m *vmDiskModel) resourceID() string {
+	return fmt.Sprintf("%s/%d/%s", m.NodeName.ValueString(), m.VMID.ValueInt64(), m.Interface.ValueString())
+}
+
+// toNewDevice builds the storage device for allocating a new disk on the VM.
+func (m *vmDiskModel) toNewDevice() vms.CustomStorageDevice {
+	return vms.CustomStorageDevice{
+		FileVolume: fmt.Sprintf("%s:%d", m.DatastoreID.ValueString(), m.Size.ValueInt64()),
+		Format:     attribute.StringPtrFromValue(m.FileFormat),
+		Discard:    attribute.StringPtrFromValue(m.Discard),
+		SSD:        attribute.CustomBoolPtrFromValue(m.SSD),
+		Backup:     attribute.CustomBoolPtrFromValue(m.Backup),
+	}
+}
+
+// applyOptions copies the in-place updatable options from the plan onto an existing device.
+func (m *vmDiskModel) applyOptions(device *vms.CustomStorageDevice) {
+	device.Discard = attribute.StringPtrFromValue(m.Discard)
+	device.SSD = attribute.CustomBoolPtrFromValue(m.SSD)
+	device.Backup = attribute.CustomBoolPtrFromValue(m.Backup)
+
+	// Never re-emit format= or size= for an allocated volume, the volume
+	// itself is resized through the dedicated resize endpoint.
+	device.Format = nil
+	device.Size = nil
+}
+
+// fromAPI populates the model from the VM's storage device configuration.
+// The file format is only set when PVE reports it, callers fall back to
+// the storage API for volumes stored in the storage default format.
+func (m *vmDiskModel) fromAPI(device *vms.CustomStorageDevice) {
+	m.ID = types.StringValue(m.resourceID())
+
+	datastoreID, pathInDatastore, found := strings.Cut(device.FileVolume, ":")
+	if found {
+		m.DatastoreID = types.StringValue(datastoreID)
+		m.PathInDatastore = types.StringValue(pathInDatastore)
+	} else {
+		m.DatastoreID = types.StringValue("")
+		m.PathInDatastore = types.StringValue(device.FileVolume)
+	}
+
+	if device.Size != nil {
+		m.Size = types.Int64Value(device.Size.InGigabytes())
+	}
+
+	if device.Format != nil {
+		m.FileFormat = types.StringValue(*device.Format)
+	}
+
+	m.Discard = types.StringValue(ptr.Or(device.Discard, "ignore"))
+	m.SSD = types.BoolValue(bool(ptr.Or(device.SSD, proxmoxtypes.CustomBool(false))))
+	m.Backup = types.BoolValue(bool(ptr.Or(device.Backup, proxmoxtypes.CustomBool(true))))
+}
@@ -0,0 +1,460 @@
+/*
+ * This Source Code Form is subject to the terms of the Mozilla Public
+ * License, v. 2.0. If a copy of the MPL was not distributed with this
+ * file, You can obtain one at https://mozilla.org/MPL/2.0/.
+ */
+
+package vmdisk
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"regexp"
+	"strconv"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-framework-validators/int64validator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/booldefault"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/int64planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringdefault"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+
+	"github.com/bpg/terraform-provider-proxmox/fwprovider/attribute"
+	"github.com/bpg/terraform-provider-proxmox/fwprovider/config"
+	"github.com/bpg/terraform-provider-proxmox/proxmox"
+	"github.com/bpg/terraform-provider-proxmox/proxmox/api"
+	"github.com/bpg/terraform-provider-proxmox/proxmox/nodes/vms"
+	proxmoxtypes "github.com/bpg/terraform-provider-proxmox/proxmox/types"
+)
+
+var (
+	_ resource.Resource                = &vmDiskResource{}
+	_ resource.ResourceWithConfigure   = &vmDiskResource{}
+	_ resource.ResourceWithImportState = &vmDiskResource{}
+)
+
+// NewResource creates a new resource for managing a single VM disk.
+func NewResource() resource.Resource {
+	return &vmDiskResource{}
+}
+
+type vmDiskResource struct {
+	client proxmox.Client
+}
+
+// Metadata defines the resource type name.
+func (r *vmDiskResource) Metadata(_ context.Context, _ resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = "proxmox_vm_disk"
+}
+
+// Schema defines the schema for the resource.
+func (r *vmDiskResource) Schema(_ context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description: "Manages a single disk attached to an existing VM.",
+		Attributes: map[string]schema.Attribute{
+			"id": attribute.ResourceID(
+				"The unique identifier of this resource, in the format `<node_name>/<vm_id>/<interface>`.",
+			),
+			"node_name": schema.StringAttribute{
+				Description: "The name of the node on which the VM is located.",
+				Required:    true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"vm_id": schema.Int64Attribute{
+				Description: "The ID of the VM to attach the disk to.",
+				Required:    true,
+				Validators: []validator.Int64{
+					int64validator.Between(100, 999999999),
+				},
+				PlanModifiers: []planmodifier.Int64{
+					int64planmodifier.RequiresReplace(),
+				},
+			},
+			"interface": schema.StringAttribute{
+				Description: "The disk interface (e.g. `scsi1`, `virtio2`, `sata0`, `ide1`).",
+				Required:    true,
+				Validators: []validator.String{
+					stringvalidator.RegexMatches(
+						regexp.MustCompile(`^(ide|sata|scsi|virtio)\d+$`),
+						"must be one of `ide<N>`, `sata<N>`, `scsi<N>` or `virtio<N>`",
+					),
+				},
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"datastore_id": schema.StringAttribute{
+				Description: "The identifier of the datastore to allocate the disk in.",
+				Required:    true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"size": schema.Int64Attribute{
+				Description: "The disk size in gigabytes. The disk can be grown in place, shrinking it forces a replacement.",
+				Required:    true,
+				Validators: []validator.Int64{
+					int64validator.AtLeast(1),
+				},
+				PlanModifiers: []planmodifier.Int64{
+					int64planmodifier.RequiresReplaceIf(
+						func(_ context.Context, req planmodifier.Int64Request, resp *int64planmodifier.RequiresReplaceIfFuncResponse) {
+							resp.RequiresReplace = !req.StateValue.IsNull() && !req.PlanValue.IsUnknown() &&
+								req.PlanValue.ValueInt64() < req.StateValue.ValueInt64()
+						},
+						"Requires replacement if the disk size is decreased.",
+						"Requires replacement if the disk size is decreased.",
+					),
+				},
+			},
+			"file_format": schema.StringAttribute{
+				Description: "The file format of the disk (`qcow2`, `raw` or `vmdk`). " +
+					"Defaults to the default format of the datastore.",
+				Optional: true,
+				Computed: true,
+				Validators: []validator.String{
+					stringvalidator.OneOf("qcow2", "raw", "vmdk"),
+				},
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+					stringplanmodifier.RequiresReplaceIfConfigured(),
+				},
+			},
+			"discard": schema.StringAttribute{
+				Description: "Whether to pass discard/trim requests to the underlying storage (`on` or `ignore`). " +
+					"Defaults to `ignore`.",
+				Optional: true,
+				Computed: true,
+				Default:  stringdefault.StaticString("ignore"),
+				Validators: []validator.String{
+					stringvalidator.OneOf("on", "ignore"),
+				},
+			},
+			"ssd": schema.BoolAttribute{
+				Description: "Whether to present the disk to the guest as a solid-state drive. " +
+					"Not supported by the `virtio` interface. Defaults to `false`.",
+				Optional: true,
+				Computed: true,
+				Default:  booldefault.StaticBool(false),
+			},
+			"backup": schema.BoolAttribute{
+				Description: "Whether to include the disk in backups. Defaults to `true`.",
+				Optional:    true,
+				Computed:    true,
+				Default:     booldefault.StaticBool(true),
+			},
+			"detach_only": schema.BoolAttribute{
+				Description: "On destroy, only detach the disk from the VM and keep the volume as an unused disk, " +
+					"instead of destroying it. Defaults to `false`.",
+				Optional: true,
+				Computed: true,
+				Default:  booldefault.StaticBool(false),
+			},
+			"path_in_datastore": schema.StringAttribute{
+				Description: "The path of the disk volume in the datastore.",
+				Computed:    true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+		},
+	}
+}
+
+// Configure captures the provider-configured API client.
+func (r *vmDiskResource) Configure(_ context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	cfg, ok := req.ProviderData.(config.Resource)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Resource Configure Type",
+			fmt.Sprintf("Expected config.Resource, got: %T", req.ProviderData),
+		)
+
+		return
+	}
+
+	r.client = cfg.Client
+}
+
+// vmClient returns the API client of the VM the disk is attached to.
+func (r *vmDiskResource) vmClient(m *vmDiskModel) *vms.Client {
+	return r.client.Node(m.NodeName.ValueString()).VM(int(m.VMID.ValueInt64()))
+}
+
+// Create allocates a new disk and attaches it to the VM.
+func (r *vmDiskResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var plan vmDiskModel
+
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	iface := plan.Interface.ValueString()
+	vmAPI := r.vmClient(&plan)
+
+	vmConfig, err := vmAPI.GetVM(ctx)
+	if err != nil {
+		resp.Diagnostics.AddError(fmt.Sprintf("Unable to Read VM %d", plan.VMID.ValueInt64()), err.Error())
+
+		return
+	}
+
+	if _, exists := vmConfig.StorageDevices[iface]; exists {
+		resp.Diagnostics.AddError(
+			fmt.Sprintf("Unable to Create VM disk %q", iface),
+			fmt.Sprintf("Interface %q is already in use on VM %d. Import the existing disk instead, "+
+				"or remove it from the VM's disk blocks.", iface, plan.VMID.ValueInt64()),
+		)
+
+		return
+	}
+
+	body := &vms.UpdateRequestBody{}
+	body.AddCustomStorageDevice(iface, plan.toNewDevice())
+
+	if err := vmAPI.UpdateVM(ctx, body); err != nil {
+		resp.Diagnostics.AddError(fmt.Sprintf("Unable to Create VM disk %q", iface), err.Error())
+
+		return
+	}
+
+	plan.ID = types.StringValue(plan.resourceID())
+
+	if !r.read(ctx, &plan, &resp.Diagnostics) {
+		if !resp.Diagnostics.HasError() {
+			resp.Diagnostics.AddError(
+				fmt.Sprintf("Unable to Read VM disk %q after creation", iface),
+				fmt.Sprintf("Disk %q was not found on VM %d.", iface, plan.VMID.ValueInt64()),
+			)
+		}
+
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &plan)...)
+}
+
+// Read refreshes the disk attributes from the VM configuration.
+func (r *vmDiskResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var state vmDiskModel
+
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if !r.read(ctx, &state, &resp.Diagnostics) {
+		if !resp.Diagnostics.HasError() {
+			resp.State.RemoveResource(ctx)
+		}
+
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &state)...)
+}
+
+// Update grows the disk and applies in-place option changes.
+func (r *vmDiskResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var plan, state vmDiskModel
+
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	iface := plan.Interface.ValueString()
+	vmAPI := r.vmClient(&plan)
+
+	if plan.Size.ValueInt64() > state.Size.ValueInt64() {
+		result := vmAPI.ResizeVMDisk(ctx, &vms.ResizeDiskRequestBody{
+			Disk: iface,
+			Size: *proxmoxtypes.DiskSizeFromGigabytes(plan.Size.ValueInt64()),
+		})
+		if result.AddDiags(&resp.Diagnostics, fmt.Sprintf("Unable to Resize VM disk %q", iface)) {
+			return
+		}
+	}
+
+	if !plan.Discard.Equal(state.Discard) || !plan.SSD.Equal(state.SSD) || !plan.Backup.Equal(state.Backup) {
+		vmConfig, err := vmAPI.GetVM(ctx)
+		if err != nil {
+			resp.Diagnostics.AddError(fmt.Sprintf("Unable to Read VM %d", plan.VMID.ValueInt64()), err.Error())
+
+			return
+		}
+
+		device, exists := vmConfig.StorageDevices[iface]
+		if !exists || device == nil {
+			resp.Diagnostics.AddError(
+				fmt.Sprintf("Unable to Update VM disk %q", iface),
+				fmt.Sprintf("Disk %q was not found on VM %d.", iface, plan.VMID.ValueInt64()),
+			)
+
+			return
+		}
+
+		plan.applyOptions(device)
+
+		body := &vms.UpdateRequestBody{}
+		body.AddCustomStorageDevice(iface, *device)
+
+		if err := vmAPI.UpdateVM(ctx, body); err != nil {
+			resp.Diagnostics.AddError(fmt.Sprintf("Unable to Update VM disk %q", iface), err.Error())
+
+			return
+		}
+	}
+
+	if !r.read(ctx, &plan, &resp.Diagnostics) {
+		if !resp.Diagnostics.HasError() {
+			resp.Diagnostics.AddError(
+				fmt.Sprintf("Unable to Read VM disk %q after update", iface),
+				fmt.Sprintf("Disk %q was not found on VM %d.", iface, plan.VMID.ValueInt64()),
+			)
+		}
+
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &plan)...)
+}
+
+// Delete detaches the disk from the VM and, unless `detach_only` is set, destroys the volume.
+func (r *vmDiskResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	var state vmDiskModel
+
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	iface := state.Interface.ValueString()
+	vmAPI := r.vmClient(&state)
+
+	var err error
+
+	if state.DetachOnly.ValueBool() {
+		body := &vms.UpdateRequestBody{}
+		body.AppendDelete(iface)
+
+		err = vmAPI.UpdateVM(ctx, body)
+	} else {
+		err = vmAPI.UnlinkVMDisks(ctx, &vms.UnlinkDisksRequestBody{
+			IDList: []string{iface},
+			Force:  proxmoxtypes.CustomBool(true).Pointer(),
+		})
+	}
+
+	if err != nil && !errors.Is(err, api.ErrResourceDoesNotExist) {
+		resp.Diagnostics.AddError(fmt.Sprintf("Unable to Delete VM disk %q", iface), err.Error())
+	}
+}
+
+// ImportState imports an existing VM disk into Terraform state.
+// The import ID must be `<node_name>/<vm_id>/<interface>`.
+func (r *vmDiskResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	idParts := strings.Split(req.ID, "/")
+	if len(idParts) != 3 || idParts[0] == "" || idParts[2] == "" {
+		resp.Diagnostics.AddError(
+			"Unexpected Import Identifier",
+			fmt.Sprintf("Expected import identifier with format `<node_name>/<vm_id>/<interface>`. Got: %q", req.ID),
+		)
+
+		return
+	}
+
+	vmID, err := strconv.ParseInt(idParts[1], 10, 64)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Unexpected Import Identifier",
+			fmt.Sprintf("Invalid VM ID %q in import identifier %q: %s", idParts[1], req.ID, err),
+		)
+
+		return
+	}
+
+	state := vmDiskModel{
+		NodeName:   types.StringValue(idParts[0]),
+		VMID:       types.Int64Value(vmID),
+		Interface:  types.StringValue(idParts[2]),
+		DetachOnly: types.BoolValue(false),
+	}
+
+	if !r.read(ctx, &state, &resp.Diagnostics) {
+		if !resp.Diagnostics.HasError() {
+			resp.Diagnostics.AddError(
+				"VM Disk Not Found",
+				fmt.Sprintf("Disk %q does not exist on VM %d on node %q.", idParts[2], vmID, idParts[0]),
+			)
+		}
+
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &state)...)
+}
+
+// read populates the model from the VM configuration. It returns false when
+// the VM or the disk does not exist, so the caller can decide how to handle it.
+func (r *vmDiskResource) read(ctx context.Context, m *vmDiskModel, diags *diag.Diagnostics) bool {
+	iface := m.Interface.ValueString()
+
+	vmConfig, err := r.vmClient(m).GetVM(ctx)
+	if err != nil {
+		if errors.Is(err, api.ErrResourceDoesNotExist) {
+			return false
+		}
+
+		diags.AddError(fmt.Sprintf("Unable to Read VM %d", m.VMID.ValueInt64()), err.Error())
+
+		return false
+	}
+
+	device, exists := vmConfig.StorageDevices[iface]
+	if !exists || device == nil || (device.Media != nil && *device.Media == "cdrom") {
+		return false
+	}
+
+	m.fromAPI(device)
+
+	if device.Format == nil && m.DatastoreID.ValueString() != "" {
+		// the config API omits the format when it is the storage default, so look it up on the volume
+		volume, err := r.client.Node(m.NodeName.ValueString()).
+			Storage(m.DatastoreID.ValueString()).
+			GetDatastoreFile(ctx, device.FileVolume)
+		if err != nil {
+			diags.AddError(fmt.Sprintf("Unable to Read VM disk %q volume", iface), err.Error())
+
+			return false
+		}
+
+		m.FileFormat = attribute.StringValueFromPtr(volume.FileFormat)
+	}
+
+	if m.FileFormat.IsUnknown() {
+		m.FileFormat = types.StringNull()
+	}
+
+	return true
+}
@@ -0,0 +1,161 @@
+//go:build acceptance || all
+
+/*
+ * This Source Code Form is subject to the terms of the Mozilla Public
+ * License, v. 2.0. If a copy of the MPL was not distributed with this
+ * file, You can obtain one at https://mozilla.org/MPL/2.0/.
+ */
+
+//testacc:tier=medium
+//testacc:resource=vm
+
+package vmdisk_test
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/terraform"
+
+	"github.com/bpg/terraform-provider-proxmox/fwprovider/test"
+)
+
+const vmDiskTestVM = `
+resource "proxmox_virtual_environment_vm" "test_vm" {
+	node_name = "{{.NodeName}}"
+	name      = "test-vm-disk"
+	started   = false
+
+	disk {
+		datastore_id = "{{.DatastoreID}}"
+		interface    = "scsi0"
+		size         = 1
+	}
+}
+`
+
+func TestAccResourceVMDisk(t *testing.T) {
+	t.Parallel()
+
+	te := test.InitEnvironment(t)
+
+	tests := []struct {
+		name  string
+		steps []resource.TestStep
+	}{
+		{"attach, update and import a disk", []resource.TestStep{
+			{
+				Config: te.RenderConfig(vmDiskTestVM + `
+				resource "proxmox_vm_disk" "test" {
+					node_name    = "{{.NodeName}}"
+					vm_id        = proxmox_virtual_environment_vm.test_vm.vm_id
+					interface    = "scsi1"
+					datastore_id = "{{.DatastoreID}}"
+					size         = 1
+				}`),
+				Check: resource.ComposeTestCheckFunc(
+					test.ResourceAttributes("proxmox_vm_disk.test", map[string]string{
+						"interface":    "scsi1",
+						"datastore_id": te.DatastoreID,
+						"size":         "1",
+						"discard":      "ignore",
+						"ssd":          "false",
+						"backup":       "true",
+					}),
+					test.ResourceAttributesSet("proxmox_vm_disk.test", []string{
+						"file_format",
+						"path_in_datastore",
+					}),
+				),
+			},
+			{
+				Config: te.RenderConfig(vmDiskTestVM + `
+				resource "proxmox_vm_disk" "test" {
+					node_name    = "{{.NodeName}}"
+					vm_id        = proxmox_virtual_environment_vm.test_vm.vm_id
+					interface    = "scsi1"
+					datastore_id = "{{.DatastoreID}}"
+					size         = 2
+					discard      = "on"
+					ssd          = true
+					backup       = false
+				}`),
+				Check: resource.ComposeTestCheckFunc(
+					test.ResourceAttributes("proxmox_vm_disk.test", map[string]string{
+						"size":    "2",
+						"discard": "on",
+						"ssd":     "true",
+						"backup":  "false",
+					}),
+					// the VM resource must not pick up the externally managed disk
+					test.ResourceAttributes("proxmox_virtual_environment_vm.test_vm", map[string]string{
+						"disk.#": "1",
+					}),
+				),
+			},
+			{
+				ResourceName:            "proxmox_vm_disk.test",
+				ImportState:             true,
+				ImportStateVerify:       true,
+				ImportStateVerifyIgnore: []string{"detach_only"},
+				ImportStateIdFunc: func(s *terraform.State) (string, error) {
+					rs, ok := s.RootModule().Resources["proxmox_vm_disk.test"]
+					if !ok {
+						return "", fmt.Errorf("resource not found")
+					}
+
+					return rs.Primary.ID, nil
+				},
+			},
+		}},
+		{"detach only", []resource.TestStep{
+			{
+				Config: te.RenderConfig(vmDiskTestVM + `
+				resource "proxmox_vm_disk" "test" {
+					node_name    = "{{.NodeName}}"
+					vm_id        = proxmox_virtual_environment_vm.test_vm.vm_id
+					interface    = "virtio1"
+					datastore_id = "{{.DatastoreID}}"
+					size         = 1
+					detach_only  = true
+				}`),
+			},
+			{
+				Config: te.RenderConfig(vmDiskTestVM),
+				Check: func(s *terraform.State) error {
+					rs, ok := s.RootModule().Resources["proxmox_virtual_environment_vm.test_vm"]
+					if !ok {
+						return fmt.Errorf("resource not found")
+					}
+
+					var vmID int
+					if _, err := fmt.Sscanf(rs.Primary.Attributes["vm_id"], "%d", &vmID); err != nil {
+						return fmt.Errorf("invalid vm_id: %w", err)
+					}
+
+					config, err := te.NodeClient().VM(vmID).GetVM(context.Background())
+					if err != nil {
+						return fmt.Errorf("failed to get VM config: %w", err)
+					}
+
+					if _, exists := config.StorageDevices["virtio1"]; exists {
+						return fmt.Errorf("disk virtio1 is still attached")
+					}
+
+					return nil
+				},
+			},
+		}},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			resource.ParallelTest(t, resource.TestCase{
+				ProtoV6ProviderFactories: te.AccProviders,
+				Steps:                    tt.steps,
+			})
+		})
+	}
+}
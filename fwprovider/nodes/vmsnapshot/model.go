@@ -0,0 +1,73 @@
+/*
+ * This Source Code Form is subject to the terms of the Mozilla Public
+ * License, v. 2.0. If a copy of the MPL was not distributed with this
+ * file, You can obtain one at https://mozilla.org/MPL/2.0/.
+ */
+
+package vmsnapshot
+
+import (
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-framework/types"
+
+	"github.com/bpg/terraform-provider-proxmox/fwprovider/attribute"
+	"github.com/bpg/terraform-provider-proxmox/proxmox/nodes/vms"
+)
+
+// vmSnapshotModel maps the proxmox_vm_snapshot schema.
+type vmSnapshotModel struct {
+	ID       types.String `tfsdk:"id"`
+	NodeName types.String `tfsdk:"node_name"`
+	VMID     types.Int64  `tfsdk:"vm_id"`
+	Name     types.String `tfsdk:"name"`
+
+	Description types.String `tfsdk:"description"`
+	VMState     types.Bool   `tfsdk:"vmstate"`
+
+	// Computed from the snapshot list.
+	Parent   types.String `tfsdk:"parent"`
+	SnapTime types.Int64  `tfsdk:"snaptime"`
+}
+
+// resourceID returns the composite identifier of the snapshot.
+func (m *vmSnapshotModel) resourceID() string {
+	return fmt.Sprintf("%s/%d/%s", m.NodeName.ValueString(), m.VMID.ValueInt64(), m.Name.ValueString())
+}
+
+// toCreateRequest builds the request body for creating the snapshot.
+func (m *vmSnapshotModel) toCreateRequest() *vms.SnapshotCreateRequestBody {
+	return &vms.SnapshotCreateRequestBody{
+		Name:        m.Name.ValueString(),
+		Description: attribute.StringPtrFromValue(m.Description),
+		VMState:     attribute.CustomBoolPtrFromValue(m.VMState),
+	}
+}
+
+// fromAPI populates the model from a snapshot list entry.
+func (m *vmSnapshotModel) fromAPI(snapshot *vms.SnapshotListResponseData) {
+	m.ID = types.StringValue(m.resourceID())
+
+	if snapshot.Description != nil && *snapshot.Description != "" {
+		m.Description = types.StringValue(*snapshot.Description)
+	} else {
+		m.Description = types.StringNull()
+	}
+
+	// PVE only records the RAM state of a running VM, so a snapshot of a stopped VM
+	// taken with vmstate enabled is reported without it. Keep the configured value
+	// in that case instead of replacing the snapshot on every plan.
+	if snapshot.VMState != nil && bool(*snapshot.VMState) {
+		m.VMState = types.BoolValue(true)
+	} else if m.VMState.IsNull() || m.VMState.IsUnknown() {
+		m.VMState = types.BoolValue(false)
+	}
+
+	if snapshot.Parent != nil && *snapshot.Parent != "" {
+		m.Parent = types.StringValue(*snapshot.Parent)
+	} else {
+		m.Parent = types.StringNull()
+	}
+
+	m.SnapTime = types.Int64PointerValue(snapshot.SnapTime)
+}
@@ -0,0 +1,343 @@
+/*
+ * This Source Code Form is subject to the terms of the Mozilla Public
+ * License, v. 2.0. If a copy of the MPL was not distributed with this
+ * file, You can obtain one at https://mozilla.org/MPL/2.0/.
+ */
+
+package vmsnapshot
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"regexp"
+	"strconv"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-framework-validators/int64validator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/booldefault"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/boolplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/int64planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+
+	"github.com/bpg/terraform-provider-proxmox/fwprovider/attribute"
+	"github.com/bpg/terraform-provider-proxmox/fwprovider/config"
+	"github.com/bpg/terraform-provider-proxmox/proxmox"
+	"github.com/bpg/terraform-provider-proxmox/proxmox/api"
+	"github.com/bpg/terraform-provider-proxmox/proxmox/nodes/vms"
+)
+
+var (
+	_ resource.Resource                = &vmSnapshotResource{}
+	_ resource.ResourceWithConfigure   = &vmSnapshotResource{}
+	_ resource.ResourceWithImportState = &vmSnapshotResource{}
+)
+
+// NewResource creates a new resource for managing a single VM snapshot.
+func NewResource() resource.Resource {
+	return &vmSnapshotResource{}
+}
+
+type vmSnapshotResource struct {
+	client proxmox.Client
+}
+
+// Metadata defines the resource type name.
+func (r *vmSnapshotResource) Metadata(_ context.Context, _ resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = "proxmox_vm_snapshot"
+}
+
+// Schema defines the schema for the resource.
+func (r *vmSnapshotResource) Schema(_ context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description: "Manages a snapshot of an existing VM.",
+		Attributes: map[string]schema.Attribute{
+			"id": attribute.ResourceID(
+				"The unique identifier of this resource, in the format `<node_name>/<vm_id>/<name>`.",
+			),
+			"node_name": schema.StringAttribute{
+				Description: "The name of the node on which the VM is located.",
+				Required:    true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"vm_id": schema.Int64Attribute{
+				Description: "The ID of the VM to snapshot.",
+				Required:    true,
+				Validators: []validator.Int64{
+					int64validator.Between(100, 999999999),
+				},
+				PlanModifiers: []planmodifier.Int64{
+					int64planmodifier.RequiresReplace(),
+				},
+			},
+			"name": schema.StringAttribute{
+				Description: "The name of the snapshot. Must start with a letter and contain only letters, " +
+					"digits, `-` and `_`. The name `current` is reserved for the current state of the VM.",
+				Required: true,
+				Validators: []validator.String{
+					stringvalidator.LengthBetween(2, 40),
+					stringvalidator.RegexMatches(
+						regexp.MustCompile(`^[a-zA-Z][a-zA-Z0-9_-]+$`),
+						"must start with a letter and contain only letters, digits, `-` and `_`",
+					),
+					stringvalidator.NoneOfCaseInsensitive(vms.CurrentSnapshotName),
+				},
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"description": schema.StringAttribute{
+				Description: "The description of the snapshot.",
+				Optional:    true,
+				Validators: []validator.String{
+					stringvalidator.LengthAtLeast(1),
+				},
+			},
+			"vmstate": schema.BoolAttribute{
+				Description: "Whether to include the RAM state of the VM in the snapshot. Only has an effect " +
+					"when the VM is running. Defaults to `false`.",
+				Optional: true,
+				Computed: true,
+				Default:  booldefault.StaticBool(false),
+				PlanModifiers: []planmodifier.Bool{
+					boolplanmodifier.RequiresReplace(),
+				},
+			},
+			"parent": schema.StringAttribute{
+				Description: "The name of the parent snapshot, if any.",
+				Computed:    true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"snaptime": schema.Int64Attribute{
+				Description: "The creation time of the snapshot as a Unix timestamp.",
+				Computed:    true,
+				PlanModifiers: []planmodifier.Int64{
+					int64planmodifier.UseStateForUnknown(),
+				},
+			},
+		},
+	}
+}
+
+// Configure captures the provider-configured API client.
+func (r *vmSnapshotResource) Configure(_ context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	cfg, ok := req.ProviderData.(config.Resource)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Resource Configure Type",
+			fmt.Sprintf("Expected config.Resource, got: %T", req.ProviderData),
+		)
+
+		return
+	}
+
+	r.client = cfg.Client
+}
+
+// vmClient returns the API client of the snapshotted VM.
+func (r *vmSnapshotResource) vmClient(m *vmSnapshotModel) *vms.Client {
+	return r.client.Node(m.NodeName.ValueString()).VM(int(m.VMID.ValueInt64()))
+}
+
+// Create takes the snapshot and waits for the snapshot task to finish.
+func (r *vmSnapshotResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var plan vmSnapshotModel
+
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	name := plan.Name.ValueString()
+
+	result := r.vmClient(&plan).CreateVMSnapshot(ctx, plan.toCreateRequest())
+	if result.AddDiags(&resp.Diagnostics, fmt.Sprintf("Unable to Create VM snapshot %q", name)) {
+		return
+	}
+
+	plan.ID = types.StringValue(plan.resourceID())
+
+	if !r.read(ctx, &plan, &resp.Diagnostics) {
+		if !resp.Diagnostics.HasError() {
+			resp.Diagnostics.AddError(
+				fmt.Sprintf("Unable to Read VM snapshot %q after creation", name),
+				fmt.Sprintf("Snapshot %q was not found on VM %d.", name, plan.VMID.ValueInt64()),
+			)
+		}
+
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &plan)...)
+}
+
+// Read refreshes the snapshot attributes from the VM snapshot list.
+func (r *vmSnapshotResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var state vmSnapshotModel
+
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if !r.read(ctx, &state, &resp.Diagnostics) {
+		if !resp.Diagnostics.HasError() {
+			resp.State.RemoveResource(ctx)
+		}
+
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &state)...)
+}
+
+// Update applies a description change, the only snapshot attribute that can be changed in place.
+func (r *vmSnapshotResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var plan, state vmSnapshotModel
+
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	name := plan.Name.ValueString()
+
+	if !plan.Description.Equal(state.Description) {
+		err := r.vmClient(&plan).UpdateVMSnapshot(ctx, name, &vms.SnapshotUpdateRequestBody{
+			Description: plan.Description.ValueString(),
+		})
+		if err != nil {
+			resp.Diagnostics.AddError(fmt.Sprintf("Unable to Update VM snapshot %q", name), err.Error())
+
+			return
+		}
+	}
+
+	if !r.read(ctx, &plan, &resp.Diagnostics) {
+		if !resp.Diagnostics.HasError() {
+			resp.Diagnostics.AddError(
+				fmt.Sprintf("Unable to Read VM snapshot %q after update", name),
+				fmt.Sprintf("Snapshot %q was not found on VM %d.", name, plan.VMID.ValueInt64()),
+			)
+		}
+
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &plan)...)
+}
+
+// Delete removes the snapshot. Its children, if any, are re-parented to its parent by Proxmox VE.
+func (r *vmSnapshotResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	var state vmSnapshotModel
+
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	name := state.Name.ValueString()
+
+	result := r.vmClient(&state).DeleteVMSnapshot(ctx, name)
+	if errors.Is(result.Err(), api.ErrResourceDoesNotExist) {
+		return
+	}
+
+	result.AddDiags(&resp.Diagnostics, fmt.Sprintf("Unable to Delete VM snapshot %q", name))
+}
+
+// ImportState imports an existing VM snapshot into Terraform state.
+// The import ID must be `<node_name>/<vm_id>/<name>`.
+func (r *vmSnapshotResource) ImportState(
+	ctx context.Context,
+	req resource.ImportStateRequest,
+	resp *resource.ImportStateResponse,
+) {
+	idParts := strings.Split(req.ID, "/")
+	if len(idParts) != 3 || idParts[0] == "" || idParts[2] == "" {
+		resp.Diagnostics.AddError(
+			"Unexpected Import Identifier",
+			fmt.Sprintf("Expected import identifier with format `<node_name>/<vm_id>/<name>`. Got: %q", req.ID),
+		)
+
+		return
+	}
+
+	vmID, err := strconv.ParseInt(idParts[1], 10, 64)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Unexpected Import Identifier",
+			fmt.Sprintf("Invalid VM ID %q in import identifier %q: %s", idParts[1], req.ID, err),
+		)
+
+		return
+	}
+
+	if strings.EqualFold(idParts[2], vms.CurrentSnapshotName) {
+		resp.Diagnostics.AddError(
+			"Unexpected Import Identifier",
+			fmt.Sprintf("%q is the current state of the VM, not a snapshot, and can't be imported.", idParts[2]),
+		)
+
+		return
+	}
+
+	state := vmSnapshotModel{
+		NodeName: types.StringValue(idParts[0]),
+		VMID:     types.Int64Value(vmID),
+		Name:     types.StringValue(idParts[2]),
+		VMState:  types.BoolNull(),
+	}
+
+	if !r.read(ctx, &state, &resp.Diagnostics) {
+		if !resp.Diagnostics.HasError() {
+			resp.Diagnostics.AddError(
+				"VM Snapshot Not Found",
+				fmt.Sprintf("Snapshot %q does not exist on VM %d on node %q.", idParts[2], vmID, idParts[0]),
+			)
+		}
+
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &state)...)
+}
+
+// read populates the model from the VM snapshot list. It returns false when
+// the VM or the snapshot does not exist, so the caller can decide how to handle it.
+func (r *vmSnapshotResource) read(ctx context.Context, m *vmSnapshotModel, diags *diag.Diagnostics) bool {
+	snapshot, err := r.vmClient(m).GetVMSnapshot(ctx, m.Name.ValueString())
+	if err != nil {
+		if errors.Is(err, api.ErrResourceDoesNotExist) {
+			return false
+		}
+
+		diags.AddError(fmt.Sprintf("Unable to Read VM %d snapshots", m.VMID.ValueInt64()), err.Error())
+
+		return false
+	}
+
+	m.fromAPI(snapshot)
+
+	return true
+}
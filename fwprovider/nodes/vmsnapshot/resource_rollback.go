@@ -0,0 +1,194 @@
+/*
+ * This Source Code Form is subject to the terms of the Mozilla Public
+ * License, v. 2.0. If a copy of the MPL was not distributed with this
+ * file, You can obtain one at https://mozilla.org/MPL/2.0/.
+ */
+
+package vmsnapshot
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-framework-validators/int64validator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/booldefault"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/int64planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/mapplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+
+	"github.com/bpg/terraform-provider-proxmox/fwprovider/attribute"
+	"github.com/bpg/terraform-provider-proxmox/fwprovider/config"
+	"github.com/bpg/terraform-provider-proxmox/proxmox"
+	"github.com/bpg/terraform-provider-proxmox/proxmox/nodes/vms"
+	proxmoxtypes "github.com/bpg/terraform-provider-proxmox/proxmox/types"
+)
+
+var (
+	_ resource.Resource              = &vmSnapshotRollbackResource{}
+	_ resource.ResourceWithConfigure = &vmSnapshotRollbackResource{}
+)
+
+// vmSnapshotRollbackModel maps the proxmox_vm_snapshot_rollback schema.
+type vmSnapshotRollbackModel struct {
+	ID           types.String `tfsdk:"id"`
+	NodeName     types.String `tfsdk:"node_name"`
+	VMID         types.Int64  `tfsdk:"vm_id"`
+	SnapshotName types.String `tfsdk:"snapshot_name"`
+	Start        types.Bool   `tfsdk:"start"`
+	Triggers     types.Map    `tfsdk:"triggers"`
+}
+
+// NewRollbackResource creates a new resource that rolls a VM back to a snapshot.
+func NewRollbackResource() resource.Resource {
+	return &vmSnapshotRollbackResource{}
+}
+
+type vmSnapshotRollbackResource struct {
+	client proxmox.Client
+}
+
+// Metadata defines the resource type name.
+func (r *vmSnapshotRollbackResource) Metadata(
+	_ context.Context,
+	_ resource.MetadataRequest,
+	resp *resource.MetadataResponse,
+) {
+	resp.TypeName = "proxmox_vm_snapshot_rollback"
+}
+
+// Schema defines the schema for the resource.
+func (r *vmSnapshotRollbackResource) Schema(_ context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description: "Rolls a VM back to a snapshot. The rollback runs when the resource is created, and again " +
+			"whenever `snapshot_name` or `triggers` change. It discards all changes made to the VM since the " +
+			"snapshot was taken.",
+		Attributes: map[string]schema.Attribute{
+			"id": attribute.ResourceID(
+				"The unique identifier of this resource, in the format `<node_name>/<vm_id>/<snapshot_name>`.",
+			),
+			"node_name": schema.StringAttribute{
+				Description: "The name of the node on which the VM is located.",
+				Required:    true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"vm_id": schema.Int64Attribute{
+				Description: "The ID of the VM to roll back.",
+				Required:    true,
+				Validators: []validator.Int64{
+					int64validator.Between(100, 999999999),
+				},
+				PlanModifiers: []planmodifier.Int64{
+					int64planmodifier.RequiresReplace(),
+				},
+			},
+			"snapshot_name": schema.StringAttribute{
+				Description: "The name of the snapshot to roll back to. Changing it performs a new rollback.",
+				Required:    true,
+				Validators: []validator.String{
+					stringvalidator.LengthAtLeast(1),
+					stringvalidator.NoneOfCaseInsensitive(vms.CurrentSnapshotName),
+				},
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"start": schema.BoolAttribute{
+				Description: "Whether to start the VM after the rollback. Defaults to `false`, in which case the VM " +
+					"is left in the state recorded by the snapshot.",
+				Optional: true,
+				Computed: true,
+				Default:  booldefault.StaticBool(false),
+			},
+			"triggers": schema.MapAttribute{
+				Description: "Arbitrary values that perform a new rollback whenever they change, " +
+					"e.g. to roll back again to the same snapshot.",
+				ElementType: types.StringType,
+				Optional:    true,
+				PlanModifiers: []planmodifier.Map{
+					mapplanmodifier.RequiresReplace(),
+				},
+			},
+		},
+	}
+}
+
+// Configure captures the provider-configured API client.
+func (r *vmSnapshotRollbackResource) Configure(
+	_ context.Context,
+	req resource.ConfigureRequest,
+	resp *resource.ConfigureResponse,
+) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	cfg, ok := req.ProviderData.(config.Resource)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Resource Configure Type",
+			fmt.Sprintf("Expected config.Resource, got: %T", req.ProviderData),
+		)
+
+		return
+	}
+
+	r.client = cfg.Client
+}
+
+// Create rolls the VM back to the snapshot and waits for the rollback task to finish.
+func (r *vmSnapshotRollbackResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var plan vmSnapshotRollbackModel
+
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	name := plan.SnapshotName.ValueString()
+	vmID := plan.VMID.ValueInt64()
+
+	body := &vms.SnapshotRollbackRequestBody{}
+	if plan.Start.ValueBool() {
+		// only sent when set, older PVE versions don't know the parameter
+		body.Start = proxmoxtypes.CustomBool(true).Pointer()
+	}
+
+	result := r.client.Node(plan.NodeName.ValueString()).VM(int(vmID)).RollbackVMSnapshot(ctx, name, body)
+	if result.AddDiags(&resp.Diagnostics, fmt.Sprintf("Unable to Roll Back VM %d to snapshot %q", vmID, name)) {
+		return
+	}
+
+	plan.ID = types.StringValue(fmt.Sprintf("%s/%d/%s", plan.NodeName.ValueString(), vmID, name))
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &plan)...)
+}
+
+// Read is a no-op, the rollback has no remote state to refresh.
+func (r *vmSnapshotRollbackResource) Read(_ context.Context, _ resource.ReadRequest, _ *resource.ReadResponse) {
+}
+
+// Update stores a change of `start`, which only takes effect on the next rollback.
+func (r *vmSnapshotRollbackResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var plan vmSnapshotRollbackModel
+
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &plan)...)
+}
+
+// Delete only removes the resource from the state, the rollback can't be undone.
+func (r *vmSnapshotRollbackResource) Delete(_ context.Context, _ resource.DeleteRequest, _ *resource.DeleteResponse) {
+}
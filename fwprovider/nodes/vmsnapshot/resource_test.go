@@ -0,0 +1,250 @@
+//go:build acceptance || all
+
+/*
+ * This Source Code Form is subject to the terms of the Mozilla Public
+ * License, v. 2.0. If a copy of the MPL was not distributed with this
+ * file, You can obtain one at https://mozilla.org/MPL/2.0/.
+ */
+
+//testacc:tier=medium
+//testacc:resource=vm
+
+package vmsnapshot_test
+
+import (
+	"context"
+	"fmt"
+	"math/rand"
+	"regexp"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/terraform"
+	"github.com/stretchr/testify/require"
+
+	"github.com/bpg/terraform-provider-proxmox/fwprovider/test"
+	"github.com/bpg/terraform-provider-proxmox/proxmox/nodes/vms"
+)
+
+const vmSnapshotTestVM = `
+resource "proxmox_virtual_environment_vm" "test_vm" {
+	node_name = "{{.NodeName}}"
+	name      = "test-vm-snapshot"
+	started   = false
+
+	disk {
+		datastore_id = "{{.DatastoreID}}"
+		interface    = "scsi0"
+		size         = 1
+	}
+}
+`
+
+const vmSnapshotFirst = `
+resource "proxmox_vm_snapshot" "first" {
+	node_name   = "{{.NodeName}}"
+	vm_id       = proxmox_virtual_environment_vm.test_vm.vm_id
+	name        = "first"
+	description = "{{.Description}}"
+}
+`
+
+const vmSnapshotSecond = `
+resource "proxmox_vm_snapshot" "second" {
+	node_name = "{{.NodeName}}"
+	vm_id     = proxmox_virtual_environment_vm.test_vm.vm_id
+	name      = "second"
+
+	depends_on = [proxmox_vm_snapshot.first]
+}
+`
+
+func TestAccResourceVMSnapshot(t *testing.T) {
+	t.Parallel()
+
+	te := test.InitEnvironment(t)
+
+	config := func(description string, snapshots ...string) string {
+		te.AddTemplateVars(map[string]any{"Description": description})
+
+		cfg := vmSnapshotTestVM
+		for _, s := range snapshots {
+			cfg += s
+		}
+
+		return te.RenderConfig(cfg)
+	}
+
+	resource.ParallelTest(t, resource.TestCase{
+		ProtoV6ProviderFactories: te.AccProviders,
+		Steps: []resource.TestStep{
+			{
+				Config: config("initial", vmSnapshotFirst, vmSnapshotSecond),
+				Check: resource.ComposeTestCheckFunc(
+					test.ResourceAttributes("proxmox_vm_snapshot.first", map[string]string{
+						"name":        "first",
+						"description": "initial",
+						"vmstate":     "false",
+					}),
+					test.NoResourceAttributesSet("proxmox_vm_snapshot.first", []string{"parent"}),
+					test.ResourceAttributesSet("proxmox_vm_snapshot.first", []string{"snaptime"}),
+					test.ResourceAttributes("proxmox_vm_snapshot.second", map[string]string{
+						"parent": "first",
+					}),
+				),
+			},
+			{
+				Config: config("updated", vmSnapshotFirst, vmSnapshotSecond),
+				Check: test.ResourceAttributes("proxmox_vm_snapshot.first", map[string]string{
+					"description": "updated",
+				}),
+			},
+			{
+				ResourceName:      "proxmox_vm_snapshot.first",
+				ImportState:       true,
+				ImportStateVerify: true,
+				ImportStateIdFunc: func(s *terraform.State) (string, error) {
+					rs, ok := s.RootModule().Resources["proxmox_vm_snapshot.first"]
+					if !ok {
+						return "", fmt.Errorf("resource not found")
+					}
+
+					return rs.Primary.ID, nil
+				},
+			},
+			// delete the parent of a snapshot, the child is re-parented
+			{
+				Config: config("updated", vmSnapshotSecond),
+			},
+			{
+				Config: config("updated", vmSnapshotSecond),
+				Check:  test.NoResourceAttributesSet("proxmox_vm_snapshot.second", []string{"parent"}),
+			},
+		},
+	})
+}
+
+func TestAccResourceVMSnapshotInvalid(t *testing.T) {
+	t.Parallel()
+
+	te := test.InitEnvironment(t)
+
+	resource.ParallelTest(t, resource.TestCase{
+		ProtoV6ProviderFactories: te.AccProviders,
+		Steps: []resource.TestStep{
+			{
+				Config: te.RenderConfig(`
+				resource "proxmox_vm_snapshot" "test" {
+					node_name = "{{.NodeName}}"
+					vm_id     = 100
+					name      = "current"
+				}`),
+				PlanOnly:    true,
+				ExpectError: regexp.MustCompile(`value must be none of`),
+			},
+			{
+				ResourceName: "proxmox_vm_snapshot.test",
+				Config: te.RenderConfig(`
+				resource "proxmox_vm_snapshot" "test" {
+					node_name = "{{.NodeName}}"
+					vm_id     = 100
+					name      = "valid"
+				}`),
+				ImportState:   true,
+				ImportStateId: te.NodeName + "/100/current",
+				ExpectError:   regexp.MustCompile(`current state of the VM`),
+			},
+		},
+	})
+}
+
+// TestAccResourceVMSnapshotRollback uses a VM created through the API, so the rolled back
+// configuration is not reported as drift by a VM resource.
+func TestAccResourceVMSnapshotRollback(t *testing.T) {
+	t.Parallel()
+
+	te := test.InitEnvironment(t)
+	ctx := context.Background()
+
+	vmID := 100000 + rand.Intn(99999)
+	vmAPI := te.NodeClient().VM(vmID)
+
+	createResult := te.NodeClient().VM(0).CreateVM(ctx, &vms.CreateRequestBody{VMID: vmID, Description: new("before")})
+	require.NoError(t, createResult.Err(), "failed to create VM %d", vmID)
+
+	t.Cleanup(func() {
+		_ = vmAPI.DeleteVM(context.Background(), true, true).Err()
+	})
+
+	te.AddTemplateVars(map[string]any{"TestVMID": vmID})
+
+	config := func(trigger string) string {
+		te.AddTemplateVars(map[string]any{"Trigger": trigger})
+
+		return te.RenderConfig(`
+			resource "proxmox_vm_snapshot" "base" {
+				node_name = "{{.NodeName}}"
+				vm_id     = {{.TestVMID}}
+				name      = "base"
+			}
+
+			resource "proxmox_vm_snapshot_rollback" "test" {
+				node_name     = "{{.NodeName}}"
+				vm_id         = {{.TestVMID}}
+				snapshot_name = proxmox_vm_snapshot.base.name
+
+				triggers = {
+					run = "{{.Trigger}}"
+				}
+			}`)
+	}
+
+	// changeDescription changes the VM configuration after the snapshot was taken.
+	changeDescription := func() {
+		require.NoError(t, vmAPI.UpdateVM(ctx, &vms.UpdateRequestBody{Description: new("after")}))
+	}
+
+	// checkDescription verifies that the rollback restored the configuration of the snapshot.
+	checkDescription := func(_ *terraform.State) error {
+		vmConfig, err := vmAPI.GetVM(ctx)
+		if err != nil {
+			return fmt.Errorf("failed to get VM config: %w", err)
+		}
+
+		if vmConfig.Description == nil || *vmConfig.Description != "before" {
+			return fmt.Errorf("expected the VM description to be rolled back to %q, got %v", "before", vmConfig.Description)
+		}
+
+		return nil
+	}
+
+	resource.ParallelTest(t, resource.TestCase{
+		ProtoV6ProviderFactories: te.AccProviders,
+		Steps: []resource.TestStep{
+			{
+				Config: te.RenderConfig(`
+				resource "proxmox_vm_snapshot" "base" {
+					node_name = "{{.NodeName}}"
+					vm_id     = {{.TestVMID}}
+					name      = "base"
+				}`),
+			},
+			{
+				PreConfig: changeDescription,
+				Config:    config("first"),
+				Check: resource.ComposeTestCheckFunc(
+					checkDescription,
+					test.ResourceAttributes("proxmox_vm_snapshot_rollback.test", map[string]string{
+						"id":    fmt.Sprintf("%s/%d/base", te.NodeName, vmID),
+						"start": "false",
+					}),
+				),
+			},
+			{
+				PreConfig: changeDescription,
+				Config:    config("second"),
+				Check:     checkDescription,
+			},
+		},
+	})
+}
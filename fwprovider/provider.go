@@ -10,7 +10,9 @@ import (
 	"context"
 	"fmt"
 	"net"
+	"strconv"
 	"strings"
+	"time"
 
 	"github.com/hashicorp/terraform-plugin-framework-validators/int64validator"
 	"github.com/hashicorp/terraform-plugin-framework-validators/listvalidator"
@@ -33,6 +35,7 @@ import (
 	"github.com/bpg/terraform-provider-proxmox/fwprovider/cluster/metrics"
 	"github.com/bpg/terraform-provider-proxmox/fwprovider/cluster/options"
 	"github.com/bpg/terraform-provider-proxmox/fwprovider/cluster/replication"
+	clusterresources "github.com/bpg/terraform-provider-proxmox/fwprovider/cluster/resources"
 	sdnapplier "github.com/bpg/terraform-provider-proxmox/fwprovider/cluster/sdn/applier"
 	sdncontroller "github.com/bpg/terraform-provider-proxmox/fwprovider/cluster/sdn/controller"
 	sdnfabric "github.com/bpg/terraform-provider-proxmox/fwprovider/cluster/sdn/fabric"
@@ -52,7 +55,11 @@ import (
 	nodefirewall "github.com/bpg/terraform-provider-proxmox/fwprovider/nodes/firewall"
 	nodeHardware "github.com/bpg/terraform-provider-proxmox/fwprovider/nodes/hardware"
 	"github.com/bpg/terraform-provider-proxmox/fwprovider/nodes/network"
+	"github.com/bpg/terraform-provider-proxmox/fwprovider/nodes/subscription"
+	nodetask "github.com/bpg/terraform-provider-proxmox/fwprovider/nodes/task"
 	"github.com/bpg/terraform-provider-proxmox/fwprovider/nodes/vm"
+	"github.com/bpg/terraform-provider-proxmox/fwprovider/nodes/vmdisk"
+	"github.com/bpg/terraform-provider-proxmox/fwprovider/nodes/vmsnapshot"
 	"github.com/bpg/terraform-provider-proxmox/fwprovider/pools"
 	"github.com/bpg/terraform-provider-proxmox/fwprovider/storage"
 	"github.com/bpg/terraform-provider-proxmox/proxmox"
@@ -90,6 +97,10 @@ type proxmoxProviderModel struct {
 	AuthTicket          types.String `tfsdk:"auth_ticket"`
 	CSRFPreventionToken types.String `tfsdk:"csrf_prevention_token"`
 	APIToken            types.String `tfsdk:"api_token"`
+	APIMaxConcurrent    types.Int64  `tfsdk:"api_max_concurrent_requests"`
+	APITaskPollInterval types.Int64  `tfsdk:"api_task_poll_interval_ms"`
+	APIRetryAttempts    types.Int64  `tfsdk:"api_retry_attempts"`
+	APIRetryStatusCodes types.List   `tfsdk:"api_retry_status_codes"`
 	OTP                 types.String `tfsdk:"otp"`
 	Username            types.String `tfsdk:"username"`
 	Password            types.String `tfsdk:"password"`
@@ -106,6 +117,8 @@ type proxmoxProviderModel struct {
 		Socks5Password  types.String `tfsdk:"socks5_password"`
 
 		NodeAddressSource types.String `tfsdk:"node_address_source"`
+		UploadRetries     types.Int64  `tfsdk:"upload_retries"`
+		UploadRetryDelay  types.Int64  `tfsdk:"upload_retry_delay"`
 
 		Nodes []struct {
 			Name    types.String `tfsdk:"name"`
@@ -114,9 +127,13 @@ type proxmoxProviderModel struct {
 		} `tfsdk:"node"`
 	} `tfsdk:"ssh"`
 	TmpDir         types.String `tfsdk:"tmp_dir"`
+	ForceDelete    types.Bool   `tfsdk:"force_delete"`
 	RandomVMIDs    types.Bool   `tfsdk:"random_vm_ids"`
 	RandomVMIDStat types.Int64  `tfsdk:"random_vm_id_start"`
 	RandomVMIDEnd  types.Int64  `tfsdk:"random_vm_id_end"`
+	RebootOnChange types.Bool   `tfsdk:"reboot_on_change"`
+	VMIDConflicts  types.Int64  `tfsdk:"vm_id_conflict_retries"`
+	VMShutdown     types.Int64  `tfsdk:"vm_shutdown_timeout"`
 }
 
 func (p *proxmoxProvider) Metadata(_ context.Context, _ provider.MetadataRequest, resp *provider.MetadataResponse) {
@@ -128,6 +145,35 @@ func (p *proxmoxProvider) Schema(_ context.Context, _ provider.SchemaRequest, re
 	resp.Schema = schema.Schema{
 		// Attributes specified in alphabetical order.
 		Attributes: map[string]schema.Attribute{
+			"api_max_concurrent_requests": schema.Int64Attribute{
+				Description: "The maximum number of concurrent requests against the Proxmox VE API. " +
+					"Set to `0` to disable the limit. Defaults to the value of the " +
+					"`PROXMOX_VE_API_MAX_CONCURRENT_REQUESTS` environment variable, or `8` if not set.",
+				Optional:   true,
+				Validators: []validator.Int64{int64validator.AtLeast(0)},
+			},
+			"api_task_poll_interval_ms": schema.Int64Attribute{
+				Description: "The maximum delay in milliseconds between two status polls of a running task. " +
+					"Defaults to the value of the `PROXMOX_VE_API_TASK_POLL_INTERVAL_MS` environment variable, " +
+					"or `1000` if not set.",
+				Optional:   true,
+				Validators: []validator.Int64{int64validator.AtLeast(1)},
+			},
+			"api_retry_attempts": schema.Int64Attribute{
+				Description: "The number of attempts of an API request that fails with a retryable error. " +
+					"Defaults to the value of the `PROXMOX_VE_API_RETRY_ATTEMPTS` environment variable, or `4` if not set.",
+				Optional:   true,
+				Validators: []validator.Int64{int64validator.AtLeast(1)},
+			},
+			"api_retry_status_codes": schema.ListAttribute{
+				Description: "The HTTP status codes on which idempotent API requests are retried. Defaults to " +
+					"502, 503, 504, 596, and 500 responses caused by an internal error of the API daemon.",
+				ElementType: types.Int64Type,
+				Optional:    true,
+				Validators: []validator.List{
+					listvalidator.ValueInt64sAre(int64validator.Between(400, 599)),
+				},
+			},
 			"api_token": schema.StringAttribute{
 				Description: "The API token for the Proxmox VE API.",
 				Optional:    true,
@@ -150,6 +196,11 @@ func (p *proxmoxProvider) Schema(_ context.Context, _ provider.SchemaRequest, re
 					stringvalidator.LengthAtLeast(1),
 				},
 			},
+			"force_delete": schema.BoolAttribute{
+				Description: "Whether to clear the protection flag of VMs and Containers " +
+					"before deleting them.",
+				Optional: true,
+			},
 			"insecure": schema.BoolAttribute{
 				Description: "Whether to skip the TLS verification step.",
 				Optional:    true,
@@ -184,6 +235,11 @@ func (p *proxmoxProvider) Schema(_ context.Context, _ provider.SchemaRequest, re
 				Optional:    true,
 				Validators:  []validator.Int64{int64validator.Between(100, 999999999)},
 			},
+			"reboot_on_change": schema.BoolAttribute{
+				Description: "Whether to restart a running Container when a change only takes effect " +
+					"after a restart, such as a `features` change.",
+				Optional: true,
+			},
 			"tmp_dir": schema.StringAttribute{
 				Description: "The alternative temporary directory.",
 				Optional:    true,
@@ -192,6 +248,18 @@ func (p *proxmoxProvider) Schema(_ context.Context, _ provider.SchemaRequest, re
 				Description: "The username for the Proxmox VE API.",
 				Optional:    true,
 			},
+			"vm_id_conflict_retries": schema.Int64Attribute{
+				Description: "The number of times to retry creating a VM / Container with a newly " +
+					"generated ID when the generated one is already in use. Defaults to `3`.",
+				Optional:   true,
+				Validators: []validator.Int64{int64validator.AtLeast(0)},
+			},
+			"vm_shutdown_timeout": schema.Int64Attribute{
+				Description: "The default time in seconds to wait for a VM to shut down gracefully before it is " +
+					"forcefully stopped, used by VMs that leave `timeout_shutdown_vm` at its default.",
+				Optional:   true,
+				Validators: []validator.Int64{int64validator.AtLeast(1)},
+			},
 		},
 		Blocks: map[string]schema.Block{
 			// have to define it as a list due to backwards compatibility
@@ -262,6 +330,22 @@ func (p *proxmoxProvider) Schema(_ context.Context, _ provider.SchemaRequest, re
 								stringvalidator.OneOf("api", "dns"),
 							},
 						},
+						"upload_retries": schema.Int64Attribute{
+							Description: "The number of times a failed SSH file upload is retried. " +
+								"Partially uploaded files are removed from the node before retrying. " +
+								"Defaults to the value of the `PROXMOX_VE_SSH_UPLOAD_RETRIES` environment variable, " +
+								"or `3` if not set.",
+							Optional:   true,
+							Validators: []validator.Int64{int64validator.Between(0, 10)},
+						},
+						"upload_retry_delay": schema.Int64Attribute{
+							Description: "The initial delay in seconds between SSH file upload retries, " +
+								"doubled after every failed attempt. " +
+								"Defaults to the value of the `PROXMOX_VE_SSH_UPLOAD_RETRY_DELAY` environment variable, " +
+								"or `5` if not set.",
+							Optional:   true,
+							Validators: []validator.Int64{int64validator.Between(0, 300)},
+						},
 						"username": schema.StringAttribute{
 							Description: "The username used for the SSH connection. " +
 								"Defaults to the value of the `username` field of the " +
@@ -404,11 +488,71 @@ func (p *proxmoxProvider) Configure(
 		)
 	}
 
-	conn, err := api.NewConnection(
-		endpoint,
-		insecure,
-		minTLS,
-	)
+	maxConcurrentRequests := int64(api.DefaultMaxConcurrentRequests)
+
+	if v := utils.GetAnyStringEnv("PROXMOX_VE_API_MAX_CONCURRENT_REQUESTS"); v != "" {
+		i, e := strconv.ParseInt(v, 10, 64)
+		if e != nil {
+			resp.Diagnostics.AddError("Invalid PROXMOX_VE_API_MAX_CONCURRENT_REQUESTS environment variable", e.Error())
+		}
+
+		maxConcurrentRequests = i
+	}
+
+	if !cfg.APIMaxConcurrent.IsNull() {
+		maxConcurrentRequests = cfg.APIMaxConcurrent.ValueInt64()
+	}
+
+	taskPollInterval := api.DefaultTaskPollInterval.Milliseconds()
+
+	if v := utils.GetAnyStringEnv("PROXMOX_VE_API_TASK_POLL_INTERVAL_MS"); v != "" {
+		i, e := strconv.ParseInt(v, 10, 64)
+		if e != nil {
+			resp.Diagnostics.AddError("Invalid PROXMOX_VE_API_TASK_POLL_INTERVAL_MS environment variable", e.Error())
+		}
+
+		taskPollInterval = i
+	}
+
+	if !cfg.APITaskPollInterval.IsNull() {
+		taskPollInterval = cfg.APITaskPollInterval.ValueInt64()
+	}
+
+	retryAttempts := int64(api.DefaultRequestRetries)
+
+	if v := utils.GetAnyStringEnv("PROXMOX_VE_API_RETRY_ATTEMPTS"); v != "" {
+		i, e := strconv.ParseInt(v, 10, 64)
+		if e != nil {
+			resp.Diagnostics.AddError("Invalid PROXMOX_VE_API_RETRY_ATTEMPTS environment variable", e.Error())
+		}
+
+		retryAttempts = i
+	}
+
+	if !cfg.APIRetryAttempts.IsNull() {
+		retryAttempts = cfg.APIRetryAttempts.ValueInt64()
+	}
+
+	connOpts := []api.ConnectionOption{
+		api.WithMaxConcurrentRequests(int(maxConcurrentRequests)),
+		api.WithTaskPollInterval(time.Duration(taskPollInterval) * time.Millisecond),
+		api.WithRequestRetries(uint(retryAttempts), api.DefaultRequestRetryDelay), //nolint:gosec
+	}
+
+	if !cfg.APIRetryStatusCodes.IsNull() && !cfg.APIRetryStatusCodes.IsUnknown() {
+		var codes []int64
+
+		resp.Diagnostics.Append(cfg.APIRetryStatusCodes.ElementsAs(ctx, &codes, false)...)
+
+		retryCodes := make([]int, 0, len(codes))
+		for _, code := range codes {
+			retryCodes = append(retryCodes, int(code))
+		}
+
+		connOpts = append(connOpts, api.WithRequestRetryClassifier(api.RetryOnStatusCodes(retryCodes...)))
+	}
+
+	conn, err := api.NewConnection(endpoint, insecure, minTLS, connOpts...)
 	if err != nil {
 		resp.Diagnostics.AddError(
 			"Unable to create Proxmox VE API connection",
@@ -437,6 +581,27 @@ func (p *proxmoxProvider) Configure(
 	sshSocks5Server := utils.GetAnyStringEnv("PROXMOX_VE_SSH_SOCKS5_SERVER")
 	sshSocks5Username := utils.GetAnyStringEnv("PROXMOX_VE_SSH_SOCKS5_USERNAME")
 	sshSocks5Password := utils.GetAnyStringEnv("PROXMOX_VE_SSH_SOCKS5_PASSWORD")
+	sshUploadRetries := int64(ssh.DefaultUploadRetries)
+	sshUploadRetryDelay := int64(ssh.DefaultUploadRetryDelay.Seconds())
+
+	if v := utils.GetAnyStringEnv("PROXMOX_VE_SSH_UPLOAD_RETRIES"); v != "" {
+		i, e := strconv.ParseInt(v, 10, 64)
+		if e != nil {
+			resp.Diagnostics.AddError("Invalid PROXMOX_VE_SSH_UPLOAD_RETRIES environment variable", e.Error())
+		}
+
+		sshUploadRetries = i
+	}
+
+	if v := utils.GetAnyStringEnv("PROXMOX_VE_SSH_UPLOAD_RETRY_DELAY"); v != "" {
+		i, e := strconv.ParseInt(v, 10, 64)
+		if e != nil {
+			resp.Diagnostics.AddError("Invalid PROXMOX_VE_SSH_UPLOAD_RETRY_DELAY environment variable", e.Error())
+		}
+
+		sshUploadRetryDelay = i
+	}
+
 	nodeOverrides := map[string]ssh.ProxmoxNode{}
 
 	//nolint: nestif
@@ -477,6 +642,14 @@ func (p *proxmoxProvider) Configure(
 			sshSocks5Password = cfg.SSH[0].Socks5Password.ValueString()
 		}
 
+		if !cfg.SSH[0].UploadRetries.IsNull() {
+			sshUploadRetries = cfg.SSH[0].UploadRetries.ValueInt64()
+		}
+
+		if !cfg.SSH[0].UploadRetryDelay.IsNull() {
+			sshUploadRetryDelay = cfg.SSH[0].UploadRetryDelay.ValueInt64()
+		}
+
 		for _, n := range cfg.SSH[0].Nodes {
 			nodePort := int32(n.Port.ValueInt64())
 			if nodePort == 0 {
@@ -522,6 +695,7 @@ func (p *proxmoxProvider) Configure(
 		sshUsername, sshPassword, sshAgent, sshAgentSocket, sshAgentForwarding, sshPrivateKey,
 		sshSocks5Server, sshSocks5Username, sshSocks5Password,
 		nodeResolver,
+		int(sshUploadRetries), time.Duration(sshUploadRetryDelay)*time.Second,
 	)
 	if err != nil {
 		resp.Diagnostics.AddError(
@@ -543,16 +717,24 @@ func (p *proxmoxProvider) Configure(
 
 	client := proxmox.NewClient(apiClient, sshClient, tmpDirOverride)
 
+	conflictRetries := int64(cluster.DefaultIDConflictRetries)
+	if !cfg.VMIDConflicts.IsNull() {
+		conflictRetries = cfg.VMIDConflicts.ValueInt64()
+	}
+
 	resp.ResourceData = config.Resource{
 		Client: client,
 		IDGenerator: cluster.NewIDGenerator(
 			client.Cluster(),
 			cluster.IDGeneratorConfig{
-				RandomIDs:    cfg.RandomVMIDs.ValueBool(),
-				RandomIDStat: int(cfg.RandomVMIDStat.ValueInt64()),
-				RandomIDEnd:  int(cfg.RandomVMIDEnd.ValueInt64()),
+				RandomIDs:       cfg.RandomVMIDs.ValueBool(),
+				RandomIDStat:    int(cfg.RandomVMIDStat.ValueInt64()),
+				RandomIDEnd:     int(cfg.RandomVMIDEnd.ValueInt64()),
+				ConflictRetries: int(conflictRetries),
 			},
 		),
+		ForceDelete:       cfg.ForceDelete.ValueBool(),
+		VMShutdownTimeout: time.Duration(cfg.VMShutdown.ValueInt64()) * time.Second,
 	}
 
 	resp.DataSourceData = config.DataSource{
@@ -564,6 +746,7 @@ func (p *proxmoxProvider) Resources(_ context.Context) []func() resource.Resourc
 	return []func() resource.Resource{
 		access.NewACLResource,
 		access.NewACLShortResource, // proxmox_acl
+		access.NewRealmADResource,  // proxmox_realm_ad
 		access.NewRealmLDAPResource,
 		access.NewRealmLDAPShortResource, // proxmox_realm_ldap
 		access.NewRealmOpenIDResource,
@@ -613,6 +796,7 @@ func (p *proxmoxProvider) Resources(_ context.Context) []func() resource.Resourc
 		nodeconfig.NewNodeConfigResource,
 		nodefirewall.NewNodeFirewallOptionsResource,
 		nodefirewall.NewShortNodeFirewallOptionsResource,
+		subscription.NewSubscriptionResource, // proxmox_node_subscription
 		options.NewClusterOptionsResource,
 		options.NewClusterOptionsShortResource,
 		pools.NewPoolMembershipResource,
@@ -658,6 +842,9 @@ func (p *proxmoxProvider) Resources(_ context.Context) []func() resource.Resourc
 		storage.NewZFSPoolStorageShortResource,
 		vm.NewResource,
 		vm.NewShortResource,
+		vmdisk.NewResource,             // proxmox_vm_disk
+		vmsnapshot.NewResource,         // proxmox_vm_snapshot
+		vmsnapshot.NewRollbackResource, // proxmox_vm_snapshot_rollback
 		replication.NewResource,
 		replication.NewShortResource,
 	}
@@ -679,12 +866,16 @@ func (p *proxmoxProvider) DataSources(_ context.Context) []func() datasource.Dat
 		apt.NewShortRepositoryDataSource,
 		apt.NewStandardRepositoryDataSource,
 		apt.NewShortStandardRepositoryDataSource,
+		apt.NewUpdatesDataSource, // proxmox_apt_updates
 		backup.NewDataSource,
-		cephstatus.NewDataSource, // proxmox_ceph_status
+		cephstatus.NewDataSource,       // proxmox_ceph_status
+		clusterresources.NewDataSource, // proxmox_cluster_resources
 		datastores.NewDataSource,
 		datastores.NewShortDataSource,
 		nodeconfig.NewNodeConfigDataSource,
 		nodeHardware.NewPCIDataSource,
+		network.NewNetworkInterfacesDataSource, // proxmox_node_network_interfaces
+		nodetask.NewDataSource,                 // proxmox_node_task
 		ha.NewHAGroupDataSource,
 		ha.NewHAGroupShortDataSource, // proxmox_hagroup
 		ha.NewHAGroupsDataSource,
@@ -15,6 +15,7 @@ import (
 	"context"
 	"fmt"
 	"path/filepath"
+	"regexp"
 	"testing"
 
 	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
@@ -137,6 +138,8 @@ func TestAccDatasourceFilesNameRegexMatch(t *testing.T) {
 				`),
 				Check: resource.ComposeAggregateTestCheckFunc(
 					resource.TestCheckResourceAttr(datasourceName, "files.#", "1"),
+					resource.TestMatchResourceAttr(datasourceName, "files.0.creation_time",
+						regexp.MustCompile(`^\d{4}-\d{2}-\d{2}T\d{2}:\d{2}:\d{2}Z$`)),
 					resource.TestCheckTypeSetElemNestedAttrs(datasourceName, "files.*", map[string]string{
 						"file_name":    fileName,
 						"content_type": "snippets",
@@ -21,6 +21,7 @@ import (
 	"time"
 
 	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/plancheck"
 	"github.com/hashicorp/terraform-plugin-testing/terraform"
 	"github.com/stretchr/testify/require"
 
@@ -467,6 +468,92 @@ func TestAccResourceContainerDiskResize(t *testing.T) {
 	})
 }
 
+func TestAccResourceContainerMountPointResize(t *testing.T) {
+	te := InitEnvironment(t)
+	imageFileName := fmt.Sprintf("%d-alpine-3.22-default_20250617_amd64.tar.xz", time.Now().UnixMicro())
+	testAccDownloadContainerTemplate(t, te, imageFileName)
+
+	accTestContainerID := 100000 + rand.Intn(99999)
+
+	te.AddTemplateVars(map[string]any{
+		"ImageFileName":   imageFileName,
+		"TestContainerID": accTestContainerID,
+	})
+
+	config := func(size string) string {
+		return te.RenderConfig(fmt.Sprintf(`
+		resource "proxmox_virtual_environment_container" "test_container" {
+			node_name = "{{.NodeName}}"
+			vm_id     = {{.TestContainerID}}
+			started   = false
+			disk {
+				datastore_id = "local-lvm"
+				size         = 4
+			}
+			mount_point {
+				volume = "local-lvm"
+				size   = "%s"
+				path   = "/mnt/data"
+			}
+			initialization {
+				hostname = "test-mp-resize"
+			}
+			network_interface {
+				name = "vmbr0"
+			}
+			operating_system {
+				template_file_id = "local:vztmpl/{{.ImageFileName}}"
+				type             = "alpine"
+			}
+		}`, size), WithRootUser())
+	}
+
+	resource.ParallelTest(t, resource.TestCase{
+		ProtoV6ProviderFactories: te.AccProviders,
+		Steps: []resource.TestStep{
+			{
+				Config: config("4G"),
+				Check: ResourceAttributes(accTestContainerName, map[string]string{
+					"mount_point.0.size": "4G",
+				}),
+			},
+			{
+				// growing a volume mount point should update in-place, not recreate
+				Config: config("6G"),
+				ConfigPlanChecks: resource.ConfigPlanChecks{
+					PreApply: []plancheck.PlanCheck{
+						plancheck.ExpectResourceAction(accTestContainerName, plancheck.ResourceActionUpdate),
+					},
+				},
+				Check: resource.ComposeTestCheckFunc(
+					ResourceAttributes(accTestContainerName, map[string]string{
+						"mount_point.0.size": "6G",
+					}),
+					func(*terraform.State) error {
+						ctInfo, err := te.NodeClient().Container(accTestContainerID).GetContainer(t.Context())
+						require.NoError(te.t, err, "failed to get container")
+						mp0 := ctInfo.MountPoints["mp0"]
+						require.NotNil(te.t, mp0, "mp0 should not be nil")
+						require.NotNil(te.t, mp0.DiskSize, "mp0 size should not be nil")
+						require.Equal(te.t, "6G", *mp0.DiskSize, "mp0 size should be 6G after resize")
+
+						return nil
+					},
+				),
+			},
+			{
+				// shrinking a volume mount point is not supported and recreates the container
+				Config: config("4G"),
+				ConfigPlanChecks: resource.ConfigPlanChecks{
+					PreApply: []plancheck.PlanCheck{
+						plancheck.ExpectResourceAction(accTestContainerName, plancheck.ResourceActionDestroyBeforeCreate),
+					},
+				},
+			},
+		},
+	})
+}
+
 func TestAccResourceContainerClone(t *testing.T) {
 	te := InitEnvironment(t)
 	accTestContainerID := 100000 + rand.Intn(99999)
@@ -554,6 +641,84 @@ func TestAccResourceContainerClone(t *testing.T) {
 	})
 }
 
+// TestAccResourceContainerTemplateConversion verifies that an existing container is converted to a template in
+// place, and that converting it back replaces it.
+func TestAccResourceContainerTemplateConversion(t *testing.T) {
+	te := InitEnvironment(t)
+	accTestContainerID := 100000 + rand.Intn(99999)
+	imageFileName := fmt.Sprintf("%d-alpine-3.22-default_20250617_amd64.tar.xz", time.Now().UnixMicro())
+
+	testAccDownloadContainerTemplate(t, te, imageFileName)
+
+	te.AddTemplateVars(map[string]interface{}{
+		"ImageFileName":   imageFileName,
+		"TestContainerID": accTestContainerID,
+	})
+
+	containerConfig := func(template bool) string {
+		te.AddTemplateVars(map[string]interface{}{"Template": template})
+
+		return te.RenderConfig(`
+			resource "proxmox_virtual_environment_container" "test_container" {
+				node_name = "{{.NodeName}}"
+				vm_id     = {{.TestContainerID}}
+				template  = {{.Template}}
+				disk {
+					datastore_id = "local-lvm"
+					size         = 4
+				}
+				initialization {
+					hostname = "test-template"
+				}
+				operating_system {
+					template_file_id = "local:vztmpl/{{.ImageFileName}}"
+					type             = "alpine"
+				}
+			}`)
+	}
+
+	resource.ParallelTest(t, resource.TestCase{
+		ProtoV6ProviderFactories: te.AccProviders,
+		Steps: []resource.TestStep{
+			{
+				Config: containerConfig(false),
+				Check: ResourceAttributes("proxmox_virtual_environment_container.test_container", map[string]string{
+					"template": "false",
+					"started":  "true",
+				}),
+			},
+			{
+				Config: containerConfig(true),
+				ConfigPlanChecks: resource.ConfigPlanChecks{
+					PreApply: []plancheck.PlanCheck{
+						plancheck.ExpectResourceAction(
+							"proxmox_virtual_environment_container.test_container",
+							plancheck.ResourceActionUpdate,
+						),
+					},
+				},
+				Check: ResourceAttributes("proxmox_virtual_environment_container.test_container", map[string]string{
+					"template": "true",
+				}),
+			},
+			{
+				Config: containerConfig(false),
+				ConfigPlanChecks: resource.ConfigPlanChecks{
+					PreApply: []plancheck.PlanCheck{
+						plancheck.ExpectResourceAction(
+							"proxmox_virtual_environment_container.test_container",
+							plancheck.ResourceActionDestroyBeforeCreate,
+						),
+					},
+				},
+				Check: ResourceAttributes("proxmox_virtual_environment_container.test_container", map[string]string{
+					"template": "false",
+				}),
+			},
+		},
+	})
+}
+
 // TestAccResourceContainerCloneFullFlag tests the `full` flag in the clone block.
 // It verifies that full=true creates a full clone, and that full=false (linked clone)
 // is correctly sent to the API (which rejects it when storage doesn't support COW).
@@ -3107,6 +3272,98 @@ func TestAccResourceContainerStartedToggle(t *testing.T) {
 	})
 }
 
+// TestAccResourceContainerLiveResources verifies that CPU cores, memory and swap of a running
+// container are updated live, without restarting the container.
+func TestAccResourceContainerLiveResources(t *testing.T) {
+	te := InitEnvironment(t)
+	imageFileName := fmt.Sprintf("%d-alpine-3.22-default_20250617_amd64.tar.xz", time.Now().UnixMicro())
+	testAccDownloadContainerTemplate(t, te, imageFileName)
+
+	accTestContainerID := 100000 + rand.Intn(99999)
+
+	te.AddTemplateVars(map[string]interface{}{
+		"ImageFileName":   imageFileName,
+		"TestContainerID": accTestContainerID,
+	})
+
+	containerConfig := func(cores, memory, swap int) string {
+		return te.RenderConfig(fmt.Sprintf(`
+			resource "proxmox_virtual_environment_container" "test_container" {
+				node_name    = "{{.NodeName}}"
+				vm_id        = {{.TestContainerID}}
+				unprivileged = true
+				cpu {
+					cores = %d
+				}
+				memory {
+					dedicated = %d
+					swap      = %d
+				}
+				disk {
+					datastore_id = "local-lvm"
+					size         = 4
+				}
+				initialization {
+					hostname = "test-live-resources"
+					ip_config {
+						ipv4 {
+							address = "dhcp"
+						}
+					}
+				}
+				network_interface {
+					name = "vmbr0"
+				}
+				operating_system {
+					template_file_id = "local:vztmpl/{{.ImageFileName}}"
+					type             = "alpine"
+				}
+			}`, cores, memory, swap), WithRootUser())
+	}
+
+	var uptime int
+
+	expectLive := func(cores int, memory int64) func(*terraform.State) error {
+		return func(*terraform.State) error {
+			st, err := te.NodeClient().Container(accTestContainerID).GetContainerStatus(t.Context())
+			require.NoError(te.t, err, "failed to get container status")
+			require.Equal(te.t, "running", st.Status)
+			require.NotNil(te.t, st.CPUCount)
+			require.InDelta(te.t, float64(cores), *st.CPUCount, 0)
+			require.NotNil(te.t, st.MemoryAllocation)
+			require.Equal(te.t, memory*1024*1024, *st.MemoryAllocation)
+			require.NotNil(te.t, st.Uptime)
+
+			// the uptime keeps growing as long as the container is not restarted
+			require.GreaterOrEqual(te.t, *st.Uptime, uptime, "container was restarted")
+			uptime = *st.Uptime
+
+			return nil
+		}
+	}
+
+	resource.ParallelTest(t, resource.TestCase{
+		ProtoV6ProviderFactories: te.AccProviders,
+		Steps: []resource.TestStep{
+			{
+				Config: containerConfig(2, 512, 0),
+				Check:  expectLive(2, 512),
+			},
+			{
+				Config: containerConfig(4, 768, 256),
+				Check: resource.ComposeTestCheckFunc(
+					ResourceAttributes(accTestContainerName, map[string]string{
+						"cpu.0.cores":        "4",
+						"memory.0.dedicated": "768",
+						"memory.0.swap":      "256",
+					}),
+					expectLive(4, 768),
+				),
+			},
+		},
+	})
+}
+
 // Regression test for #2893: omitting the `disk { ... }` block must not panic create.
 //
 //nolint:paralleltest
@@ -329,6 +329,7 @@ func uploadSnippetFile(t *testing.T, fileName string) {
 				Port:    int32(sshPort),
 			},
 		},
+		0, 0,
 	)
 	require.NoError(t, err)
 
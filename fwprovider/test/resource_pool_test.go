@@ -121,6 +121,74 @@ func TestAccResourcePool(t *testing.T) {
 				},
 			},
 		}},
+		{"manage pool members", []resource.TestStep{
+			{
+				Config: te.RenderConfig(`
+					resource "proxmox_virtual_environment_vm" "test_05" {
+						node_name = "{{.NodeName}}"
+						started   = false
+						name      = "test-pool-members"
+					}
+					resource "proxmox_virtual_environment_pool" "test_05" {
+						pool_id = "test-05"
+						vm_ids  = [proxmox_virtual_environment_vm.test_05.vm_id]
+					}
+				`, WithRootUser()),
+				Check: resource.ComposeTestCheckFunc(
+					ResourceAttributes("proxmox_virtual_environment_pool.test_05", map[string]string{
+						"vm_ids.#":      "1",
+						"storage_ids.#": "0",
+						"members.#":     "1",
+					}),
+					resource.TestCheckResourceAttrPair(
+						"proxmox_virtual_environment_pool.test_05", "vm_ids.0",
+						"proxmox_virtual_environment_vm.test_05", "vm_id",
+					),
+				),
+			},
+			{
+				Config: te.RenderConfig(`
+					resource "proxmox_virtual_environment_vm" "test_05" {
+						node_name = "{{.NodeName}}"
+						started   = false
+						name      = "test-pool-members"
+					}
+					resource "proxmox_virtual_environment_pool" "test_05" {
+						pool_id     = "test-05"
+						vm_ids      = [proxmox_virtual_environment_vm.test_05.vm_id]
+						storage_ids = ["{{.DatastoreID}}"]
+					}
+				`, WithRootUser()),
+				ConfigPlanChecks: resource.ConfigPlanChecks{
+					PreApply: []plancheck.PlanCheck{
+						plancheck.ExpectResourceAction("proxmox_virtual_environment_pool.test_05", plancheck.ResourceActionUpdate),
+					},
+				},
+				Check: ResourceAttributes("proxmox_virtual_environment_pool.test_05", map[string]string{
+					"vm_ids.#":      "1",
+					"storage_ids.#": "1",
+					"storage_ids.0": te.DatastoreID,
+					"members.#":     "2",
+				}),
+			},
+			{
+				Config: te.RenderConfig(`
+					resource "proxmox_virtual_environment_vm" "test_05" {
+						node_name = "{{.NodeName}}"
+						started   = false
+						name      = "test-pool-members"
+					}
+					resource "proxmox_virtual_environment_pool" "test_05" {
+						pool_id = "test-05"
+					}
+				`, WithRootUser()),
+				Check: ResourceAttributes("proxmox_virtual_environment_pool.test_05", map[string]string{
+					"vm_ids.#":      "0",
+					"storage_ids.#": "0",
+					"members.#":     "0",
+				}),
+			},
+		}},
 	}
 
 	for _, tt := range tests {
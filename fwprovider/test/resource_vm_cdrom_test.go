@@ -12,6 +12,7 @@
 package test
 
 import (
+	"regexp"
 	"testing"
 
 	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
@@ -94,6 +95,66 @@ func TestAccResourceVMCDROM(t *testing.T) {
 				RefreshState: true,
 			},
 		}},
+		{"multiple cdroms", []resource.TestStep{
+			{
+				Config: te.RenderConfig(`
+				resource "proxmox_virtual_environment_vm" "test_cdrom" {
+					node_name = "{{.NodeName}}"
+					started   = false
+					name 	  = "test-cdrom"
+					cdrom {
+						file_id   = "none"
+						interface = "ide2"
+					}
+					cdrom {
+						file_id   = "none"
+						interface = "sata1"
+					}
+				}`),
+				Check: ResourceAttributes("proxmox_virtual_environment_vm.test_cdrom", map[string]string{
+					"cdrom.#":           "2",
+					"cdrom.0.interface": "ide2",
+					"cdrom.1.interface": "sata1",
+				}),
+			},
+			{
+				RefreshState: true,
+			},
+			{
+				Config: te.RenderConfig(`
+				resource "proxmox_virtual_environment_vm" "test_cdrom" {
+					node_name = "{{.NodeName}}"
+					started   = false
+					name 	  = "test-cdrom"
+					cdrom {
+						file_id   = "cdrom"
+						interface = "ide2"
+					}
+				}`),
+				Check: ResourceAttributes("proxmox_virtual_environment_vm.test_cdrom", map[string]string{
+					"cdrom.#":         "1",
+					"cdrom.0.file_id": "cdrom",
+				}),
+			},
+		}},
+		{"cdrom interface used by a disk", []resource.TestStep{{
+			Config: te.RenderConfig(`
+				resource "proxmox_virtual_environment_vm" "test_cdrom" {
+					node_name = "{{.NodeName}}"
+					started   = false
+					name 	  = "test-cdrom"
+					disk {
+						datastore_id = "local-lvm"
+						interface    = "sata0"
+						size         = 8
+					}
+					cdrom {
+						file_id   = "none"
+						interface = "sata0"
+					}
+				}`),
+			ExpectError: regexp.MustCompile(`is already used by a disk block`),
+		}}},
 		{"enable cdrom", []resource.TestStep{
 			{
 				Config: te.RenderConfig(`
@@ -604,7 +604,7 @@ func TestAccResourceVMDisks(t *testing.T) {
 						size         = 8
 					}
 				}`),
-				ExpectError: regexp.MustCompile(`cannot delete boot disk "scsi0"`),
+				ExpectError: regexp.MustCompile(`boot_order references device "scsi0", which is not configured`),
 			},
 		}, nil},
 		{"non-boot disk deletion works", []resource.TestStep{
@@ -665,6 +665,47 @@ func TestAccResourceVMDisks(t *testing.T) {
 				RefreshState: true,
 			},
 		}, nil},
+		{"clear boot order", []resource.TestStep{
+			{
+				Config: te.RenderConfig(`
+				resource "proxmox_virtual_environment_vm" "test_boot_order_clear" {
+					node_name = "{{.NodeName}}"
+					started   = false
+					name 	  = "test-boot-order-clear"
+
+					boot_order = ["scsi0"]
+
+					disk {
+						datastore_id = "local-lvm"
+						interface    = "scsi0"
+						size         = 8
+					}
+				}`),
+				Check: ResourceAttributes("proxmox_virtual_environment_vm.test_boot_order_clear", map[string]string{
+					"boot_order.#": "1",
+					"boot_order.0": "scsi0",
+				}),
+			},
+			{
+				Config: te.RenderConfig(`
+				resource "proxmox_virtual_environment_vm" "test_boot_order_clear" {
+					node_name = "{{.NodeName}}"
+					started   = false
+					name 	  = "test-boot-order-clear"
+
+					boot_order = []
+
+					disk {
+						datastore_id = "local-lvm"
+						interface    = "scsi0"
+						size         = 8
+					}
+				}`),
+				Check: ResourceAttributes("proxmox_virtual_environment_vm.test_boot_order_clear", map[string]string{
+					"boot_order.#": "0",
+				}),
+			},
+		}, nil},
 		{"disk resize with cdrom in boot order", []resource.TestStep{
 			{
 				Config: te.RenderConfig(`
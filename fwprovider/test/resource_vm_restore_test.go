@@ -0,0 +1,137 @@
+//go:build acceptance || all
+
+//testacc:tier=heavy
+//testacc:resource=vm
+
+/*
+ * This Source Code Form is subject to the terms of the Mozilla Public
+ * License, v. 2.0. If a copy of the MPL was not distributed with this
+ * file, You can obtain one at https://mozilla.org/MPL/2.0/.
+ */
+
+package test
+
+import (
+	"context"
+	"math/rand"
+	"net/http"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/plancheck"
+	"github.com/stretchr/testify/require"
+
+	"github.com/bpg/terraform-provider-proxmox/proxmox/nodes/storage"
+)
+
+// vzdumpRequestBody contains the parameters of a backup request.
+type vzdumpRequestBody struct {
+	VMID    int    `url:"vmid"`
+	Storage string `url:"storage"`
+	Mode    string `url:"mode"`
+}
+
+// TestAccResourceVMRestore verifies that a VM can be created from a backup, and that changes to the
+// restore block after creation are ignored.
+func TestAccResourceVMRestore(t *testing.T) {
+	te := InitEnvironment(t)
+
+	sourceVMID := 100000 + rand.Intn(99999)
+	te.AddTemplateVars(map[string]any{"SourceVMID": sourceVMID})
+
+	backupStorage := &storage.Client{Client: te.NodeClient(), StorageName: "local"}
+
+	t.Cleanup(func() {
+		ctx := context.Background()
+		contentType := "backup"
+
+		files, err := backupStorage.ListDatastoreFiles(ctx, &contentType)
+		if err != nil {
+			return
+		}
+
+		for _, f := range files {
+			if f.VMID != nil && *f.VMID == sourceVMID {
+				_ = backupStorage.DeleteDatastoreFile(ctx, f.VolumeID)
+			}
+		}
+	})
+
+	sourceConfig := `
+		resource "proxmox_virtual_environment_vm" "source" {
+			node_name = "{{.NodeName}}"
+			vm_id     = {{.SourceVMID}}
+			name      = "test-restore-source"
+			started   = false
+		}`
+
+	restoredConfig := func(unique bool) string {
+		te.AddTemplateVars(map[string]any{"Unique": unique})
+
+		return te.RenderConfig(sourceConfig + `
+			data "proxmox_files" "backups" {
+				node_name    = "{{.NodeName}}"
+				datastore_id = "local"
+				content_type = "backup"
+			}
+
+			locals {
+				backups = [for f in data.proxmox_files.backups.files : f if f.vmid == {{.SourceVMID}}]
+			}
+
+			resource "proxmox_virtual_environment_vm" "restored" {
+				node_name = "{{.NodeName}}"
+				name      = "test-restore-target"
+				started   = false
+
+				restore {
+					backup_file = local.backups[0].id
+					unique      = {{.Unique}}
+				}
+			}`)
+	}
+
+	resource.Test(t, resource.TestCase{
+		ProtoV6ProviderFactories: te.AccProviders,
+		Steps: []resource.TestStep{
+			{
+				Config: te.RenderConfig(sourceConfig),
+			},
+			{
+				PreConfig: func() {
+					ctx := context.Background()
+
+					var resBody struct {
+						Data *string `json:"data,omitempty"`
+					}
+
+					err := te.NodeClient().DoRequest(ctx, http.MethodPost, te.NodeClient().ExpandPath("vzdump"),
+						&vzdumpRequestBody{VMID: sourceVMID, Storage: "local", Mode: "stop"}, &resBody)
+					require.NoError(t, err, "failed to back up VM %d", sourceVMID)
+					require.NotNil(t, resBody.Data)
+
+					require.NoError(t, te.NodeClient().Tasks().WaitForTask(ctx, *resBody.Data).Err())
+				},
+				Config: restoredConfig(true),
+				Check: resource.ComposeTestCheckFunc(
+					ResourceAttributes("proxmox_virtual_environment_vm.restored", map[string]string{
+						"name":             "test-restore-target",
+						"restore.0.unique": "true",
+					}),
+					ResourceAttributesSet("proxmox_virtual_environment_vm.restored", []string{
+						"vm_id",
+						"restore.0.backup_file",
+					}),
+				),
+			},
+			{
+				Config: restoredConfig(false),
+				ConfigPlanChecks: resource.ConfigPlanChecks{
+					PreApply: []plancheck.PlanCheck{
+						plancheck.ExpectResourceAction("proxmox_virtual_environment_vm.restored", plancheck.ResourceActionNoop),
+					},
+				},
+			},
+		},
+	})
+}
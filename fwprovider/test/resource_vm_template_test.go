@@ -16,6 +16,7 @@ import (
 	"testing"
 
 	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/plancheck"
 )
 
 func TestAccResourceVMTemplateConversion(t *testing.T) {
@@ -25,6 +26,32 @@ func TestAccResourceVMTemplateConversion(t *testing.T) {
 	imageFileID := te.DownloadCloudImage()
 	te.AddTemplateVars(map[string]any{"ImageFileID": imageFileID})
 
+	templateConfig := func(template bool, size int) string {
+		te.AddTemplateVars(map[string]any{"Template": template, "DiskSize": size})
+
+		return te.RenderConfig(`
+			resource "proxmox_virtual_environment_vm" "template_vm" {
+				node_name = "{{.NodeName}}"
+				started   = false
+				template  = {{.Template}}
+
+				disk {
+					datastore_id = "local-lvm"
+					file_id      = "{{.ImageFileID}}"
+					interface    = "virtio0"
+					size         = {{.DiskSize}}
+				}
+
+				cpu {
+					cores = 2
+				}
+
+				memory {
+					dedicated = 2048
+				}
+			}`)
+	}
+
 	tests := []struct {
 		name string
 		step []resource.TestStep
@@ -146,29 +173,9 @@ func TestAccResourceVMTemplateConversion(t *testing.T) {
 				}),
 			),
 		}}},
-		{"cannot convert template back to regular VM", []resource.TestStep{
+		{"converting template back to regular VM replaces it", []resource.TestStep{
 			{
-				Config: te.RenderConfig(`
-				resource "proxmox_virtual_environment_vm" "template_vm" {
-					node_name = "{{.NodeName}}"
-					started   = false
-					template  = true
-
-					disk {
-						datastore_id = "local-lvm"
-						file_id      = "{{.ImageFileID}}"
-						interface    = "virtio0"
-						size         = 20
-					}
-
-					cpu {
-						cores = 2
-					}
-
-					memory {
-						dedicated = 2048
-					}
-				}`),
+				Config: templateConfig(true, 20),
 				Check: resource.ComposeTestCheckFunc(
 					ResourceAttributes("proxmox_virtual_environment_vm.template_vm", map[string]string{
 						"template": "true",
@@ -176,28 +183,24 @@ func TestAccResourceVMTemplateConversion(t *testing.T) {
 				),
 			},
 			{
-				Config: te.RenderConfig(`
-				resource "proxmox_virtual_environment_vm" "template_vm" {
-					node_name = "{{.NodeName}}"
-					started   = false
-					template  = false
-
-					disk {
-						datastore_id = "local-lvm"
-						file_id      = "{{.ImageFileID}}"
-						interface    = "virtio0"
-						size         = 20
-					}
-
-					cpu {
-						cores = 2
-					}
-
-					memory {
-						dedicated = 2048
-					}
-				}`),
-				ExpectError: regexp.MustCompile(`Cannot convert a template back to a regular VM`),
+				Config:      templateConfig(true, 30),
+				ExpectError: regexp.MustCompile(`of a template can't be resized`),
+			},
+			{
+				Config: templateConfig(false, 20),
+				ConfigPlanChecks: resource.ConfigPlanChecks{
+					PreApply: []plancheck.PlanCheck{
+						plancheck.ExpectResourceAction(
+							"proxmox_virtual_environment_vm.template_vm",
+							plancheck.ResourceActionDestroyBeforeCreate,
+						),
+					},
+				},
+				Check: resource.ComposeTestCheckFunc(
+					ResourceAttributes("proxmox_virtual_environment_vm.template_vm", map[string]string{
+						"template": "false",
+					}),
+				),
 			},
 		}},
 	}
@@ -242,6 +242,169 @@ func TestAccResourceVM(t *testing.T) {
 				),
 			},
 		}},
+		{"update memory ballooning", []resource.TestStep{
+			{
+				Config: te.RenderConfig(`
+				resource "proxmox_virtual_environment_vm" "test_vm_balloon" {
+					node_name = "{{.NodeName}}"
+					started   = false
+
+					memory {
+						dedicated = 2048
+						floating  = 4096
+					}
+				}`),
+				PlanOnly:    true,
+				ExpectError: regexp.MustCompile(`must not be greater than memory.0.dedicated`),
+			}, {
+				Config: te.RenderConfig(`
+				resource "proxmox_virtual_environment_vm" "test_vm_balloon" {
+					node_name = "{{.NodeName}}"
+					started   = false
+
+					memory {
+						dedicated = 2048
+						floating  = 1024
+						shares    = 2000
+					}
+				}`),
+				Check: ResourceAttributes("proxmox_virtual_environment_vm.test_vm_balloon", map[string]string{
+					"memory.0.floating": "1024",
+					"memory.0.shares":   "2000",
+				}),
+			}, {
+				Config: te.RenderConfig(`
+				resource "proxmox_virtual_environment_vm" "test_vm_balloon" {
+					node_name = "{{.NodeName}}"
+					started   = false
+
+					memory {
+						dedicated = 2048
+						floating  = 512
+					}
+				}`),
+				Check: ResourceAttributes("proxmox_virtual_environment_vm.test_vm_balloon", map[string]string{
+					"memory.0.floating": "512",
+					"memory.0.shares":   "1000",
+				}),
+			},
+		}},
+		{"set vm flags", []resource.TestStep{
+			{
+				Config: te.RenderConfig(`
+				resource "proxmox_virtual_environment_vm" "test_vm_flags" {
+					node_name = "{{.NodeName}}"
+					started   = false
+				}`),
+				Check: ResourceAttributes("proxmox_virtual_environment_vm.test_vm_flags", map[string]string{
+					"allow_reboot": "true",
+					"freeze":       "false",
+					"kvm":          "true",
+					"local_time":   "false",
+				}),
+			}, {
+				Config: te.RenderConfig(`
+				resource "proxmox_virtual_environment_vm" "test_vm_flags" {
+					node_name    = "{{.NodeName}}"
+					started      = false
+					allow_reboot = false
+					freeze       = true
+					kvm          = false
+					local_time   = true
+				}`),
+				Check: ResourceAttributes("proxmox_virtual_environment_vm.test_vm_flags", map[string]string{
+					"allow_reboot": "false",
+					"freeze":       "true",
+					"kvm":          "false",
+					"local_time":   "true",
+				}),
+			}, {
+				RefreshState: true,
+			},
+		}},
+		{"set and clear cpu flags", []resource.TestStep{
+			{
+				Config: te.RenderConfig(`
+				resource "proxmox_virtual_environment_vm" "test_vm_cpu_flags" {
+					node_name = "{{.NodeName}}"
+					started   = false
+					cpu {
+						flags = ["+aes", "-pcid", "+md-clear"]
+					}
+				}`),
+				Check: ResourceAttributes("proxmox_virtual_environment_vm.test_vm_cpu_flags", map[string]string{
+					"cpu.0.flags.#": "3",
+					"cpu.0.flags.0": "+aes",
+					"cpu.0.flags.1": "-pcid",
+					"cpu.0.flags.2": "+md-clear",
+				}),
+			}, {
+				RefreshState: true,
+			}, {
+				Config: te.RenderConfig(`
+				resource "proxmox_virtual_environment_vm" "test_vm_cpu_flags" {
+					node_name = "{{.NodeName}}"
+					started   = false
+					cpu {
+						flags = []
+					}
+				}`),
+				Check: ResourceAttributes("proxmox_virtual_environment_vm.test_vm_cpu_flags", map[string]string{
+					"cpu.0.flags.#": "0",
+				}),
+			},
+		}},
+		{"set, update and remove spice enhancements", []resource.TestStep{
+			{
+				Config: te.RenderConfig(`
+				resource "proxmox_virtual_environment_vm" "test_vm_spice" {
+					node_name = "{{.NodeName}}"
+					started   = false
+					vga {
+						type = "qxl"
+					}
+					spice_enhancements {
+						folder_sharing  = true
+						video_streaming = "all"
+					}
+				}`),
+				Check: ResourceAttributes("proxmox_virtual_environment_vm.test_vm_spice", map[string]string{
+					"spice_enhancements.#":                 "1",
+					"spice_enhancements.0.folder_sharing":  "true",
+					"spice_enhancements.0.video_streaming": "all",
+				}),
+			}, {
+				RefreshState: true,
+			}, {
+				Config: te.RenderConfig(`
+				resource "proxmox_virtual_environment_vm" "test_vm_spice" {
+					node_name = "{{.NodeName}}"
+					started   = false
+					vga {
+						type = "qxl"
+					}
+					spice_enhancements {
+						video_streaming = "filter"
+					}
+				}`),
+				Check: ResourceAttributes("proxmox_virtual_environment_vm.test_vm_spice", map[string]string{
+					"spice_enhancements.0.folder_sharing":  "false",
+					"spice_enhancements.0.video_streaming": "filter",
+				}),
+			}, {
+				Config: te.RenderConfig(`
+				resource "proxmox_virtual_environment_vm" "test_vm_spice" {
+					node_name = "{{.NodeName}}"
+					started   = false
+					vga {
+						type = "qxl"
+					}
+				}`),
+				Check: ResourceAttributes("proxmox_virtual_environment_vm.test_vm_spice", map[string]string{
+					"spice_enhancements.#": "0",
+				}),
+			},
+		}},
 		{"create vga block", []resource.TestStep{
 			{
 				Config: te.RenderConfig(`
@@ -851,6 +1014,156 @@ func TestAccResourceVMImportEffectiveDefaults(t *testing.T) {
 	})
 }
 
+// TestAccResourceVMImportNoDrift verifies that a VM created outside of Terraform, with a CD-ROM on a
+// non-default interface and an audio device, can be imported and adopted by a matching config without
+// any planned changes.
+func TestAccResourceVMImportNoDrift(t *testing.T) {
+	te := InitEnvironment(t)
+
+	vmID := 100000 + rand.Intn(99999)
+
+	ctx := context.Background()
+	body := &vms.CreateRequestBody{
+		VMID: vmID,
+		AudioDevices: vms.CustomAudioDevices{
+			{Device: "intel-hda", Driver: new("spice"), Enabled: true},
+		},
+	}
+	body.AddCustomStorageDevice("ide2", vms.CustomStorageDevice{FileVolume: "none", Media: new("cdrom")})
+
+	createResult := te.NodeClient().VM(0).CreateVM(ctx, body)
+	require.NoError(t, createResult.Err(), "failed to create bare VM %d", vmID)
+
+	t.Cleanup(func() {
+		_ = te.NodeClient().VM(vmID).DeleteVM(context.Background(), true, true).Err()
+	})
+
+	te.AddTemplateVars(map[string]any{"TestVMID": vmID})
+
+	config := te.RenderConfig(`
+		resource "proxmox_virtual_environment_vm" "vm_import" {
+			node_name = "{{.NodeName}}"
+			vm_id     = {{.TestVMID}}
+			started   = false
+
+			audio_device {
+				device = "intel-hda"
+				driver = "spice"
+			}
+
+			cdrom {
+				interface = "ide2"
+				file_id   = "none"
+			}
+		}`)
+
+	resource.Test(t, resource.TestCase{
+		ProtoV6ProviderFactories: te.AccProviders,
+		Steps: []resource.TestStep{
+			{
+				Config:             config,
+				ResourceName:       "proxmox_virtual_environment_vm.vm_import",
+				ImportState:        true,
+				ImportStateId:      fmt.Sprintf("%s/%d", te.NodeName, vmID),
+				ImportStatePersist: true,
+				ImportStateCheck: func(states []*terraform.InstanceState) error {
+					if len(states) != 1 {
+						return fmt.Errorf("expected 1 imported state, got %d", len(states))
+					}
+
+					attrs := states[0].Attributes
+
+					for k, want := range map[string]string{
+						"cdrom.0.interface":     "ide2",
+						"cdrom.0.file_id":       "none",
+						"audio_device.0.device": "intel-hda",
+						"audio_device.0.driver": "spice",
+					} {
+						if got := attrs[k]; got != want {
+							return fmt.Errorf("%s = %q, want %q", k, got, want)
+						}
+					}
+
+					return nil
+				},
+			},
+			{
+				Config:   config,
+				PlanOnly: true,
+			},
+		},
+	})
+}
+
+// TestAccResourceVMImportOnBoot verifies that on_boot is read back from an imported VM that starts on
+// node boot, and that a config without on_boot keeps the value instead of planning a change.
+func TestAccResourceVMImportOnBoot(t *testing.T) {
+	te := InitEnvironment(t)
+
+	vmID := 100000 + rand.Intn(99999)
+
+	ctx := context.Background()
+	createResult := te.NodeClient().VM(0).CreateVM(ctx, &vms.CreateRequestBody{
+		VMID:        vmID,
+		StartOnBoot: types.CustomBool(true).Pointer(),
+	})
+	require.NoError(t, createResult.Err(), "failed to create bare VM %d", vmID)
+
+	t.Cleanup(func() {
+		_ = te.NodeClient().VM(vmID).DeleteVM(context.Background(), true, true).Err()
+	})
+
+	te.AddTemplateVars(map[string]any{"TestVMID": vmID})
+
+	config := te.RenderConfig(`
+		resource "proxmox_virtual_environment_vm" "vm_import" {
+			node_name = "{{.NodeName}}"
+			vm_id     = {{.TestVMID}}
+			started   = false
+		}`)
+
+	resource.Test(t, resource.TestCase{
+		ProtoV6ProviderFactories: te.AccProviders,
+		Steps: []resource.TestStep{
+			{
+				Config:             config,
+				ResourceName:       "proxmox_virtual_environment_vm.vm_import",
+				ImportState:        true,
+				ImportStateId:      fmt.Sprintf("%s/%d", te.NodeName, vmID),
+				ImportStatePersist: true,
+				ImportStateCheck: func(states []*terraform.InstanceState) error {
+					if len(states) != 1 {
+						return fmt.Errorf("expected 1 imported state, got %d", len(states))
+					}
+
+					if got := states[0].Attributes["on_boot"]; got != "true" {
+						return fmt.Errorf("on_boot = %q, want %q", got, "true")
+					}
+
+					return nil
+				},
+			},
+			{
+				Config:   config,
+				PlanOnly: true,
+			},
+			{
+				Config: te.RenderConfig(`
+					resource "proxmox_virtual_environment_vm" "vm_import" {
+						node_name = "{{.NodeName}}"
+						vm_id     = {{.TestVMID}}
+						started   = false
+						on_boot   = false
+					}`),
+				Check: ResourceAttributes("proxmox_virtual_environment_vm.vm_import", map[string]string{
+					"on_boot": "false",
+					"started": "false",
+				}),
+			},
+		},
+	})
+}
+
 func TestAccResourceVMInitialization(t *testing.T) {
 	te := InitEnvironment(t)
 	imageFileID := te.DownloadCloudImage()
@@ -903,6 +1216,56 @@ func TestAccResourceVMInitialization(t *testing.T) {
 				"initialization.0.file_format":  "qcow2",
 			}),
 		}}},
+		{"cloud-init drive on a custom interface and removal", []resource.TestStep{{
+			Config: te.RenderConfig(`
+				resource "proxmox_virtual_environment_vm" "test_vm_cloudinit" {
+					node_name = "{{.NodeName}}"
+					started = false
+
+					cdrom {
+						interface = "ide2"
+						file_id   = "none"
+					}
+
+					initialization {
+						interface = "ide0"
+					}
+				}`),
+			Check: ResourceAttributes("proxmox_virtual_environment_vm.test_vm_cloudinit", map[string]string{
+				"cdrom.0.interface":          "ide2",
+				"initialization.0.interface": "ide0",
+			}),
+		}, {
+			Config: te.RenderConfig(`
+				resource "proxmox_virtual_environment_vm" "test_vm_cloudinit" {
+					node_name = "{{.NodeName}}"
+					started = false
+
+					cdrom {
+						interface = "ide2"
+						file_id   = "none"
+					}
+
+					initialization {
+						interface = "ide2"
+					}
+				}`),
+			ExpectError: regexp.MustCompile(`initialization.0.interface "ide2" is already used by the cdrom drive`),
+		}, {
+			Config: te.RenderConfig(`
+				resource "proxmox_virtual_environment_vm" "test_vm_cloudinit" {
+					node_name = "{{.NodeName}}"
+					started = false
+
+					cdrom {
+						interface = "ide2"
+						file_id   = "none"
+					}
+				}`),
+			Check: ResourceAttributes("proxmox_virtual_environment_vm.test_vm_cloudinit", map[string]string{
+				"initialization.#": "0",
+			}),
+		}}},
 		{"custom cloud-init: use SCSI interface", []resource.TestStep{{
 			Config: te.RenderConfig(`
 				resource "proxmox_virtual_environment_file" "cloud_config" {
@@ -332,6 +332,7 @@ func (e *Environment) ExecuteNodeCommands(commands []string) string {
 		"",
 		"", "", "",
 		staticNodeResolver{node: ssh.ProxmoxNode{Address: address, Port: port}},
+		0, 0,
 	)
 	require.NoError(e.t, err)
 
@@ -46,15 +46,19 @@ import (
 //go:generate cp ./build/docs-gen/data-sources/apt_repository.md ./docs/data-sources/
 //go:generate cp ./build/docs-gen/data-sources/virtual_environment_apt_standard_repository.md ./docs/data-sources/
 //go:generate cp ./build/docs-gen/data-sources/apt_standard_repository.md ./docs/data-sources/
+//go:generate cp ./build/docs-gen/data-sources/apt_updates.md ./docs/data-sources/
 //go:generate cp ./build/docs-gen/data-sources/virtual_environment_datastores.md ./docs/data-sources/
 //go:generate cp ./build/docs-gen/data-sources/datastores.md ./docs/data-sources/
 //go:generate cp ./build/docs-gen/data-sources/backup_jobs.md ./docs/data-sources/
 //go:generate cp ./build/docs-gen/data-sources/ceph_status.md ./docs/data-sources/
+//go:generate cp ./build/docs-gen/data-sources/cluster_resources.md ./docs/data-sources/
 //go:generate cp ./build/docs-gen/data-sources/virtual_environment_file.md ./docs/data-sources/
 //go:generate cp ./build/docs-gen/data-sources/file.md ./docs/data-sources/
 //go:generate cp ./build/docs-gen/data-sources/files.md ./docs/data-sources/
 //go:generate cp ./build/docs-gen/data-sources/node_config.md ./docs/data-sources/
 //go:generate cp ./build/docs-gen/data-sources/hardware_pci.md ./docs/data-sources/
+//go:generate cp ./build/docs-gen/data-sources/node_network_interfaces.md ./docs/data-sources/
+//go:generate cp ./build/docs-gen/data-sources/node_task.md ./docs/data-sources/
 //go:generate cp ./build/docs-gen/data-sources/hagroup.md ./docs/data-sources/
 //go:generate cp ./build/docs-gen/data-sources/hagroups.md ./docs/data-sources/
 //go:generate cp ./build/docs-gen/data-sources/haresource.md ./docs/data-sources/
@@ -112,6 +116,7 @@ import (
 //go:generate cp ./build/docs-gen/data-sources/version.md ./docs/data-sources/
 //go:generate cp ./build/docs-gen/data-sources/vm.md ./docs/data-sources/
 //go:generate cp ./build/docs-gen/resources/backup_job.md ./docs/resources/
+//go:generate cp ./build/docs-gen/resources/realm_ad.md ./docs/resources/
 //go:generate cp ./build/docs-gen/resources/realm_ldap.md ./docs/resources/
 //go:generate cp ./build/docs-gen/resources/realm_openid.md ./docs/resources/
 //go:generate cp ./build/docs-gen/resources/realm_sync.md ./docs/resources/
@@ -157,6 +162,7 @@ import (
 //go:generate cp ./build/docs-gen/resources/node_config.md ./docs/resources/
 //go:generate cp ./build/docs-gen/resources/virtual_environment_node_firewall.md ./docs/resources/
 //go:generate cp ./build/docs-gen/resources/node_firewall.md ./docs/resources/
+//go:generate cp ./build/docs-gen/resources/node_subscription.md ./docs/resources/
 //go:generate cp ./build/docs-gen/resources/virtual_environment_oci_image.md ./docs/resources/
 //go:generate cp ./build/docs-gen/resources/oci_image.md ./docs/resources/
 //go:generate cp ./build/docs-gen/resources/virtual_environment_pool_membership.md ./docs/resources/
@@ -208,6 +214,9 @@ import (
 //go:generate cp ./build/docs-gen/resources/virtual_environment_vm2.md ./docs/resources/
 //go:generate cp ./build/docs-gen/resources/pool_membership.md ./docs/resources/
 //go:generate cp ./build/docs-gen/resources/vm.md ./docs/resources/
+//go:generate cp ./build/docs-gen/resources/vm_disk.md ./docs/resources/
+//go:generate cp ./build/docs-gen/resources/vm_snapshot.md ./docs/resources/
+//go:generate cp ./build/docs-gen/resources/vm_snapshot_rollback.md ./docs/resources/
 
 // these will be set by the goreleaser configuration
 // to appropriate values for the compiled binary.
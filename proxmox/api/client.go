@@ -20,6 +20,7 @@ import (
 	"slices"
 	"sort"
 	"strings"
+	"time"
 
 	"github.com/avast/retry-go/v5"
 	"github.com/google/go-querystring/query"
@@ -57,16 +58,33 @@ type Client interface {
 
 	// HTTP returns a lower-level HTTP client.
 	HTTP() *http.Client
+
+	// TaskPollInterval returns the maximum delay between two status polls of a running task.
+	TaskPollInterval() time.Duration
 }
 
 // Connection represents a connection to the Proxmox Virtual Environment API.
 type Connection struct {
 	endpoint   string
 	httpClient *http.Client
+	options    connectionOptions
+}
+
+// ConnectionOption customizes a Connection.
+type ConnectionOption func(*connectionOptions)
+
+type connectionOptions struct {
+	maxConcurrentRequests int
+
+	retryAttempts uint
+	retryDelay    time.Duration
+	retryIf       RequestRetryClassifier
+
+	taskPollInterval time.Duration
 }
 
 // NewConnection creates and initializes a Connection instance.
-func NewConnection(endpoint string, insecure bool, minTLS string) (*Connection, error) {
+func NewConnection(endpoint string, insecure bool, minTLS string, opts ...ConnectionOption) (*Connection, error) {
 	u, err := url.ParseRequestURI(endpoint)
 	if err != nil {
 		return nil, errors.New(
@@ -100,12 +118,26 @@ func NewConnection(endpoint string, insecure bool, minTLS string) (*Connection,
 
 	// make sure the path does not contain "/api2/json"
 	u.Path = ""
+	endpoint = strings.TrimRight(u.String(), "/")
+
+	options := connectionOptions{}
+	for _, opt := range opts {
+		opt(&options)
+	}
+
+	if options.maxConcurrentRequests > 0 {
+		transport = &limitedTransport{
+			inner: transport,
+			sem:   requestLimiter(endpoint, options.maxConcurrentRequests),
+		}
+	}
 
 	return &Connection{
-		endpoint: strings.TrimRight(u.String(), "/"),
+		endpoint: endpoint,
 		httpClient: &http.Client{
 			Transport: transport,
 		},
+		options: options,
 	}, nil
 }
 
@@ -233,25 +265,61 @@ func (c *client) DoRequest(
 		)
 	}
 
+	attempt := 0
+
 	//nolint:bodyclose
 	res, err := retry.NewWithData[*http.Response](
 		retry.Context(ctx),
 		retry.RetryIf(func(err error) bool {
-			var urlErr *url.Error
-			if errors.As(err, &urlErr) {
-				return strings.ToUpper(urlErr.Op) == http.MethodGet
-			}
-
-			return false
+			return c.conn.shouldRetry(req, err)
+		}),
+		retry.OnRetry(func(n uint, err error) {
+			tflog.Warn(ctx, "retrying HTTP request", map[string]any{
+				"method":  method,
+				"path":    modifiedPath,
+				"attempt": n + 1,
+				"error":   err.Error(),
+			})
 		}),
 		retry.LastErrorOnly(true),
-		retry.Attempts(3),
+		retry.Attempts(c.conn.retryAttempts()),
+		retry.Delay(c.conn.retryDelay()),
+		retry.MaxJitter(c.conn.retryDelay()),
+		retry.DelayType(retry.CombineDelay(retry.BackOffDelay, retry.RandomDelay)),
 	).Do(
 		func() (*http.Response, error) {
-			return c.conn.httpClient.Do(req)
+			attempt++
+
+			// the body of the previous attempt has been consumed, rewind it
+			if attempt > 1 && req.GetBody != nil {
+				body, e := req.GetBody()
+				if e != nil {
+					return nil, retry.Unrecoverable(e)
+				}
+
+				req.Body = body
+			}
+
+			r, e := c.conn.httpClient.Do(req)
+			if e != nil {
+				return nil, e
+			}
+
+			if e = validateResponseCode(r); e != nil {
+				utils.CloseOrLogError(ctx)(r.Body)
+
+				return nil, e
+			}
+
+			return r, nil
 		},
 	)
 	if err != nil {
+		var httpErr *HTTPError
+		if errors.As(err, &httpErr) {
+			return err
+		}
+
 		return fmt.Errorf("failed to perform HTTP %s request (path: %s) - Reason: %w",
 			method,
 			modifiedPath,
@@ -261,11 +329,6 @@ func (c *client) DoRequest(
 
 	defer utils.CloseOrLogError(ctx)(res.Body)
 
-	err = validateResponseCode(res)
-	if err != nil {
-		return err
-	}
-
 	//nolint:nestif
 	if responseBody != nil {
 		err = json.NewDecoder(res.Body).Decode(responseBody)
@@ -35,4 +35,8 @@ type FileUploadRequest struct {
 	// References:
 	//   1. https://en.wikipedia.org/wiki/Chmod#Special_modes
 	Mode string
+	// Checksum is the expected checksum of the uploaded file, it is verified on the node after the upload when set.
+	Checksum string
+	// ChecksumAlgorithm is the algorithm of Checksum, one of `md5`, `sha256` or `sha512`.
+	ChecksumAlgorithm string
 }
@@ -0,0 +1,92 @@
+/*
+ * This Source Code Form is subject to the terms of the Mozilla Public
+ * License, v. 2.0. If a copy of the MPL was not distributed with this
+ * file, You can obtain one at https://mozilla.org/MPL/2.0/.
+ */
+
+package api
+
+import (
+	"fmt"
+	"io"
+	"net/http"
+	"sync"
+)
+
+// DefaultMaxConcurrentRequests is the default number of requests allowed in flight against the Proxmox VE API.
+const DefaultMaxConcurrentRequests = 8
+
+// WithMaxConcurrentRequests limits the number of requests in flight against the API endpoint.
+// The value 0 disables the limit.
+func WithMaxConcurrentRequests(n int) ConnectionOption {
+	return func(o *connectionOptions) {
+		o.maxConcurrentRequests = n
+	}
+}
+
+//nolint:gochecknoglobals
+var (
+	requestLimitersMu sync.Mutex
+	requestLimiters   = map[string]chan struct{}{}
+)
+
+// requestLimiter returns the semaphore shared by all connections to the endpoint with the same limit,
+// so the limit holds across the SDK and Framework halves of the provider.
+func requestLimiter(endpoint string, limit int) chan struct{} {
+	requestLimitersMu.Lock()
+	defer requestLimitersMu.Unlock()
+
+	key := fmt.Sprintf("%s#%d", endpoint, limit)
+
+	sem, ok := requestLimiters[key]
+	if !ok {
+		sem = make(chan struct{}, limit)
+		requestLimiters[key] = sem
+	}
+
+	return sem
+}
+
+// limitedTransport is a http.RoundTripper that caps the number of concurrent requests.
+// A slot is held until the response body is closed.
+type limitedTransport struct {
+	inner http.RoundTripper
+	sem   chan struct{}
+}
+
+// RoundTrip waits for a free slot and performs the request.
+func (t *limitedTransport) RoundTrip(req *http.Request) (*http.Response, error) {
+	select {
+	case t.sem <- struct{}{}:
+	case <-req.Context().Done():
+		return nil, req.Context().Err()
+	}
+
+	resp, err := t.inner.RoundTrip(req)
+	if err != nil {
+		<-t.sem
+
+		return nil, err
+	}
+
+	resp.Body = &releasingBody{
+		ReadCloser: resp.Body,
+		release:    sync.OnceFunc(func() { <-t.sem }),
+	}
+
+	return resp, nil
+}
+
+// releasingBody releases the request slot when the response body is closed.
+type releasingBody struct {
+	io.ReadCloser
+
+	release func()
+}
+
+// Close closes the response body and releases the request slot.
+func (b *releasingBody) Close() error {
+	defer b.release()
+
+	return b.ReadCloser.Close()
+}
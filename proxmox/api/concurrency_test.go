@@ -0,0 +1,108 @@
+/*
+ * This Source Code Form is subject to the terms of the Mozilla Public
+ * License, v. 2.0. If a copy of the MPL was not distributed with this
+ * file, You can obtain one at https://mozilla.org/MPL/2.0/.
+ */
+
+package api
+
+import (
+	"context"
+	"io"
+	"net/http"
+	"strings"
+	"sync"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestLimitedTransportCapsConcurrentRequests(t *testing.T) {
+	t.Parallel()
+
+	const limit = 2
+
+	var inFlight, maxInFlight atomic.Int32
+
+	transport := &limitedTransport{
+		inner: RoundTripFunc(func(_ *http.Request) *http.Response {
+			n := inFlight.Add(1)
+			for {
+				m := maxInFlight.Load()
+				if n <= m || maxInFlight.CompareAndSwap(m, n) {
+					break
+				}
+			}
+
+			time.Sleep(10 * time.Millisecond)
+			inFlight.Add(-1)
+
+			return &http.Response{StatusCode: http.StatusOK, Body: io.NopCloser(strings.NewReader("{}"))}
+		}),
+		sem: make(chan struct{}, limit),
+	}
+
+	var wg sync.WaitGroup
+
+	for range 10 {
+		wg.Go(func() {
+			req, err := http.NewRequestWithContext(context.Background(), http.MethodGet, "https://example.com", nil)
+			require.NoError(t, err)
+
+			res, err := transport.RoundTrip(req)
+			require.NoError(t, err)
+			require.NoError(t, res.Body.Close())
+		})
+	}
+
+	wg.Wait()
+
+	require.LessOrEqual(t, maxInFlight.Load(), int32(limit))
+	require.Empty(t, transport.sem, "all request slots must be released")
+}
+
+func TestLimitedTransportHoldsSlotUntilBodyClosed(t *testing.T) {
+	t.Parallel()
+
+	transport := &limitedTransport{
+		inner: RoundTripFunc(func(_ *http.Request) *http.Response {
+			return &http.Response{StatusCode: http.StatusOK, Body: io.NopCloser(strings.NewReader("{}"))}
+		}),
+		sem: make(chan struct{}, 1),
+	}
+
+	req, err := http.NewRequestWithContext(context.Background(), http.MethodGet, "https://example.com", nil)
+	require.NoError(t, err)
+
+	res, err := transport.RoundTrip(req)
+	require.NoError(t, err)
+
+	// the only slot is taken until the body of the first response is closed
+	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Millisecond)
+	defer cancel()
+
+	_, err = transport.RoundTrip(req.WithContext(ctx))
+	require.ErrorIs(t, err, context.DeadlineExceeded)
+
+	require.NoError(t, res.Body.Close())
+	require.NoError(t, res.Body.Close(), "closing the body twice must not release the slot twice")
+
+	res, err = transport.RoundTrip(req)
+	require.NoError(t, err)
+	require.NoError(t, res.Body.Close())
+	require.Empty(t, transport.sem)
+}
+
+func TestRequestLimiterIsSharedPerEndpoint(t *testing.T) {
+	t.Parallel()
+
+	a := requestLimiter("https://pve-limiter-test:8006", 4)
+	b := requestLimiter("https://pve-limiter-test:8006", 4)
+	c := requestLimiter("https://pve-limiter-other:8006", 4)
+
+	require.Equal(t, a, b)
+	require.NotEqual(t, a, c)
+	require.Equal(t, 4, cap(a))
+}
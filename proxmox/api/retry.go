@@ -0,0 +1,137 @@
+/*
+ * This Source Code Form is subject to the terms of the Mozilla Public
+ * License, v. 2.0. If a copy of the MPL was not distributed with this
+ * file, You can obtain one at https://mozilla.org/MPL/2.0/.
+ */
+
+package api
+
+import (
+	"errors"
+	"net"
+	"net/http"
+	"net/url"
+	"slices"
+	"strings"
+	"time"
+)
+
+const (
+	// DefaultRequestRetries is the default number of attempts for a retryable API request.
+	DefaultRequestRetries = 4
+
+	// DefaultRequestRetryDelay is the default base delay between attempts of a retryable API request.
+	// The delay grows exponentially with every attempt and is jittered.
+	DefaultRequestRetryDelay = 500 * time.Millisecond
+
+	// statusConnectionTimedOut is the non-standard status pveproxy returns when it can't reach pvedaemon
+	// or the daemon on another node, e.g. when it runs out of workers or file descriptors.
+	statusConnectionTimedOut = 596
+)
+
+// RequestRetryClassifier decides whether a failed API request should be retried.
+type RequestRetryClassifier func(method string, err error) bool
+
+// WithRequestRetries sets the number of attempts and the base delay between attempts of a retryable API request.
+func WithRequestRetries(attempts uint, delay time.Duration) ConnectionOption {
+	return func(o *connectionOptions) {
+		o.retryAttempts = attempts
+		o.retryDelay = delay
+	}
+}
+
+// WithRequestRetryClassifier replaces the default IsRetryableRequestError classifier.
+func WithRequestRetryClassifier(fn RequestRetryClassifier) ConnectionOption {
+	return func(o *connectionOptions) {
+		o.retryIf = fn
+	}
+}
+
+// IsRetryableRequestError is the default RequestRetryClassifier. Idempotent (GET and HEAD) requests are
+// retried on transport errors, on HTTP 502/503/504/596, and on HTTP 500 caused by an internal error of the
+// API daemon (e.g. "too many open files"). Other requests are only retried when the connection could not
+// be established, as they may have already been applied otherwise.
+func IsRetryableRequestError(method string, err error) bool {
+	return isRetryableRequestError(method, err, func(httpErr *HTTPError) bool {
+		switch httpErr.Code {
+		case http.StatusBadGateway, http.StatusServiceUnavailable, http.StatusGatewayTimeout, statusConnectionTimedOut:
+			return true
+		case http.StatusInternalServerError:
+			msg := strings.ToLower(httpErr.Message)
+
+			return strings.Contains(msg, "internal error") || strings.Contains(msg, "too many open files")
+		}
+
+		return false
+	})
+}
+
+// RetryOnStatusCodes returns a RequestRetryClassifier that retries idempotent requests on the given HTTP
+// status codes instead of the default ones. Transport and connection errors are classified the same way as
+// by IsRetryableRequestError.
+func RetryOnStatusCodes(codes ...int) RequestRetryClassifier {
+	return func(method string, err error) bool {
+		return isRetryableRequestError(method, err, func(httpErr *HTTPError) bool {
+			return slices.Contains(codes, httpErr.Code)
+		})
+	}
+}
+
+func isRetryableRequestError(method string, err error, retryHTTPError func(*HTTPError) bool) bool {
+	if err == nil {
+		return false
+	}
+
+	var opErr *net.OpError
+	if errors.As(err, &opErr) && opErr.Op == "dial" {
+		return true
+	}
+
+	if method != http.MethodGet && method != http.MethodHead {
+		return false
+	}
+
+	var urlErr *url.Error
+	if errors.As(err, &urlErr) {
+		return true
+	}
+
+	var httpErr *HTTPError
+	if errors.As(err, &httpErr) {
+		return retryHTTPError(httpErr)
+	}
+
+	return false
+}
+
+// retryAttempts returns the number of attempts of a retryable request.
+func (c *Connection) retryAttempts() uint {
+	if c.options.retryAttempts == 0 {
+		return DefaultRequestRetries
+	}
+
+	return c.options.retryAttempts
+}
+
+// retryDelay returns the base delay between attempts of a retryable request.
+func (c *Connection) retryDelay() time.Duration {
+	if c.options.retryDelay == 0 {
+		return DefaultRequestRetryDelay
+	}
+
+	return c.options.retryDelay
+}
+
+// shouldRetry reports whether the failed request can be retried.
+func (c *Connection) shouldRetry(req *http.Request, err error) bool {
+	// a consumed body that can't be rewound must not be sent again
+	if req.Body != nil && req.Body != http.NoBody && req.GetBody == nil {
+		return false
+	}
+
+	if c.options.retryIf != nil {
+		return c.options.retryIf(req.Method, err)
+	}
+
+	return IsRetryableRequestError(req.Method, err)
+}
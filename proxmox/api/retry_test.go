@@ -0,0 +1,166 @@
+/*
+ * This Source Code Form is subject to the terms of the Mozilla Public
+ * License, v. 2.0. If a copy of the MPL was not distributed with this
+ * file, You can obtain one at https://mozilla.org/MPL/2.0/.
+ */
+
+package api
+
+import (
+	"errors"
+	"fmt"
+	"net"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// newRetryTestClient returns a client for a server that fails the first `failures` requests with the given status.
+func newRetryTestClient(t *testing.T, failures int32, status string) (*client, *atomic.Int32) {
+	t.Helper()
+
+	var hits atomic.Int32
+
+	server := httptest.NewTLSServer(http.HandlerFunc(func(w http.ResponseWriter, _ *http.Request) {
+		if hits.Add(1) <= failures {
+			var code int
+
+			_, err := fmt.Sscanf(status, "%d", &code)
+			assert.NoError(t, err)
+
+			w.Header().Set("Content-Type", "application/json")
+			w.WriteHeader(code)
+			_, _ = fmt.Fprintf(w, `{"message":%q,"data":null}`, status)
+
+			return
+		}
+
+		w.Header().Set("Content-Type", "application/json")
+		_, _ = w.Write([]byte(`{"data":{"version":"8.3.0"}}`))
+	}))
+	t.Cleanup(server.Close)
+
+	conn, err := NewConnection(server.URL, true, "", WithRequestRetries(3, time.Millisecond))
+	require.NoError(t, err)
+
+	return &client{conn: conn, auth: dummyAuthenticator{}}, &hits
+}
+
+func TestDoRequestRetriesGetOnConnectionTimedOut(t *testing.T) {
+	t.Parallel()
+
+	c, hits := newRetryTestClient(t, 2, "596 Connection timed out")
+
+	var res struct {
+		Data struct {
+			Version string `json:"version"`
+		} `json:"data"`
+	}
+
+	err := c.DoRequest(t.Context(), http.MethodGet, "version", nil, &res)
+	require.NoError(t, err)
+	assert.Equal(t, int32(3), hits.Load())
+	assert.Equal(t, "8.3.0", res.Data.Version)
+}
+
+func TestDoRequestRetriesGetOnInternalError(t *testing.T) {
+	t.Parallel()
+
+	c, hits := newRetryTestClient(t, 1, "500 internal error - Too many open files")
+
+	err := c.DoRequest(t.Context(), http.MethodGet, "version", nil, nil)
+	require.NoError(t, err)
+	assert.Equal(t, int32(2), hits.Load())
+}
+
+func TestDoRequestGivesUpAfterAttempts(t *testing.T) {
+	t.Parallel()
+
+	c, hits := newRetryTestClient(t, 5, "596 Connection timed out")
+
+	err := c.DoRequest(t.Context(), http.MethodGet, "version", nil, nil)
+
+	var httpErr *HTTPError
+	require.ErrorAs(t, err, &httpErr)
+	assert.Equal(t, 596, httpErr.Code)
+	assert.Equal(t, int32(3), hits.Load())
+}
+
+func TestDoRequestDoesNotRetryNonIdempotentOnHTTPError(t *testing.T) {
+	t.Parallel()
+
+	c, hits := newRetryTestClient(t, 2, "596 Connection timed out")
+
+	err := c.DoRequest(t.Context(), http.MethodPost, "version", nil, nil)
+	require.Error(t, err)
+	assert.Equal(t, int32(1), hits.Load())
+}
+
+func TestIsRetryableRequestError(t *testing.T) {
+	t.Parallel()
+
+	dialErr := &net.OpError{Op: "dial", Net: "tcp", Err: errors.New("connection refused")}
+	readErr := &net.OpError{Op: "read", Net: "tcp", Err: errors.New("connection reset by peer")}
+
+	tests := []struct {
+		name   string
+		method string
+		err    error
+		want   bool
+	}{
+		{"nil error", http.MethodGet, nil, false},
+		{"GET on 596", http.MethodGet, &HTTPError{Code: 596, Message: "Connection timed out"}, true},
+		{"GET on 503", http.MethodGet, &HTTPError{Code: 503, Message: "Service Unavailable"}, true},
+		{"GET on 500 internal error", http.MethodGet, &HTTPError{Code: 500, Message: "internal error"}, true},
+		{"GET on 500 other error", http.MethodGet, &HTTPError{Code: 500, Message: "VM 100 is locked"}, false},
+		{"GET on 400", http.MethodGet, &HTTPError{Code: 400, Message: "Parameter verification failed"}, false},
+		{"GET on transport error", http.MethodGet, &url.Error{Op: "Get", Err: readErr}, true},
+		{"POST on 596", http.MethodPost, &HTTPError{Code: 596, Message: "Connection timed out"}, false},
+		{"POST on transport error", http.MethodPost, &url.Error{Op: "Post", Err: readErr}, false},
+		{"POST on dial error", http.MethodPost, &url.Error{Op: "Post", Err: dialErr}, true},
+		{"DELETE on dial error", http.MethodDelete, &url.Error{Op: "Delete", Err: dialErr}, true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			assert.Equal(t, tt.want, IsRetryableRequestError(tt.method, tt.err))
+		})
+	}
+}
+
+func TestRetryOnStatusCodes(t *testing.T) {
+	t.Parallel()
+
+	dialErr := &net.OpError{Op: "dial", Net: "tcp", Err: errors.New("connection refused")}
+	classifier := RetryOnStatusCodes(http.StatusInternalServerError, statusConnectionTimedOut)
+
+	tests := []struct {
+		name   string
+		method string
+		err    error
+		want   bool
+	}{
+		{"nil error", http.MethodGet, nil, false},
+		{"GET on listed 596", http.MethodGet, &HTTPError{Code: 596, Message: "Connection timed out"}, true},
+		{"GET on listed 500", http.MethodGet, &HTTPError{Code: 500, Message: "VM 100 is locked"}, true},
+		{"GET on unlisted 503", http.MethodGet, &HTTPError{Code: 503, Message: "Service Unavailable"}, false},
+		{"POST on listed 596", http.MethodPost, &HTTPError{Code: 596, Message: "Connection timed out"}, false},
+		{"POST on dial error", http.MethodPost, &url.Error{Op: "Post", Err: dialErr}, true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			assert.Equal(t, tt.want, classifier(tt.method, tt.err))
+		})
+	}
+}
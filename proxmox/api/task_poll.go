@@ -0,0 +1,28 @@
+/*
+ * This Source Code Form is subject to the terms of the Mozilla Public
+ * License, v. 2.0. If a copy of the MPL was not distributed with this
+ * file, You can obtain one at https://mozilla.org/MPL/2.0/.
+ */
+
+package api
+
+import "time"
+
+// DefaultTaskPollInterval is the default maximum delay between two status polls of a running task.
+const DefaultTaskPollInterval = time.Second
+
+// WithTaskPollInterval sets the maximum delay between two status polls of a running task.
+func WithTaskPollInterval(interval time.Duration) ConnectionOption {
+	return func(o *connectionOptions) {
+		o.taskPollInterval = interval
+	}
+}
+
+// TaskPollInterval returns the maximum delay between two status polls of a running task.
+func (c *client) TaskPollInterval() time.Duration {
+	if c.conn == nil || c.conn.options.taskPollInterval <= 0 {
+		return DefaultTaskPollInterval
+	}
+
+	return c.conn.options.taskPollInterval
+}
@@ -106,6 +106,7 @@ type GetResponseData struct {
 	Node                   *string                         `json:"node,omitempty"`
 	VMID                   *string                         `json:"vmid,omitempty"`
 	All                    *types.CustomBool               `json:"all,omitempty"`
+	Exclude                *string                         `json:"exclude,omitempty"`
 	Mode                   *string                         `json:"mode,omitempty"`
 	Compress               *string                         `json:"compress,omitempty"`
 	StartTime              *string                         `json:"starttime,omitempty"`
@@ -139,6 +140,7 @@ type RequestBodyCommon struct {
 	Node                   *string            `json:"node,omitempty"                      url:"node,omitempty"`
 	VMID                   *string            `json:"vmid,omitempty"                      url:"vmid,omitempty"`
 	All                    *types.CustomBool  `json:"all,omitempty"                       url:"all,omitempty,int"`
+	Exclude                *string            `json:"exclude,omitempty"                   url:"exclude,omitempty"`
 	Mode                   *string            `json:"mode,omitempty"                      url:"mode,omitempty"`
 	Compress               *string            `json:"compress,omitempty"                  url:"compress,omitempty"`
 	StartTime              *string            `json:"starttime,omitempty"                 url:"starttime,omitempty"`
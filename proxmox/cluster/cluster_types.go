@@ -27,29 +27,30 @@ type ResourcesListBody struct {
 
 // ResourcesListRequestBody contains the body params to cluster resource list request.
 type ResourcesListRequestBody struct {
-	Type string `json:"type" url:"type"`
+	Type string `json:"type,omitempty" url:"type,omitempty"`
 }
 
 // ResourcesListResponseData contains the data from a cluster resource list body response.
 type ResourcesListResponseData struct {
-	Type       string  `json:"type"`
-	ID         string  `json:"id"`
-	CgroupMode int     `json:"cgroup-mode,omitempty"`
-	Content    int     `json:"content,omitempty"`
-	CPU        float64 `json:"cpu,omitempty"`
-	Disk       int64   `json:"disk,omitempty"`
-	HaState    string  `json:"hastate,omitempty"`
-	Level      string  `json:"level,omitempty"`
-	MaxCPU     float64 `json:"maxcpu,omitempty"`
-	MaxDisk    int64   `json:"maxdisk,omitempty"`
-	MaxMem     int64   `json:"maxmem,omitempty"`
-	Mem        int64   `json:"mem,omitempty"`
-	Name       string  `json:"name,omitempty"`
-	NodeName   string  `json:"node,omitempty"`
-	PluginType string  `json:"plugintype,omitempty"`
-	PoolName   string  `json:"poolname,omitempty"`
-	Status     string  `json:"status,omitempty"`
-	Storage    string  `json:"storage,omitempty"`
-	Uptime     int     `json:"uptime,omitempty"`
-	VMID       int     `json:"vmid,omitempty"`
+	Type       string           `json:"type"`
+	ID         string           `json:"id"`
+	CgroupMode int              `json:"cgroup-mode,omitempty"`
+	Content    string           `json:"content,omitempty"`
+	CPU        float64          `json:"cpu,omitempty"`
+	Disk       int64            `json:"disk,omitempty"`
+	HaState    string           `json:"hastate,omitempty"`
+	Level      string           `json:"level,omitempty"`
+	MaxCPU     float64          `json:"maxcpu,omitempty"`
+	MaxDisk    int64            `json:"maxdisk,omitempty"`
+	MaxMem     int64            `json:"maxmem,omitempty"`
+	Mem        int64            `json:"mem,omitempty"`
+	Name       string           `json:"name,omitempty"`
+	NodeName   string           `json:"node,omitempty"`
+	PluginType string           `json:"plugintype,omitempty"`
+	PoolName   string           `json:"poolname,omitempty"`
+	Status     string           `json:"status,omitempty"`
+	Storage    string           `json:"storage,omitempty"`
+	Template   types.CustomBool `json:"template,omitempty"`
+	Uptime     int              `json:"uptime,omitempty"`
+	VMID       int              `json:"vmid,omitempty"`
 }
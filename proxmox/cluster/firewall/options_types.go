@@ -24,6 +24,7 @@ type OptionsPutRequestBody struct {
 	PolicyIn     *string             `json:"policy_in,omitempty"      url:"policy_in,omitempty"`
 	PolicyOut    *string             `json:"policy_out,omitempty"     url:"policy_out,omitempty"`
 	PolicyFwd    *string             `json:"policy_forward,omitempty" url:"policy_forward,omitempty"`
+	Delete       []string            `json:"delete,omitempty"         url:"delete,omitempty,comma"`
 }
 
 // CustomLogRateLimit is a custom type for the log_ratelimit field of the firewall optionss.
@@ -17,7 +17,12 @@ type HAResourceListResponseBody struct {
 
 // HAResourceListResponseData contains the data from a HA resource list response.
 type HAResourceListResponseData struct {
+	HAResourceDataBase
+
+	// Identifier of this resource
 	ID types.HAResourceID `json:"sid"`
+	// Type of this resource
+	Type types.HAResourceType `json:"type"`
 }
 
 // HAResourceGetResponseBody contains the body from a HA resource get response.
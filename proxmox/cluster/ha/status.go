@@ -0,0 +1,32 @@
+/*
+ * This Source Code Form is subject to the terms of the Mozilla Public
+ * License, v. 2.0. If a copy of the MPL was not distributed with this
+ * file, You can obtain one at https://mozilla.org/MPL/2.0/.
+ */
+
+package ha
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+
+	"github.com/bpg/terraform-provider-proxmox/proxmox/api"
+)
+
+// GetCurrentStatus retrieves the current status of the High Availability manager, including the live state of
+// every HA-managed service.
+func (c *Client) GetCurrentStatus(ctx context.Context) ([]*StatusCurrentResponseData, error) {
+	resBody := &StatusCurrentResponseBody{}
+
+	err := c.DoRequest(ctx, http.MethodGet, c.ExpandPath("status/current"), nil, resBody)
+	if err != nil {
+		return nil, fmt.Errorf("error reading HA status: %w", err)
+	}
+
+	if resBody.Data == nil {
+		return nil, api.ErrNoDataObjectInResponse
+	}
+
+	return resBody.Data, nil
+}
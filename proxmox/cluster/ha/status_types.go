@@ -0,0 +1,33 @@
+/*
+ * This Source Code Form is subject to the terms of the Mozilla Public
+ * License, v. 2.0. If a copy of the MPL was not distributed with this
+ * file, You can obtain one at https://mozilla.org/MPL/2.0/.
+ */
+
+package ha
+
+// StatusTypeService is the type of the HA status entries which describe HA-managed resources.
+const StatusTypeService = "service"
+
+// StatusCurrentResponseBody contains the body from a HA current status response.
+type StatusCurrentResponseBody struct {
+	Data []*StatusCurrentResponseData `json:"data,omitempty"`
+}
+
+// StatusCurrentResponseData contains a single entry of the HA current status response.
+type StatusCurrentResponseData struct {
+	// Status entry identifier.
+	ID string `json:"id"`
+	// Type of the entry: quorum, master, lrm or service.
+	Type string `json:"type"`
+	// Identifier of the HA resource, for service entries.
+	SID *string `json:"sid,omitempty"`
+	// Node the entry is associated with.
+	Node *string `json:"node,omitempty"`
+	// Current state of the service as seen by the cluster resource manager.
+	CRMState *string `json:"crm_state,omitempty"`
+	// Requested state of the service.
+	State *string `json:"state,omitempty"`
+	// Human-readable status line.
+	Status *string `json:"status,omitempty"`
+}
@@ -15,6 +15,7 @@ import (
 	"net/http"
 	"os"
 	"path/filepath"
+	"regexp"
 	"strconv"
 	"strings"
 	"time"
@@ -29,6 +30,10 @@ const (
 	idGeneratorLockFile         = "terraform-provider-proxmox-id-gen.lock"
 	idGeneratorSequenceFile     = "terraform-provider-proxmox-id-gen.seq"
 	idGeneratorContentionWindow = 5 * time.Second
+
+	// DefaultIDConflictRetries is the default number of times a create is retried with a freshly
+	// allocated identifier when the previous one has been taken in the meantime.
+	DefaultIDConflictRetries = 3
 )
 
 // IDGenerator is responsible for generating unique identifiers for VMs and Containers.
@@ -43,6 +48,10 @@ type IDGeneratorConfig struct {
 	RandomIDStat int
 	RandomIDEnd  int
 
+	// ConflictRetries is the number of times CreateWithRetry allocates a new identifier
+	// after a create failed because the identifier is already in use.
+	ConflictRetries int
+
 	lockFName string
 	seqFName  string
 }
@@ -146,6 +155,36 @@ func (g IDGenerator) NextID(ctx context.Context) (int, error) {
 	return *id, nil
 }
 
+// CreateWithRetry calls create with the identifier allocated by NextID. The identifier is only checked
+// for uniqueness when allocated, so it may be taken by another provider instance or a user before the
+// VM or container is created. When create fails because the identifier is already in use, a new one
+// is allocated and create is called again, up to the configured number of retries.
+// It returns the identifier passed to the last create call.
+func (g IDGenerator) CreateWithRetry(ctx context.Context, id int, create func(ctx context.Context, id int) error) (int, error) {
+	for attempt := 0; ; attempt++ {
+		err := create(ctx, id)
+		if err == nil || !IsIDConflictError(err) || attempt >= g.config.ConflictRetries {
+			return id, err
+		}
+
+		newID, allocErr := g.NextID(ctx)
+		if allocErr != nil {
+			return id, errors.Join(err, allocErr)
+		}
+
+		id = newID
+	}
+}
+
+// idConflictRegexp matches the errors PVE reports when a VM or container identifier is already in use,
+// e.g. "VM 100 already exists on node 'pve'" or "unable to create VM 100: config file already exists".
+var idConflictRegexp = regexp.MustCompile(`\b(?:VM|CT) \d+ already exists|config file already exists`)
+
+// IsIDConflictError returns true if the error reports that a VM or container identifier is already in use.
+func IsIDConflictError(err error) bool {
+	return err != nil && idConflictRegexp.MatchString(err.Error())
+}
+
 func nextSequentialID(seqFName string) (*int, error) {
 	buf, err := lockedfile.Read(seqFName)
 	if err != nil {
@@ -0,0 +1,145 @@
+/*
+ * This Source Code Form is subject to the terms of the Mozilla Public
+ * License, v. 2.0. If a copy of the MPL was not distributed with this
+ * file, You can obtain one at https://mozilla.org/MPL/2.0/.
+ */
+
+package cluster
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/http"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/bpg/terraform-provider-proxmox/proxmox/types"
+)
+
+// nextIDAPIClient answers cluster/nextid requests, confirming the requested identifier
+// or returning the lowest free one.
+type nextIDAPIClient struct{}
+
+func (nextIDAPIClient) DoRequest(_ context.Context, _, _ string, req, res any) error {
+	id := 100
+
+	if r, ok := req.(*NextIDRequestBody); ok && r.VMID != nil {
+		id = *r.VMID
+	}
+
+	data := types.CustomInt(id)
+	res.(*NextIDResponseBody).Data = &data
+
+	return nil
+}
+
+func (nextIDAPIClient) ExpandPath(path string) string       { return path }
+func (nextIDAPIClient) IsRoot(_ context.Context) bool       { return false }
+func (nextIDAPIClient) IsRootTicket(_ context.Context) bool { return false }
+func (nextIDAPIClient) HTTP() *http.Client                  { return &http.Client{} }
+func (nextIDAPIClient) TaskPollInterval() time.Duration     { return time.Second }
+
+func newTestIDGenerator(t *testing.T, retries int) IDGenerator {
+	t.Helper()
+
+	dir := t.TempDir()
+
+	return IDGenerator{
+		client: &Client{Client: nextIDAPIClient{}},
+		config: IDGeneratorConfig{
+			ConflictRetries: retries,
+			lockFName:       filepath.Join(dir, idGeneratorLockFile),
+			seqFName:        filepath.Join(dir, idGeneratorSequenceFile),
+		},
+	}
+}
+
+func TestIDGeneratorCreateWithRetry(t *testing.T) {
+	t.Parallel()
+
+	conflict := errors.New("unable to create VM 100 - VM 100 already exists on node 'pve'")
+
+	tests := []struct {
+		name      string
+		retries   int
+		failures  int
+		failWith  error
+		wantID    int
+		wantCalls []int
+		wantErr   bool
+	}{
+		{name: "no conflict", retries: 3, wantID: 100, wantCalls: []int{100}},
+		{name: "conflict on first attempt", retries: 3, failures: 1, failWith: conflict, wantID: 101, wantCalls: []int{100, 101}},
+		{
+			name: "retries exhausted", retries: 2, failures: 5, failWith: conflict,
+			wantID: 102, wantCalls: []int{100, 101, 102}, wantErr: true,
+		},
+		{name: "retries disabled", retries: 0, failures: 1, failWith: conflict, wantID: 100, wantCalls: []int{100}, wantErr: true},
+		{
+			name: "other error is not retried", retries: 3, failures: 1, failWith: errors.New("storage 'local' does not exist"),
+			wantID: 100, wantCalls: []int{100}, wantErr: true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			g := newTestIDGenerator(t, tt.retries)
+
+			id, err := g.NextID(t.Context())
+			require.NoError(t, err)
+
+			var calls []int
+
+			id, err = g.CreateWithRetry(t.Context(), id, func(_ context.Context, id int) error {
+				calls = append(calls, id)
+
+				if len(calls) <= tt.failures {
+					return fmt.Errorf("error creating VM: %w", tt.failWith)
+				}
+
+				return nil
+			})
+
+			if tt.wantErr {
+				require.Error(t, err)
+			} else {
+				require.NoError(t, err)
+			}
+
+			assert.Equal(t, tt.wantID, id)
+			assert.Equal(t, tt.wantCalls, calls)
+		})
+	}
+}
+
+func TestIsIDConflictError(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name string
+		err  error
+		want bool
+	}{
+		{"nil", nil, false},
+		{"vm exists", errors.New("unable to create VM 100 - VM 100 already exists on node 'pve'"), true},
+		{"ct exists", errors.New("CT 101 already exists on node 'pve'"), true},
+		{"config file exists", errors.New("unable to create VM 100: config file already exists"), true},
+		{"volume exists", errors.New("lvcreate 'pve/vm-100-disk-0' error: Logical Volume already exists"), false},
+		{"pool exists", errors.New("pool 'prod' already exists"), false},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			assert.Equal(t, tt.want, IsIDConflictError(tt.err))
+		})
+	}
+}
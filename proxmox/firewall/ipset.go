@@ -24,6 +24,7 @@ import (
 type IPSet interface {
 	CreateIPSet(ctx context.Context, d *IPSetCreateRequestBody) error
 	AddCIDRToIPSet(ctx context.Context, id string, d IPSetGetResponseData) error
+	UpdateIPSetContent(ctx context.Context, id string, cidr string, d *IPSetContentUpdateRequestBody) error
 	UpdateIPSet(ctx context.Context, d *IPSetUpdateRequestBody) error
 	DeleteIPSet(ctx context.Context, id string) error
 	DeleteIPSetContent(ctx context.Context, id string, cidr string) error
@@ -61,6 +62,27 @@ func (c *Client) AddCIDRToIPSet(ctx context.Context, id string, d IPSetGetRespon
 	return nil
 }
 
+// UpdateIPSetContent updates the options of an IP or Network in an IPSet.
+func (c *Client) UpdateIPSetContent(
+	ctx context.Context,
+	id string,
+	cidr string,
+	d *IPSetContentUpdateRequestBody,
+) error {
+	err := c.DoRequest(
+		ctx,
+		http.MethodPut,
+		fmt.Sprintf("%s/%s/%s", c.ipsetPath(), url.PathEscape(id), url.PathEscape(cidr)),
+		d,
+		nil,
+	)
+	if err != nil {
+		return fmt.Errorf("error updating IPSet content %s: %w", id, err)
+	}
+
+	return nil
+}
+
 // UpdateIPSet updates an IPSet.
 func (c *Client) UpdateIPSet(ctx context.Context, d *IPSetUpdateRequestBody) error {
 	err := c.DoRequest(ctx, http.MethodPost, c.ipsetPath(), d, nil)
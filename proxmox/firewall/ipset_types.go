@@ -33,6 +33,12 @@ type IPSetGetResponseData struct {
 	Comment *string           `json:"comment,omitempty" url:"comment,omitempty"`
 }
 
+// IPSetContentUpdateRequestBody contains the data for an IPSet content update request.
+type IPSetContentUpdateRequestBody struct {
+	NoMatch *types.CustomBool `json:"nomatch,omitempty" url:"nomatch,omitempty,int"`
+	Comment *string           `json:"comment,omitempty" url:"comment,omitempty"`
+}
+
 // IPSetUpdateRequestBody contains the data for an IPSet update request.
 type IPSetUpdateRequestBody struct {
 	ReName  string  `json:"rename,omitempty"  url:"rename,omitempty"`
@@ -22,6 +22,7 @@ type OptionsPutRequestBody struct {
 	PolicyIn    *string           `json:"policy_in,omitempty"     url:"policy_in,omitempty"`
 	PolicyOut   *string           `json:"policy_out,omitempty"    url:"policy_out,omitempty"`
 	RAdv        *types.CustomBool `json:"radv,omitempty"          url:"radv,omitempty,int"`
+	Delete      []string          `json:"delete,omitempty"        url:"delete,omitempty,comma"`
 }
 
 // OptionsGetResponseBody is the response body for the GET /cluster/firewall/options API call.
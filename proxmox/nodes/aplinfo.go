@@ -0,0 +1,31 @@
+/*
+ * This Source Code Form is subject to the terms of the Mozilla Public
+ * License, v. 2.0. If a copy of the MPL was not distributed with this
+ * file, You can obtain one at https://mozilla.org/MPL/2.0/.
+ */
+
+package nodes
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+
+	"github.com/bpg/terraform-provider-proxmox/proxmox/api"
+)
+
+// ListApplianceTemplates retrieves the appliance template index (aplinfo) of a node.
+func (c *Client) ListApplianceTemplates(ctx context.Context) ([]*ApplianceTemplateListResponseData, error) {
+	resBody := &ApplianceTemplateListResponseBody{}
+
+	err := c.DoRequest(ctx, http.MethodGet, c.ExpandPath("aplinfo"), nil, resBody)
+	if err != nil {
+		return nil, fmt.Errorf("error retrieving appliance templates: %w", err)
+	}
+
+	if resBody.Data == nil {
+		return nil, api.ErrNoDataObjectInResponse
+	}
+
+	return resBody.Data, nil
+}
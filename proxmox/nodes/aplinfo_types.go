@@ -0,0 +1,23 @@
+/*
+ * This Source Code Form is subject to the terms of the Mozilla Public
+ * License, v. 2.0. If a copy of the MPL was not distributed with this
+ * file, You can obtain one at https://mozilla.org/MPL/2.0/.
+ */
+
+package nodes
+
+// ApplianceTemplateListResponseBody contains the body from an appliance template list response.
+type ApplianceTemplateListResponseBody struct {
+	Data []*ApplianceTemplateListResponseData `json:"data,omitempty"`
+}
+
+// ApplianceTemplateListResponseData contains the data from an appliance template list response.
+type ApplianceTemplateListResponseData struct {
+	Architecture *string `json:"architecture,omitempty"`
+	OS           string  `json:"os"`
+	Package      string  `json:"package"`
+	Section      *string `json:"section,omitempty"`
+	Template     string  `json:"template"`
+	Type         string  `json:"type"`
+	Version      string  `json:"version"`
+}
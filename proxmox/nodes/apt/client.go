@@ -11,6 +11,7 @@ import (
 
 	"github.com/bpg/terraform-provider-proxmox/proxmox/api"
 	"github.com/bpg/terraform-provider-proxmox/proxmox/nodes/apt/repositories"
+	"github.com/bpg/terraform-provider-proxmox/proxmox/nodes/apt/updates"
 )
 
 // Client is an interface for accessing the Proxmox cluster API.
@@ -27,3 +28,8 @@ func (c *Client) ExpandPath(path string) string {
 func (c *Client) Repositories() *repositories.Client {
 	return &repositories.Client{Client: c}
 }
+
+// Updates returns a client for listing available package updates.
+func (c *Client) Updates() *updates.Client {
+	return &updates.Client{Client: c}
+}
@@ -0,0 +1,30 @@
+/*
+ * This Source Code Form is subject to the terms of the Mozilla Public
+ * License, v. 2.0. If a copy of the MPL was not distributed with this
+ * file, You can obtain one at https://mozilla.org/MPL/2.0/.
+ */
+
+package updates
+
+// GetResponseBody is the body from an APT updates list response.
+type GetResponseBody struct {
+	Data []*GetResponseData `json:"data,omitempty"`
+}
+
+// GetResponseData contains the data of an available package update.
+type GetResponseData struct {
+	// Package is the name of the package.
+	Package string `json:"Package"`
+
+	// OldVersion is the currently installed version of the package.
+	OldVersion *string `json:"OldVersion,omitempty"`
+
+	// Version is the version of the package that is available for installation.
+	Version string `json:"Version"`
+
+	// Priority is the priority of the package.
+	Priority *string `json:"Priority,omitempty"`
+
+	// Title is the short description of the package.
+	Title *string `json:"Title,omitempty"`
+}
@@ -0,0 +1,41 @@
+/*
+ * This Source Code Form is subject to the terms of the Mozilla Public
+ * License, v. 2.0. If a copy of the MPL was not distributed with this
+ * file, You can obtain one at https://mozilla.org/MPL/2.0/.
+ */
+
+package updates
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+
+	"github.com/bpg/terraform-provider-proxmox/proxmox/api"
+)
+
+// Client is an interface for accessing the Proxmox node APT updates API.
+type Client struct {
+	api.Client
+}
+
+// ExpandPath expands a relative path to a full APT updates API path.
+func (c *Client) ExpandPath() string {
+	return c.Client.ExpandPath("update")
+}
+
+// List retrieves the list of available package updates.
+func (c *Client) List(ctx context.Context) ([]*GetResponseData, error) {
+	resBody := &GetResponseBody{}
+
+	err := c.DoRequest(ctx, http.MethodGet, c.ExpandPath(), nil, resBody)
+	if err != nil {
+		return nil, fmt.Errorf("reading APT package updates: %w", err)
+	}
+
+	if resBody.Data == nil {
+		return nil, api.ErrNoDataObjectInResponse
+	}
+
+	return resBody.Data, nil
+}
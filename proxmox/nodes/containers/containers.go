@@ -52,6 +52,22 @@ func (c *Client) CloneContainerAsync(ctx context.Context, d *CloneRequestBody) (
 	return resBody.Data, nil
 }
 
+// ConvertToTemplate converts a container to a template.
+func (c *Client) ConvertToTemplate(ctx context.Context) tasks.TaskResult {
+	resBody := &TaskSubmittedResponseBody{}
+
+	err := c.DoRequest(ctx, http.MethodPost, c.ExpandPath("template"), nil, resBody)
+	if err != nil {
+		return tasks.TaskFailed(fmt.Errorf("error converting container %d to template: %w", c.VMID, err))
+	}
+
+	if resBody.Data != nil {
+		return c.Tasks().WaitForTask(ctx, *resBody.Data)
+	}
+
+	return tasks.TaskOK()
+}
+
 // CreateContainer creates a container.
 // The returned TaskResult carries any warnings from the task log.
 func (c *Client) CreateContainer(ctx context.Context, d *CreateRequestBody) tasks.TaskResult {
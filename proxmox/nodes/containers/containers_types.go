@@ -98,11 +98,12 @@ type CustomEnvironmentVariables map[string]string
 
 // CustomFeatures contains the values for the "features" property.
 type CustomFeatures struct {
-	FUSE           *types.CustomBool `json:"fuse,omitempty"    url:"fuse,omitempty,int"`
-	KeyControl     *types.CustomBool `json:"keyctl,omitempty"  url:"keyctl,omitempty,int"`
-	MountTypes     *[]string         `json:"mount,omitempty"   url:"mount,omitempty"`
-	Nesting        *types.CustomBool `json:"nesting,omitempty" url:"nesting,omitempty,int"`
-	MakeDeviceNode *types.CustomBool `json:"mknod,omitempty"   url:"mknod,omitempty,int"`
+	ForceRWSys     *types.CustomBool `json:"force_rw_sys,omitempty" url:"force_rw_sys,omitempty,int"`
+	FUSE           *types.CustomBool `json:"fuse,omitempty"         url:"fuse,omitempty,int"`
+	KeyControl     *types.CustomBool `json:"keyctl,omitempty"       url:"keyctl,omitempty,int"`
+	MountTypes     *[]string         `json:"mount,omitempty"        url:"mount,omitempty"`
+	Nesting        *types.CustomBool `json:"nesting,omitempty"      url:"nesting,omitempty,int"`
+	MakeDeviceNode *types.CustomBool `json:"mknod,omitempty"        url:"mknod,omitempty,int"`
 }
 
 // CustomMountPoint contains the values for the "mp[n]" properties.
@@ -361,6 +362,14 @@ func (r *CustomFeatures) EncodeValues(key string, v *url.Values) error {
 		}
 	}
 
+	if r.ForceRWSys != nil {
+		if *r.ForceRWSys {
+			values = append(values, "force_rw_sys=1")
+		} else {
+			values = append(values, "force_rw_sys=0")
+		}
+	}
+
 	if len(values) > 0 {
 		v.Add(key, strings.Join(values, ","))
 	}
@@ -620,7 +629,7 @@ func (r *CustomNetworkInterface) EncodeValues(
 	values = append(values, fmt.Sprintf("name=%s", r.Name))
 
 	if r.RateLimit != nil {
-		values = append(values, fmt.Sprintf("rate=%.2f", *r.RateLimit))
+		values = append(values, "rate="+strconv.FormatFloat(*r.RateLimit, 'f', -1, 64))
 	}
 
 	if r.Tag != nil {
@@ -816,6 +825,8 @@ func (r *CustomFeatures) UnmarshalJSON(b []byte) error {
 				r.Nesting = types.CustomBool(v[1] == "1").Pointer()
 			case "mknod":
 				r.MakeDeviceNode = types.CustomBool(v[1] == "1").Pointer()
+			case "force_rw_sys":
+				r.ForceRWSys = types.CustomBool(v[1] == "1").Pointer()
 			}
 		}
 	}
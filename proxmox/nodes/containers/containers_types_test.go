@@ -13,6 +13,8 @@ import (
 
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
+
+	"github.com/bpg/terraform-provider-proxmox/proxmox/types"
 )
 
 func TestCustomLXCConfig_UnmarshalJSON(t *testing.T) {
@@ -159,17 +161,25 @@ func TestGetResponseData_UnmarshalJSON_WithIDMaps(t *testing.T) {
 	require.Len(t, data.LXCConfig.Raw, 2)
 }
 
-func TestCustomNetworkInterface_HostManaged(t *testing.T) {
+func TestCustomNetworkInterface_Flags(t *testing.T) {
 	t.Parallel()
 
+	hostManaged := func(ni CustomNetworkInterface) *types.CustomBool { return ni.HostManaged }
+	firewall := func(ni CustomNetworkInterface) *types.CustomBool { return ni.Firewall }
+
 	tests := []struct {
-		name  string
-		input string
-		want  *bool // nil = absent, else expected value
+		name   string
+		input  string
+		option string
+		get    func(ni CustomNetworkInterface) *types.CustomBool
+		want   *bool // nil = absent, else expected value
 	}{
-		{"host-managed=1", `"name=eth0,bridge=vmbr0,host-managed=1"`, new(true)},
-		{"host-managed=0", `"name=eth0,bridge=vmbr0,host-managed=0"`, new(false)},
-		{"flag absent", `"name=eth0,bridge=vmbr0"`, nil},
+		{"host-managed=1", `"name=eth0,bridge=vmbr0,host-managed=1"`, "host-managed", hostManaged, new(true)},
+		{"host-managed=0", `"name=eth0,bridge=vmbr0,host-managed=0"`, "host-managed", hostManaged, new(false)},
+		{"host-managed absent", `"name=eth0,bridge=vmbr0"`, "host-managed", hostManaged, nil},
+		{"firewall=1", `"name=eth0,bridge=vmbr0,firewall=1"`, "firewall", firewall, new(true)},
+		{"firewall=0", `"name=eth0,bridge=vmbr0,firewall=0"`, "firewall", firewall, new(false)},
+		{"firewall absent", `"name=eth0,bridge=vmbr0"`, "firewall", firewall, nil},
 	}
 
 	for _, tc := range tests {
@@ -179,21 +189,23 @@ func TestCustomNetworkInterface_HostManaged(t *testing.T) {
 			var ni CustomNetworkInterface
 			require.NoError(t, json.Unmarshal([]byte(tc.input), &ni))
 
+			v := url.Values{}
+			require.NoError(t, ni.EncodeValues("net0", &v))
+			encoded := v.Get("net0")
+
 			if tc.want == nil {
-				assert.Nil(t, ni.HostManaged)
+				assert.Nil(t, tc.get(ni))
+				assert.NotContains(t, encoded, tc.option)
+
 				return
 			}
 
-			require.NotNil(t, ni.HostManaged)
-			assert.Equal(t, *tc.want, bool(*ni.HostManaged))
-
-			v := url.Values{}
-			require.NoError(t, ni.EncodeValues("net0", &v))
-			encoded := v.Get("net0")
+			require.NotNil(t, tc.get(ni))
+			assert.Equal(t, *tc.want, bool(*tc.get(ni)))
 
-			expected := "host-managed=0"
+			expected := tc.option + "=0"
 			if *tc.want {
-				expected = "host-managed=1"
+				expected = tc.option + "=1"
 			}
 
 			assert.Contains(t, encoded, expected,
@@ -201,3 +213,33 @@ func TestCustomNetworkInterface_HostManaged(t *testing.T) {
 		})
 	}
 }
+
+func TestCustomNetworkInterface_RateLimit(t *testing.T) {
+	t.Parallel()
+
+	var ni CustomNetworkInterface
+	require.NoError(t, json.Unmarshal([]byte(`"name=eth0,bridge=vmbr0,rate=0.125"`), &ni))
+	require.NotNil(t, ni.RateLimit)
+	assert.InDelta(t, 0.125, *ni.RateLimit, 0)
+
+	v := url.Values{}
+	require.NoError(t, ni.EncodeValues("net0", &v))
+	assert.Contains(t, v.Get("net0"), "rate=0.125")
+}
+
+func TestCustomFeatures_ForceRWSys(t *testing.T) {
+	t.Parallel()
+
+	var features CustomFeatures
+
+	err := json.Unmarshal([]byte(`"nesting=1,keyctl=1,force_rw_sys=1"`), &features)
+	require.NoError(t, err)
+	require.NotNil(t, features.ForceRWSys)
+	assert.True(t, bool(*features.ForceRWSys))
+	assert.True(t, bool(*features.Nesting))
+	assert.True(t, bool(*features.KeyControl))
+
+	v := url.Values{}
+	require.NoError(t, features.EncodeValues("features", &v))
+	assert.Equal(t, "keyctl=1,nesting=1,force_rw_sys=1", v.Get("features"))
+}
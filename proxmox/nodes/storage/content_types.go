@@ -6,6 +6,8 @@
 
 package storage
 
+import "github.com/bpg/terraform-provider-proxmox/proxmox/types"
+
 // Content type constants for Proxmox VE datastores.
 const (
 	ContentTypeBackup   = "backup"   // VM backups
@@ -42,13 +44,14 @@ type DatastoreFileListResponseBody struct {
 
 // DatastoreFileListResponseData contains the data from a datastore content list response.
 type DatastoreFileListResponseData struct {
-	ContentType    string  `json:"content"`
-	FileFormat     string  `json:"format"`
-	FileSize       int64   `json:"size"`
-	ParentVolumeID *string `json:"parent,omitempty"`
-	SpaceUsed      *int    `json:"used,omitempty"`
-	VMID           *int    `json:"vmid,omitempty"`
-	VolumeID       string  `json:"volid"`
+	ContentType    string                 `json:"content"`
+	CreationTime   *types.CustomTimestamp `json:"ctime,omitempty"`
+	FileFormat     string                 `json:"format"`
+	FileSize       int64                  `json:"size"`
+	ParentVolumeID *string                `json:"parent,omitempty"`
+	SpaceUsed      *int                   `json:"used,omitempty"`
+	VMID           *int                   `json:"vmid,omitempty"`
+	VolumeID       string                 `json:"volid"`
 }
 
 // DatastoreFileGetRequestData contains the body from a datastore content get request.
@@ -12,6 +12,7 @@ import (
 	"net/http"
 
 	"github.com/bpg/terraform-provider-proxmox/proxmox/api"
+	"github.com/bpg/terraform-provider-proxmox/proxmox/nodes/tasks"
 )
 
 // DownloadFileByURL downloads the file using URL.
@@ -30,7 +31,7 @@ func (c *Client) DownloadFileByURL(
 		return api.ErrNoDataObjectInResponse
 	}
 
-	taskErr := c.Tasks().WaitForTask(ctx, *resBody.TaskID).Err()
+	taskErr := c.Tasks().WaitForTask(ctx, *resBody.TaskID, tasks.WithProgressLog()).Err()
 	if taskErr != nil {
 		err = fmt.Errorf(
 			"error download file to datastore %s: failed waiting for url download: %w",
@@ -65,6 +65,22 @@ func (c *Client) APIUpload(
 			return
 		}
 
+		if d.Checksum != "" {
+			// PVE verifies the checksum of the uploaded file and fails the upload task on a mismatch
+			err = m.WriteField("checksum", d.Checksum)
+			if err == nil {
+				err = m.WriteField("checksum-algorithm", d.ChecksumAlgorithm)
+			}
+
+			if err != nil {
+				tflog.Error(ctx, "failed to write 'checksum' fields", map[string]any{
+					"error": err,
+				})
+
+				return
+			}
+		}
+
 		part, err := m.CreateFormFile("filename", d.FileName)
 		if err != nil {
 			return
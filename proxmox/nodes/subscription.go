@@ -0,0 +1,65 @@
+/*
+ * This Source Code Form is subject to the terms of the Mozilla Public
+ * License, v. 2.0. If a copy of the MPL was not distributed with this
+ * file, You can obtain one at https://mozilla.org/MPL/2.0/.
+ */
+
+package nodes
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+
+	"github.com/bpg/terraform-provider-proxmox/proxmox/api"
+	"github.com/bpg/terraform-provider-proxmox/proxmox/types"
+)
+
+// GetSubscription retrieves the subscription status of a node.
+func (c *Client) GetSubscription(ctx context.Context) (*SubscriptionGetResponseData, error) {
+	resBody := &SubscriptionGetResponseBody{}
+
+	err := c.DoRequest(ctx, http.MethodGet, c.ExpandPath("subscription"), nil, resBody)
+	if err != nil {
+		return nil, fmt.Errorf("error retrieving subscription: %w", err)
+	}
+
+	if resBody.Data == nil {
+		return nil, api.ErrNoDataObjectInResponse
+	}
+
+	return resBody.Data, nil
+}
+
+// SetSubscriptionKey sets the subscription key of a node.
+func (c *Client) SetSubscriptionKey(ctx context.Context, key string) error {
+	err := c.DoRequest(ctx, http.MethodPut, c.ExpandPath("subscription"), &SubscriptionSetRequestBody{Key: key}, nil)
+	if err != nil {
+		return fmt.Errorf("error setting subscription key: %w", err)
+	}
+
+	return nil
+}
+
+// CheckSubscription updates the subscription information of a node from the subscription server.
+// The server is only contacted when the cached information is outdated, unless force is set.
+func (c *Client) CheckSubscription(ctx context.Context, force bool) error {
+	d := &SubscriptionCheckRequestBody{Force: types.CustomBool(force).Pointer()}
+
+	err := c.DoRequest(ctx, http.MethodPost, c.ExpandPath("subscription"), d, nil)
+	if err != nil {
+		return fmt.Errorf("error checking subscription: %w", err)
+	}
+
+	return nil
+}
+
+// DeleteSubscription removes the subscription key of a node.
+func (c *Client) DeleteSubscription(ctx context.Context) error {
+	err := c.DoRequest(ctx, http.MethodDelete, c.ExpandPath("subscription"), nil, nil)
+	if err != nil {
+		return fmt.Errorf("error deleting subscription: %w", err)
+	}
+
+	return nil
+}
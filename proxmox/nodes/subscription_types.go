@@ -0,0 +1,39 @@
+/*
+ * This Source Code Form is subject to the terms of the Mozilla Public
+ * License, v. 2.0. If a copy of the MPL was not distributed with this
+ * file, You can obtain one at https://mozilla.org/MPL/2.0/.
+ */
+
+package nodes
+
+import "github.com/bpg/terraform-provider-proxmox/proxmox/types"
+
+// SubscriptionStatusNotFound is the status of a node without a subscription key.
+const SubscriptionStatusNotFound = "notfound"
+
+// SubscriptionGetResponseBody contains the body from a subscription get response.
+type SubscriptionGetResponseBody struct {
+	Data *SubscriptionGetResponseData `json:"data,omitempty"`
+}
+
+// SubscriptionGetResponseData contains the data from a subscription get response.
+type SubscriptionGetResponseData struct {
+	Key         *string `json:"key,omitempty"`
+	Level       *string `json:"level,omitempty"`
+	Message     *string `json:"message,omitempty"`
+	NextDueDate *string `json:"nextduedate,omitempty"`
+	ProductName *string `json:"productname,omitempty"`
+	RegDate     *string `json:"regdate,omitempty"`
+	ServerID    *string `json:"serverid,omitempty"`
+	Status      string  `json:"status"`
+}
+
+// SubscriptionSetRequestBody contains the body for a subscription key request.
+type SubscriptionSetRequestBody struct {
+	Key string `json:"key" url:"key"`
+}
+
+// SubscriptionCheckRequestBody contains the body for a subscription check request.
+type SubscriptionCheckRequestBody struct {
+	Force *types.CustomBool `json:"force,omitempty" url:"force,omitempty,int"`
+}
@@ -11,6 +11,9 @@ import (
 	"errors"
 	"fmt"
 	"net/http"
+	"net/url"
+	"regexp"
+	"strconv"
 	"strings"
 	"time"
 
@@ -71,6 +74,93 @@ func (c *Client) GetTaskLog(ctx context.Context, upid string) ([]string, error)
 	return lines, nil
 }
 
+// getTaskLogFrom retrieves up to limit lines of the task log, starting at the given line number.
+func (c *Client) getTaskLogFrom(ctx context.Context, upid string, start int, limit int) ([]*GetTaskLogResponseData, error) {
+	resBody := &GetTaskLogResponseBody{}
+
+	path, err := c.BuildPath(upid, "log")
+	if err != nil {
+		return nil, fmt.Errorf("error building path for task log: %w", err)
+	}
+
+	err = c.DoRequest(ctx, http.MethodGet, path, &GetTaskLogRequestBody{Start: &start, Limit: &limit}, resBody)
+	if err != nil {
+		return nil, fmt.Errorf("error retrieving task log: %w", err)
+	}
+
+	return resBody.Data, nil
+}
+
+// GetTaskLogTail retrieves up to the last limit lines of the task log.
+func (c *Client) GetTaskLogTail(ctx context.Context, upid string, limit int) ([]string, error) {
+	resBody := &GetTaskLogResponseBody{}
+
+	path, err := c.BuildPath(upid, "log")
+	if err != nil {
+		return nil, fmt.Errorf("error building path for task log: %w", err)
+	}
+
+	start := 0
+
+	err = c.DoRequest(ctx, http.MethodGet, path, &GetTaskLogRequestBody{Start: &start, Limit: &limit}, resBody)
+	if err != nil {
+		return nil, fmt.Errorf("error retrieving task log: %w", err)
+	}
+
+	// the first page only holds the head of a longer log, fetch the tail instead
+	if resBody.Total > limit {
+		start = resBody.Total - limit
+		resBody = &GetTaskLogResponseBody{}
+
+		err = c.DoRequest(ctx, http.MethodGet, path, &GetTaskLogRequestBody{Start: &start, Limit: &limit}, resBody)
+		if err != nil {
+			return nil, fmt.Errorf("error retrieving task log: %w", err)
+		}
+	}
+
+	lines := make([]string, 0, len(resBody.Data))
+
+	for _, line := range resBody.Data {
+		lines = append(lines, line.LineText)
+	}
+
+	return lines, nil
+}
+
+// GetTaskEndTime retrieves the time a finished task ended at from the node task list.
+// The zero time is returned if the task is still running or not listed.
+func (c *Client) GetTaskEndTime(ctx context.Context, upid string) (time.Time, error) {
+	tid, err := ParseTaskID(upid)
+	if err != nil {
+		return time.Time{}, err
+	}
+
+	startTime := tid.StartTime.Unix()
+	resBody := &ListTasksResponseBody{}
+
+	// the list filters on the start time, so this narrows it down to the tasks started in the same second
+	reqBody := &ListTasksRequestBody{
+		Source:     new("all"),
+		Since:      &startTime,
+		Until:      &startTime,
+		TypeFilter: &tid.Type,
+		UserFilter: &tid.User,
+	}
+
+	err = c.DoRequest(ctx, http.MethodGet, fmt.Sprintf("nodes/%s/tasks", url.PathEscape(tid.NodeName)), reqBody, resBody)
+	if err != nil {
+		return time.Time{}, fmt.Errorf("error listing tasks: %w", err)
+	}
+
+	for _, task := range resBody.Data {
+		if task.UPID == upid && task.EndTime > 0 {
+			return time.Unix(task.EndTime, 0).UTC(), nil
+		}
+	}
+
+	return time.Time{}, nil
+}
+
 // DeleteTask deletes specific task.
 func (c *Client) DeleteTask(ctx context.Context, upid string) error {
 	path, err := c.baseTaskPath(upid)
@@ -90,9 +180,15 @@ func (c *Client) DeleteTask(ctx context.Context, upid string) error {
 	return nil
 }
 
+// taskPollMinInterval is the delay before the first status poll of a running task. The delay doubles with every
+// poll until it reaches the poll interval, so quick tasks complete without waiting for a full interval.
+const taskPollMinInterval = 250 * time.Millisecond
+
 type taskWaitOptions struct {
 	failOnWarnings   bool
 	ignoreStatusCode int
+	progressLog      bool
+	pollInterval     time.Duration
 }
 
 // TaskWaitOption is an option for waiting for a task to complete.
@@ -125,6 +221,32 @@ func (w withIgnoreStatus) apply(opts *taskWaitOptions) {
 	opts.ignoreStatusCode = w.statusCode
 }
 
+type withProgressLog struct{}
+
+// WithProgressLog emits the task log lines as trace logs while the task is running, so the progress of
+// long-running tasks such as file downloads is visible.
+func WithProgressLog() TaskWaitOption {
+	return withProgressLog{}
+}
+
+func (w withProgressLog) apply(opts *taskWaitOptions) {
+	opts.progressLog = true
+}
+
+type withPollInterval struct {
+	interval time.Duration
+}
+
+// WithPollInterval overrides the maximum delay between two status polls of the task, which defaults to the
+// task poll interval of the API client.
+func WithPollInterval(interval time.Duration) TaskWaitOption {
+	return withPollInterval{interval: interval}
+}
+
+func (w withPollInterval) apply(opts *taskWaitOptions) {
+	opts.pollInterval = w.interval
+}
+
 // DoTask dispatches an async PVE task with retry and waits for its result.
 // On dispatch failure, the returned TaskResult wraps the dispatch error.
 // On success or task failure, the TaskResult comes from WaitForTask. When the task
@@ -153,12 +275,20 @@ func (c *Client) DoTask(
 func (c *Client) WaitForTask(ctx context.Context, upid string, opts ...TaskWaitOption) TaskResult {
 	errStillRunning := errors.New("still running")
 
-	options := &taskWaitOptions{}
+	options := &taskWaitOptions{
+		pollInterval: c.TaskPollInterval(),
+	}
 
 	for _, opt := range opts {
 		opt.apply(options)
 	}
 
+	if options.pollInterval <= 0 {
+		options.pollInterval = api.DefaultTaskPollInterval
+	}
+
+	progress := &taskProgress{}
+
 	status, err := retrylib.NewWithData[*GetTaskStatusResponseData](
 		retrylib.Context(ctx),
 		retrylib.RetryIf(func(err error) bool {
@@ -180,8 +310,9 @@ func (c *Client) WaitForTask(ctx context.Context, upid string, opts ...TaskWaitO
 		}),
 		retrylib.LastErrorOnly(true),
 		retrylib.UntilSucceeded(),
-		retrylib.DelayType(retrylib.FixedDelay),
-		retrylib.Delay(time.Second),
+		retrylib.DelayType(retrylib.BackOffDelay),
+		retrylib.Delay(min(taskPollMinInterval, options.pollInterval)),
+		retrylib.MaxDelay(options.pollInterval),
 	).Do(
 		func() (*GetTaskStatusResponseData, error) {
 			status, err := c.GetTaskStatus(ctx, upid)
@@ -190,6 +321,10 @@ func (c *Client) WaitForTask(ctx context.Context, upid string, opts ...TaskWaitO
 			}
 
 			if status.Status == "running" {
+				if options.progressLog {
+					c.logTaskProgress(ctx, upid, progress)
+				}
+
 				return nil, errStillRunning
 			}
 
@@ -200,6 +335,10 @@ func (c *Client) WaitForTask(ctx context.Context, upid string, opts ...TaskWaitO
 		return TaskFailed(fmt.Errorf("timeout while waiting for task %q to complete", upid))
 	}
 
+	if errors.Is(err, context.Canceled) {
+		return TaskFailed(fmt.Errorf("cancelled while waiting for task %q to complete", upid))
+	}
+
 	if err != nil {
 		return TaskFailed(fmt.Errorf("error while waiting for task %q to complete: %w", upid, err))
 	}
@@ -221,6 +360,67 @@ func (c *Client) WaitForTask(ctx context.Context, upid string, opts ...TaskWaitO
 	return TaskOK()
 }
 
+// taskProgressLogLimit is the maximum number of task log lines fetched per progress poll.
+const taskProgressLogLimit = 500
+
+// taskProgressBytesRegex matches the leading kilobyte counter of a wget dot-style progress line,
+// e.g. " 32768K ........ ........ ........ ........  3% 82.6M 29s".
+var taskProgressBytesRegex = regexp.MustCompile(`^\s*(\d+)K\s+[. ]+`)
+
+// taskProgress tracks the task log lines already emitted by logTaskProgress.
+type taskProgress struct {
+	lastLine int
+}
+
+// logTaskProgress emits the task log lines written since the previous poll as trace logs.
+// This is best-effort: failures to fetch the log are ignored.
+func (c *Client) logTaskProgress(ctx context.Context, upid string, progress *taskProgress) {
+	lines, err := c.getTaskLogFrom(ctx, upid, progress.lastLine, taskProgressLogLimit)
+	if err != nil {
+		tflog.Trace(ctx, "failed to fetch task log for progress", map[string]any{
+			"task_id": upid,
+			"error":   err.Error(),
+		})
+
+		return
+	}
+
+	for _, line := range lines {
+		// line numbers are 1-based, so the last seen line number is the offset of the next poll
+		if line == nil || line.LineNumber <= progress.lastLine {
+			continue
+		}
+
+		progress.lastLine = line.LineNumber
+
+		fields := map[string]any{
+			"task_id": upid,
+			"line":    line.LineText,
+		}
+
+		if downloaded, ok := parseTaskProgressBytes(line.LineText); ok {
+			fields["downloaded_bytes"] = downloaded
+		}
+
+		tflog.Trace(ctx, "task progress", fields)
+	}
+}
+
+// parseTaskProgressBytes extracts the number of downloaded bytes from a wget dot-style progress line.
+func parseTaskProgressBytes(line string) (int64, bool) {
+	match := taskProgressBytesRegex.FindStringSubmatch(line)
+	if match == nil {
+		return 0, false
+	}
+
+	kb, err := strconv.ParseInt(match[1], 10, 64)
+	if err != nil {
+		return 0, false
+	}
+
+	return kb * 1024, true
+}
+
 // filterWarnings extracts warning lines from a task log.
 func filterWarnings(lines []string) []string {
 	var warnings []string
@@ -7,10 +7,14 @@
 package tasks
 
 import (
+	"context"
 	"encoding/json"
 	"net/http"
 	"net/http/httptest"
+	"sync"
+	"sync/atomic"
 	"testing"
+	"time"
 
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
@@ -256,3 +260,235 @@ func TestWaitForTask_FailedTaskWithWarningsDoesNotDuplicateWarnLines(t *testing.
 	require.Len(t, result.Warnings(), 1)
 	assert.Contains(t, result.Warnings()[0], "disk is nearly full")
 }
+
+// TestWaitForTask_WithProgressLogReadsLogIncrementally verifies that the task log is polled
+// while the task is running, continuing from the last line already seen.
+func TestWaitForTask_WithProgressLogReadsLogIncrementally(t *testing.T) {
+	t.Parallel()
+
+	mux := http.NewServeMux()
+
+	var (
+		mu          sync.Mutex
+		statusCalls int
+		logStarts   []string
+	)
+
+	// Task status: running for the first two polls, then completed.
+	mux.HandleFunc("GET /api2/json/nodes/pve/tasks/"+testUPID+"/status", func(w http.ResponseWriter, r *http.Request) {
+		mu.Lock()
+		statusCalls++
+		status := "running"
+
+		if statusCalls > 2 {
+			status = "stopped"
+		}
+		mu.Unlock()
+
+		w.Header().Set("Content-Type", "application/json")
+		writeJSON(w, map[string]any{
+			"data": map[string]any{
+				"status":     status,
+				"exitstatus": "OK",
+			},
+		})
+	})
+
+	// Task log: one new progress line per poll.
+	mux.HandleFunc("GET /api2/json/nodes/pve/tasks/"+testUPID+"/log", func(w http.ResponseWriter, r *http.Request) {
+		start := r.URL.Query().Get("start")
+
+		mu.Lock()
+		logStarts = append(logStarts, start)
+		mu.Unlock()
+
+		lines := []map[string]any{
+			{"n": 1, "t": "downloading https://example.com/image.iso"},
+		}
+		if start == "1" {
+			lines = []map[string]any{
+				{"n": 2, "t": " 32768K ........ ........ ........ ........  3% 82.6M 29s"},
+			}
+		}
+
+		w.Header().Set("Content-Type", "application/json")
+		writeJSON(w, map[string]any{"data": lines})
+	})
+
+	server := httptest.NewTLSServer(mux)
+	defer server.Close()
+
+	client := newTestClient(t, server.URL)
+
+	result := client.WaitForTask(t.Context(), testUPID, WithProgressLog())
+	require.NoError(t, result.Err())
+
+	mu.Lock()
+	defer mu.Unlock()
+
+	assert.Equal(t, []string{"0", "1"}, logStarts)
+}
+
+// newRunningTaskServer returns a server that reports the task as running for the given number of polls,
+// or forever when polls is negative, and counts the status polls.
+func newRunningTaskServer(t *testing.T, polls int32) (*httptest.Server, *atomic.Int32) {
+	t.Helper()
+
+	var hits atomic.Int32
+
+	mux := http.NewServeMux()
+	mux.HandleFunc("GET /api2/json/nodes/pve/tasks/"+testUPID+"/status", func(w http.ResponseWriter, _ *http.Request) {
+		status := "running"
+		if n := hits.Add(1); polls >= 0 && n > polls {
+			status = "stopped"
+		}
+
+		w.Header().Set("Content-Type", "application/json")
+		writeJSON(w, map[string]any{
+			"data": map[string]any{
+				"status":     status,
+				"exitstatus": "OK",
+			},
+		})
+	})
+
+	server := httptest.NewTLSServer(mux)
+	t.Cleanup(server.Close)
+
+	return server, &hits
+}
+
+// TestWaitForTask_DeadlineReturnsTimeout verifies that a context deadline stops the polling of a task that
+// never completes, and is reported as a timeout.
+func TestWaitForTask_DeadlineReturnsTimeout(t *testing.T) {
+	t.Parallel()
+
+	server, _ := newRunningTaskServer(t, -1)
+	client := newTestClient(t, server.URL)
+
+	ctx, cancel := context.WithTimeout(t.Context(), 300*time.Millisecond)
+	defer cancel()
+
+	start := time.Now()
+	result := client.WaitForTask(ctx, testUPID)
+
+	require.Error(t, result.Err())
+	assert.Contains(t, result.Err().Error(), "timeout while waiting for task")
+	assert.Less(t, time.Since(start), 5*time.Second, "WaitForTask should return once the deadline is exceeded")
+}
+
+// TestWaitForTask_CancelStopsPolling verifies that cancelling the context, e.g. on an interrupted apply,
+// stops the polling of a running task.
+func TestWaitForTask_CancelStopsPolling(t *testing.T) {
+	t.Parallel()
+
+	server, _ := newRunningTaskServer(t, -1)
+	client := newTestClient(t, server.URL)
+
+	ctx, cancel := context.WithCancel(t.Context())
+	time.AfterFunc(300*time.Millisecond, cancel)
+
+	result := client.WaitForTask(ctx, testUPID)
+
+	require.Error(t, result.Err())
+	assert.Contains(t, result.Err().Error(), "cancelled while waiting for task")
+}
+
+// TestWaitForTask_WithPollInterval verifies that a short poll interval completes a quick task without waiting
+// for the default interval.
+func TestWaitForTask_WithPollInterval(t *testing.T) {
+	t.Parallel()
+
+	server, hits := newRunningTaskServer(t, 3)
+	client := newTestClient(t, server.URL)
+
+	start := time.Now()
+	result := client.WaitForTask(t.Context(), testUPID, WithPollInterval(10*time.Millisecond))
+
+	require.NoError(t, result.Err())
+	assert.Equal(t, int32(4), hits.Load())
+	assert.Less(t, time.Since(start), time.Second)
+}
+
+func TestParseTaskProgressBytes(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name   string
+		line   string
+		want   int64
+		wantOK bool
+	}{
+		{"first dot line", "     0K ........ ........ ........ ........  1% 53.3M 37s", 0, true},
+		{"later dot line", " 32768K ........ ........ ........ ........  3% 82.6M 29s", 32768 * 1024, true},
+		{"header line", "Length: 2000000000 (1.9G) [application/octet-stream]", 0, false},
+		{"plain text", "download of 'https://example.com/image.iso' finished", 0, false},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			got, ok := parseTaskProgressBytes(tt.line)
+			assert.Equal(t, tt.wantOK, ok)
+			assert.Equal(t, tt.want, got)
+		})
+	}
+}
+
+// TestGetTaskLogTail verifies that the tail of a log longer than the limit is fetched.
+func TestGetTaskLogTail(t *testing.T) {
+	t.Parallel()
+
+	mux := http.NewServeMux()
+
+	mux.HandleFunc("GET /api2/json/nodes/pve/tasks/"+testUPID+"/log", func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+
+		data := []map[string]any{{"n": 1, "t": "line 1"}, {"n": 2, "t": "line 2"}}
+		if r.URL.Query().Get("start") == "3" {
+			data = []map[string]any{{"n": 4, "t": "line 4"}, {"n": 5, "t": "line 5"}}
+		}
+
+		writeJSON(w, map[string]any{"data": data, "total": 5})
+	})
+
+	server := httptest.NewTLSServer(mux)
+	defer server.Close()
+
+	lines, err := newTestClient(t, server.URL).GetTaskLogTail(t.Context(), testUPID, 2)
+	require.NoError(t, err)
+	assert.Equal(t, []string{"line 4", "line 5"}, lines)
+}
+
+// TestGetTaskEndTime verifies that the end time is looked up in the node task list,
+// filtered by the start time, type and user of the task.
+func TestGetTaskEndTime(t *testing.T) {
+	t.Parallel()
+
+	mux := http.NewServeMux()
+
+	mux.HandleFunc("GET /api2/json/nodes/pve/tasks", func(w http.ResponseWriter, r *http.Request) {
+		q := r.URL.Query()
+		assert.Equal(t, "all", q.Get("source"))
+		assert.Equal(t, "2864434397", q.Get("since"))
+		assert.Equal(t, "2864434397", q.Get("until"))
+		assert.Equal(t, "vzcreate", q.Get("typefilter"))
+		assert.Equal(t, "root@pam", q.Get("userfilter"))
+
+		w.Header().Set("Content-Type", "application/json")
+		writeJSON(w, map[string]any{
+			"data": []map[string]any{
+				{"upid": "UPID:pve:00009999:00005678:AABBCCDD:vzcreate:101:root@pam:", "endtime": 2864434500},
+				{"upid": testUPID, "status": "OK", "starttime": 2864434397, "endtime": 2864434410},
+			},
+		})
+	})
+
+	server := httptest.NewTLSServer(mux)
+	defer server.Close()
+
+	endTime, err := newTestClient(t, server.URL).GetTaskEndTime(t.Context(), testUPID)
+	require.NoError(t, err)
+	assert.Equal(t, time.Unix(2864434410, 0).UTC(), endTime)
+}
@@ -20,14 +20,23 @@ type GetTaskStatusResponseBody struct {
 
 // GetTaskStatusResponseData contains the data from a node get task status response.
 type GetTaskStatusResponseData struct {
-	PID      int    `json:"pid,omitempty"`
-	Status   string `json:"status,omitempty"`
-	ExitCode string `json:"exitstatus,omitempty"`
+	PID       int    `json:"pid,omitempty"`
+	Status    string `json:"status,omitempty"`
+	ExitCode  string `json:"exitstatus,omitempty"`
+	Type      string `json:"type,omitempty"`
+	StartTime int64  `json:"starttime,omitempty"`
+}
+
+// GetTaskLogRequestBody contains the query parameters for a node get task log request.
+type GetTaskLogRequestBody struct {
+	Start *int `url:"start,omitempty"`
+	Limit *int `url:"limit,omitempty"`
 }
 
 // GetTaskLogResponseBody contains the body from a node get task log response.
 type GetTaskLogResponseBody struct {
-	Data []*GetTaskLogResponseData `json:"data,omitempty"`
+	Data  []*GetTaskLogResponseData `json:"data,omitempty"`
+	Total int                       `json:"total,omitempty"`
 }
 
 // GetTaskLogResponseData contains the data from a node get task log response.
@@ -36,6 +45,29 @@ type GetTaskLogResponseData struct {
 	LineText   string `json:"t,omitempty"`
 }
 
+// ListTasksRequestBody contains the query parameters for a node list tasks request.
+type ListTasksRequestBody struct {
+	Source     *string `url:"source,omitempty"`
+	Since      *int64  `url:"since,omitempty"`
+	Until      *int64  `url:"until,omitempty"`
+	TypeFilter *string `url:"typefilter,omitempty"`
+	UserFilter *string `url:"userfilter,omitempty"`
+}
+
+// ListTasksResponseBody contains the body from a node list tasks response.
+type ListTasksResponseBody struct {
+	Data []*ListTasksResponseData `json:"data,omitempty"`
+}
+
+// ListTasksResponseData contains the data from a node list tasks response.
+type ListTasksResponseData struct {
+	UPID      string `json:"upid"`
+	Type      string `json:"type,omitempty"`
+	Status    string `json:"status,omitempty"`
+	StartTime int64  `json:"starttime,omitempty"`
+	EndTime   int64  `json:"endtime,omitempty"`
+}
+
 // TaskID contains the components of a PVE task ID.
 type TaskID struct {
 	NodeName  string
@@ -114,10 +114,7 @@ func (r CustomCloudInitConfig) EncodeValues(_ string, v *url.Values) error {
 	}
 
 	if r.SSHKeys != nil {
-		v.Add(
-			"sshkeys",
-			strings.ReplaceAll(url.QueryEscape(strings.Join(*r.SSHKeys, "\n")), "+", "%20"),
-		)
+		v.Add("sshkeys", r.SSHKeys.encode())
 	}
 
 	if r.Type != nil {
@@ -207,16 +204,38 @@ func (r *CustomCloudInitSSHKeys) UnmarshalJSON(b []byte) error {
 		return fmt.Errorf("error unmarshalling CustomCloudInitSSHKeys: %w", err)
 	}
 
-	s, err := url.QueryUnescape(s)
+	// unlike url.QueryUnescape, this keeps a literal "+" of a key that was not set through the API
+	s, err := url.PathUnescape(s)
 	if err != nil {
 		return fmt.Errorf("error unescaping CustomCloudInitSSHKeys: %w", err)
 	}
 
-	if s != "" {
-		*r = strings.Split(strings.TrimSpace(s), "\n")
-	} else {
-		*r = []string{}
+	*r = []string{}
+
+	for line := range strings.SplitSeq(s, "\n") {
+		if line = strings.TrimSpace(line); line != "" {
+			*r = append(*r, line)
+		}
 	}
 
 	return nil
 }
+
+// sshKeysUnescaper reverts the escaping of the characters that encodeURIComponent, which the Proxmox VE UI
+// uses for the sshkeys value, leaves as is.
+//
+//nolint:gochecknoglobals
+var sshKeysUnescaper = strings.NewReplacer("+", "%20", "%21", "!", "%27", "'", "%28", "(", "%29", ")", "%2A", "*")
+
+// encode joins the keys with newlines and URL-encodes them the same way as the Proxmox VE UI.
+func (r CustomCloudInitSSHKeys) encode() string {
+	keys := make([]string, 0, len(r))
+
+	for _, k := range r {
+		if k = strings.TrimSpace(k); k != "" {
+			keys = append(keys, k)
+		}
+	}
+
+	return sshKeysUnescaper.Replace(url.QueryEscape(strings.Join(keys, "\n")))
+}
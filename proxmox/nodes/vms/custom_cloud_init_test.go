@@ -7,6 +7,7 @@
 package vms
 
 import (
+	"encoding/json"
 	"net/url"
 	"testing"
 
@@ -61,3 +62,90 @@ func TestCustomCloudInitConfig_EncodeValues_Upgrade(t *testing.T) {
 		})
 	}
 }
+
+func TestCustomCloudInitIPConfig_RoundTrip(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name   string
+		value  string
+		config CustomCloudInitIPConfig
+	}{
+		{
+			name:   "slaac",
+			value:  "ip6=auto",
+			config: CustomCloudInitIPConfig{IPv6: new("auto")},
+		},
+		{
+			name:   "dhcpv6",
+			value:  "ip6=dhcp",
+			config: CustomCloudInitIPConfig{IPv6: new("dhcp")},
+		},
+		{
+			name:   "static ipv6",
+			value:  "gw6=2001:db8::1,ip6=2001:db8::5/64",
+			config: CustomCloudInitIPConfig{IPv6: new("2001:db8::5/64"), GatewayIPv6: new("2001:db8::1")},
+		},
+		{
+			name:  "dual stack",
+			value: "gw=192.168.1.1,gw6=2001:db8::1,ip=192.168.1.5/24,ip6=2001:db8::5/64",
+			config: CustomCloudInitIPConfig{
+				IPv4:        new("192.168.1.5/24"),
+				GatewayIPv4: new("192.168.1.1"),
+				IPv6:        new("2001:db8::5/64"),
+				GatewayIPv6: new("2001:db8::1"),
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			values := &url.Values{}
+			err := CustomCloudInitConfig{IPConfig: []CustomCloudInitIPConfig{tt.config}}.EncodeValues("", values)
+			require.NoError(t, err)
+			require.Equal(t, tt.value, values.Get("ipconfig0"))
+
+			raw, err := json.Marshal(tt.value)
+			require.NoError(t, err)
+
+			var parsed CustomCloudInitIPConfig
+
+			require.NoError(t, json.Unmarshal(raw, &parsed))
+			require.Equal(t, tt.config, parsed)
+		})
+	}
+}
+
+func TestCustomCloudInitSSHKeys_RoundTrip(t *testing.T) {
+	t.Parallel()
+
+	keys := CustomCloudInitSSHKeys{
+		"ssh-ed25519 AAAAC3NzaC1lZDI1NTE5AAAAIHkz+f/0 alice@example.com",
+		"ssh-rsa AAAAB3NzaC1yc2EAAAADAQABAAABAQC7+x/y= bob's key (laptop)\n",
+		"ecdsa-sha2-nistp256 AAAAE2VjZHNhLXNoYTItbmlzdHAyNTYAAA== carol@host",
+	}
+
+	// the value the Proxmox VE UI sends, i.e. encodeURIComponent() of the newline-joined keys
+	encoded := "ssh-ed25519%20AAAAC3NzaC1lZDI1NTE5AAAAIHkz%2Bf%2F0%20alice%40example.com%0A" +
+		"ssh-rsa%20AAAAB3NzaC1yc2EAAAADAQABAAABAQC7%2Bx%2Fy%3D%20bob's%20key%20(laptop)%0A" +
+		"ecdsa-sha2-nistp256%20AAAAE2VjZHNhLXNoYTItbmlzdHAyNTYAAA%3D%3D%20carol%40host"
+
+	values := &url.Values{}
+	err := CustomCloudInitConfig{SSHKeys: &keys}.EncodeValues("", values)
+	require.NoError(t, err)
+	require.Equal(t, encoded, values.Get("sshkeys"))
+
+	raw, err := json.Marshal(encoded)
+	require.NoError(t, err)
+
+	var parsed CustomCloudInitSSHKeys
+
+	require.NoError(t, json.Unmarshal(raw, &parsed))
+	require.Equal(t, CustomCloudInitSSHKeys{
+		"ssh-ed25519 AAAAC3NzaC1lZDI1NTE5AAAAIHkz+f/0 alice@example.com",
+		"ssh-rsa AAAAB3NzaC1yc2EAAAADAQABAAABAQC7+x/y= bob's key (laptop)",
+		"ecdsa-sha2-nistp256 AAAAE2VjZHNhLXNoYTItbmlzdHAyNTYAAA== carol@host",
+	}, parsed)
+}
@@ -73,7 +73,7 @@ func (r *CustomNetworkDevice) EncodeValues(key string, v *url.Values) error {
 	}
 
 	if r.RateLimit != nil {
-		values = append(values, fmt.Sprintf("rate=%f", *r.RateLimit))
+		values = append(values, "rate="+strconv.FormatFloat(*r.RateLimit, 'f', -1, 64))
 	}
 
 	if r.Tag != nil {
@@ -0,0 +1,118 @@
+/*
+ * This Source Code Form is subject to the terms of the Mozilla Public
+ * License, v. 2.0. If a copy of the MPL was not distributed with this
+ * file, You can obtain one at https://mozilla.org/MPL/2.0/.
+ */
+
+package vms
+
+import (
+	"encoding/json"
+	"net/url"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestCustomNetworkDevice_RoundTrip(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name    string
+		input   string
+		encoded string // expected in the encoded value, empty if no option must be encoded
+		check   func(t *testing.T, device CustomNetworkDevice)
+	}{
+		{
+			"integer rate", `"virtio=BC:24:11:00:00:01,bridge=vmbr0,rate=100"`, "rate=100",
+			func(t *testing.T, device CustomNetworkDevice) {
+				t.Helper()
+				require.NotNil(t, device.RateLimit)
+				assert.InDelta(t, 100, *device.RateLimit, 0)
+			},
+		},
+		{
+			"fraction rate", `"virtio=BC:24:11:00:00:01,bridge=vmbr0,rate=12.5"`, "rate=12.5",
+			func(t *testing.T, device CustomNetworkDevice) {
+				t.Helper()
+				require.NotNil(t, device.RateLimit)
+				assert.InDelta(t, 12.5, *device.RateLimit, 0)
+			},
+		},
+		{
+			"three decimals rate", `"virtio=BC:24:11:00:00:01,bridge=vmbr0,rate=0.125"`, "rate=0.125",
+			func(t *testing.T, device CustomNetworkDevice) {
+				t.Helper()
+				require.NotNil(t, device.RateLimit)
+				assert.InDelta(t, 0.125, *device.RateLimit, 0)
+			},
+		},
+		{
+			"link down", `"virtio=BC:24:11:00:00:01,bridge=vmbr0,link_down=1"`, "link_down=1",
+			func(t *testing.T, device CustomNetworkDevice) {
+				t.Helper()
+				require.NotNil(t, device.LinkDown)
+				assert.True(t, bool(*device.LinkDown))
+			},
+		},
+		{
+			"link up", `"virtio=BC:24:11:00:00:01,bridge=vmbr0,link_down=0"`, "link_down=0",
+			func(t *testing.T, device CustomNetworkDevice) {
+				t.Helper()
+				require.NotNil(t, device.LinkDown)
+				assert.False(t, bool(*device.LinkDown))
+			},
+		},
+		{
+			"firewall enabled", `"virtio=BC:24:11:00:00:01,bridge=vmbr0,firewall=1"`, "firewall=1",
+			func(t *testing.T, device CustomNetworkDevice) {
+				t.Helper()
+				require.NotNil(t, device.Firewall)
+				assert.True(t, bool(*device.Firewall))
+			},
+		},
+		{
+			"firewall disabled", `"virtio=BC:24:11:00:00:01,bridge=vmbr0,firewall=0"`, "firewall=0",
+			func(t *testing.T, device CustomNetworkDevice) {
+				t.Helper()
+				require.NotNil(t, device.Firewall)
+				assert.False(t, bool(*device.Firewall))
+			},
+		},
+		{
+			"options absent", `"virtio=BC:24:11:00:00:01,bridge=vmbr0"`, "",
+			func(t *testing.T, device CustomNetworkDevice) {
+				t.Helper()
+				assert.Nil(t, device.RateLimit)
+				assert.Nil(t, device.LinkDown)
+				assert.Nil(t, device.Firewall)
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			var device CustomNetworkDevice
+			require.NoError(t, json.Unmarshal([]byte(tt.input), &device))
+			tt.check(t, device)
+
+			v := url.Values{}
+			require.NoError(t, device.EncodeValues("net0", &v))
+
+			if tt.encoded == "" {
+				for _, option := range []string{"rate", "link_down", "firewall"} {
+					assert.NotContains(t, v.Get("net0"), option)
+				}
+			} else {
+				assert.Contains(t, v.Get("net0"), tt.encoded)
+			}
+
+			var decoded CustomNetworkDevice
+			require.NoError(t, json.Unmarshal([]byte(`"`+v.Get("net0")+`"`), &decoded))
+			tt.check(t, decoded)
+		})
+	}
+}
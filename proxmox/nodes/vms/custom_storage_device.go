@@ -11,6 +11,7 @@ import (
 	"fmt"
 	"net/url"
 	"path/filepath"
+	"strconv"
 	"strings"
 
 	"github.com/bpg/terraform-provider-proxmox/proxmox/helpers/ptr"
@@ -31,8 +32,8 @@ type CustomStorageDevice struct {
 
 	AIO                     *string           `json:"aio,omitempty"         url:"aio,omitempty"`
 	Backup                  *types.CustomBool `json:"backup,omitempty"      url:"backup,omitempty,int"`
-	BurstableReadSpeedMbps  *int              `json:"mbps_rd_max,omitempty" url:"mbps_rd_max,omitempty"`
-	BurstableWriteSpeedMbps *int              `json:"mbps_wr_max,omitempty" url:"mbps_wr_max,omitempty"`
+	BurstableReadSpeedMbps  *float64          `json:"mbps_rd_max,omitempty" url:"mbps_rd_max,omitempty"`
+	BurstableWriteSpeedMbps *float64          `json:"mbps_wr_max,omitempty" url:"mbps_wr_max,omitempty"`
 	Cache                   *string           `json:"cache,omitempty"       url:"cache,omitempty"`
 	Discard                 *string           `json:"discard,omitempty"     url:"discard,omitempty"`
 	ImportFrom              *string           `json:"import_from,omitempty" url:"import_from,omitempty"`
@@ -42,8 +43,8 @@ type CustomStorageDevice struct {
 	IOThread                *types.CustomBool `json:"iothread,omitempty"    url:"iothread,omitempty,int"`
 	MaxIopsRead             *int              `json:"iops_rd_max,omitempty" url:"iops_rd_max,omitempty"`
 	MaxIopsWrite            *int              `json:"iops_wr_max,omitempty" url:"iops_wr_max,omitempty"`
-	MaxReadSpeedMbps        *int              `json:"mbps_rd,omitempty"     url:"mbps_rd,omitempty"`
-	MaxWriteSpeedMbps       *int              `json:"mbps_wr,omitempty"     url:"mbps_wr,omitempty"`
+	MaxReadSpeedMbps        *float64          `json:"mbps_rd,omitempty"     url:"mbps_rd,omitempty"`
+	MaxWriteSpeedMbps       *float64          `json:"mbps_wr,omitempty"     url:"mbps_wr,omitempty"`
 	Media                   *string           `json:"media,omitempty"       url:"media,omitempty"`
 	Queues                  *int              `json:"queues,omitempty"      url:"queues,omitempty"`
 	Replicate               *types.CustomBool `json:"replicate,omitempty"   url:"replicate,omitempty,int"`
@@ -179,19 +180,19 @@ func (d *CustomStorageDevice) EncodeOptions() string {
 	}
 
 	if d.BurstableReadSpeedMbps != nil {
-		values = append(values, fmt.Sprintf("mbps_rd_max=%d", *d.BurstableReadSpeedMbps))
+		values = append(values, "mbps_rd_max="+strconv.FormatFloat(*d.BurstableReadSpeedMbps, 'f', -1, 64))
 	}
 
 	if d.BurstableWriteSpeedMbps != nil {
-		values = append(values, fmt.Sprintf("mbps_wr_max=%d", *d.BurstableWriteSpeedMbps))
+		values = append(values, "mbps_wr_max="+strconv.FormatFloat(*d.BurstableWriteSpeedMbps, 'f', -1, 64))
 	}
 
 	if d.MaxReadSpeedMbps != nil {
-		values = append(values, fmt.Sprintf("mbps_rd=%d", *d.MaxReadSpeedMbps))
+		values = append(values, "mbps_rd="+strconv.FormatFloat(*d.MaxReadSpeedMbps, 'f', -1, 64))
 	}
 
 	if d.MaxWriteSpeedMbps != nil {
-		values = append(values, fmt.Sprintf("mbps_wr=%d", *d.MaxWriteSpeedMbps))
+		values = append(values, "mbps_wr="+strconv.FormatFloat(*d.MaxWriteSpeedMbps, 'f', -1, 64))
 	}
 
 	if d.Replicate != nil {
@@ -304,19 +305,19 @@ func (d *CustomStorageDevice) UnmarshalJSON(b []byte) error {
 				d.IOThread = types.CustomBool(v[1] == "1").Pointer()
 
 			case "mbps_rd":
-				if d.MaxReadSpeedMbps, err = ptr.ParseIntPtr(v[1], "mbps_rd"); err != nil {
+				if d.MaxReadSpeedMbps, err = ptr.ParseFloat64Ptr(v[1], "mbps_rd"); err != nil {
 					return err
 				}
 			case "mbps_rd_max":
-				if d.BurstableReadSpeedMbps, err = ptr.ParseIntPtr(v[1], "mbps_rd_max"); err != nil {
+				if d.BurstableReadSpeedMbps, err = ptr.ParseFloat64Ptr(v[1], "mbps_rd_max"); err != nil {
 					return err
 				}
 			case "mbps_wr":
-				if d.MaxWriteSpeedMbps, err = ptr.ParseIntPtr(v[1], "mbps_wr"); err != nil {
+				if d.MaxWriteSpeedMbps, err = ptr.ParseFloat64Ptr(v[1], "mbps_wr"); err != nil {
 					return err
 				}
 			case "mbps_wr_max":
-				if d.BurstableWriteSpeedMbps, err = ptr.ParseIntPtr(v[1], "mbps_wr_max"); err != nil {
+				if d.BurstableWriteSpeedMbps, err = ptr.ParseFloat64Ptr(v[1], "mbps_wr_max"); err != nil {
 					return err
 				}
 			case "media":
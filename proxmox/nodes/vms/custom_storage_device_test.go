@@ -71,6 +71,17 @@ func TestCustomStorageDevice_UnmarshalJSON(t *testing.T) {
 				Size:       ds8gig,
 			},
 		},
+		{
+			name: "volume with speed limits",
+			line: `"local-lvm:vm-2041-disk-0,iops_rd=100,mbps_rd=12.5,mbps_wr_max=100,size=8G"`,
+			want: &CustomStorageDevice{
+				BurstableWriteSpeedMbps: new(100.0),
+				FileVolume:              "local-lvm:vm-2041-disk-0",
+				IopsRead:                new(100),
+				MaxReadSpeedMbps:        new(12.5),
+				Size:                    ds8gig,
+			},
+		},
 	}
 
 	for _, tt := range tests {
@@ -140,3 +151,15 @@ func TestCustomStorageDevice_IsCloudInitDrive(t *testing.T) {
 		})
 	}
 }
+
+func TestCustomStorageDevice_EncodeOptionsSpeedLimits(t *testing.T) {
+	t.Parallel()
+
+	d := &CustomStorageDevice{
+		BurstableReadSpeedMbps: new(100.0),
+		MaxReadSpeedMbps:       new(12.5),
+		MaxWriteSpeedMbps:      new(0.25),
+	}
+
+	require.Equal(t, "mbps_rd_max=100,mbps_rd=12.5,mbps_wr=0.25", d.EncodeOptions())
+}
@@ -21,6 +21,7 @@ import (
 	"github.com/bpg/terraform-provider-proxmox/proxmox/api"
 	"github.com/bpg/terraform-provider-proxmox/proxmox/nodes/tasks"
 	"github.com/bpg/terraform-provider-proxmox/proxmox/retry"
+	"github.com/bpg/terraform-provider-proxmox/proxmox/types"
 	"github.com/bpg/terraform-provider-proxmox/utils/ip"
 )
 
@@ -171,6 +172,77 @@ func (c *Client) GetVMNetworkInterfacesFromAgent(ctx context.Context) (*GetQEMUN
 	return resBody.Data, nil
 }
 
+// AgentNetworkInterfacesTimeout bounds the request made by GetAgentNetworkInterfaces, as an unresponsive agent
+// blocks until PVE gives up.
+const AgentNetworkInterfacesTimeout = 15 * time.Second
+
+// ErrAgentNotEnabled is returned by GetAgentNetworkInterfaces when the QEMU guest agent is disabled for the VM.
+var ErrAgentNotEnabled = errors.New("the QEMU guest agent is not enabled for the VM")
+
+// GetAgentNetworkInterfaces reads the network interfaces reported by the QEMU guest agent of the VM described by
+// status and config. Templates never run an agent, so an empty list is returned for them. An error explains why the
+// interfaces are not available: the agent is disabled, the VM is not running, or the agent does not answer
+// within AgentNetworkInterfacesTimeout.
+func (c *Client) GetAgentNetworkInterfaces(
+	ctx context.Context,
+	status *GetStatusResponseData,
+	config *GetResponseData,
+) ([]AgentNetworkInterface, error) {
+	if config.Template != nil && bool(*config.Template) {
+		return []AgentNetworkInterface{}, nil
+	}
+
+	if config.Agent == nil || config.Agent.Enabled == nil || !bool(*config.Agent.Enabled) {
+		return []AgentNetworkInterface{}, ErrAgentNotEnabled
+	}
+
+	if status.Status != "running" {
+		return []AgentNetworkInterface{}, fmt.Errorf("the VM is %s", status.Status)
+	}
+
+	agentCtx, cancel := context.WithTimeout(ctx, AgentNetworkInterfacesTimeout)
+	defer cancel()
+
+	data, err := c.GetVMNetworkInterfacesFromAgent(agentCtx)
+	if err != nil {
+		return []AgentNetworkInterface{}, fmt.Errorf(
+			"unable to read the network interfaces from the agent, it may not be running: %w", err,
+		)
+	}
+
+	return FlattenAgentNetworkInterfaces(data), nil
+}
+
+// FlattenAgentNetworkInterfaces converts the agent response to a list of interfaces without the optional fields.
+func FlattenAgentNetworkInterfaces(data *GetQEMUNetworkInterfacesResponseData) []AgentNetworkInterface {
+	interfaces := []AgentNetworkInterface{}
+
+	if data == nil || data.Result == nil {
+		return interfaces
+	}
+
+	for _, iface := range *data.Result {
+		addresses := []AgentNetworkInterfaceIPAddress{}
+
+		if iface.IPAddresses != nil {
+			for _, ip := range *iface.IPAddresses {
+				addresses = append(addresses, AgentNetworkInterfaceIPAddress{
+					Address: ip.Address,
+					Prefix:  ip.Prefix,
+				})
+			}
+		}
+
+		interfaces = append(interfaces, AgentNetworkInterface{
+			Name:        iface.Name,
+			MACAddress:  iface.MACAddress,
+			IPAddresses: addresses,
+		})
+	}
+
+	return interfaces
+}
+
 // GetVMStatus retrieves the status for a virtual machine.
 func (c *Client) GetVMStatus(ctx context.Context) (*GetStatusResponseData, error) {
 	resBody := &GetStatusResponseBody{}
@@ -319,6 +391,32 @@ func (c *Client) RebootVMAsync(ctx context.Context, d *RebootRequestBody) (*stri
 	return resBody.Data, nil
 }
 
+// ResetVM hard-resets a virtual machine, without giving the guest a chance to shut down.
+func (c *Client) ResetVM(ctx context.Context) tasks.TaskResult {
+	taskID, err := c.ResetVMAsync(ctx)
+	if err != nil {
+		return tasks.TaskFailed(err)
+	}
+
+	return c.Tasks().WaitForTask(ctx, *taskID)
+}
+
+// ResetVMAsync hard-resets a virtual machine asynchronously.
+func (c *Client) ResetVMAsync(ctx context.Context) (*string, error) {
+	resBody := &ResetResponseBody{}
+
+	err := c.DoRequest(ctx, http.MethodPost, c.ExpandPath("status/reset"), nil, resBody)
+	if err != nil {
+		return nil, fmt.Errorf("error resetting VM: %w", err)
+	}
+
+	if resBody.Data == nil {
+		return nil, api.ErrNoDataObjectInResponse
+	}
+
+	return resBody.Data, nil
+}
+
 // ResizeVMDisk resizes a virtual machine disk.
 func (c *Client) ResizeVMDisk(ctx context.Context, d *ResizeDiskRequestBody) tasks.TaskResult {
 	// Retry wraps the entire operation (dispatch + wait) because "does not exist"
@@ -392,6 +490,68 @@ func (c *Client) ShutdownVMAsync(ctx context.Context, d *ShutdownRequestBody) (*
 	return resBody.Data, nil
 }
 
+// ShutdownOrStopVM gracefully shuts down a virtual machine, waiting up to shutdownTimeout for it to power off.
+// When the guest does not power off in time, the VM is forcefully stopped, waiting up to stopTimeout.
+// Other shutdown failures, e.g. missing permissions or a locked VM, are returned without stopping the VM.
+func (c *Client) ShutdownOrStopVM(ctx context.Context, shutdownTimeout, stopTimeout time.Duration) tasks.TaskResult {
+	forceStop := types.CustomBool(true)
+	shutdownTimeoutSec := int(shutdownTimeout.Seconds())
+
+	shutdownCtx, cancel := context.WithTimeout(ctx, shutdownTimeout)
+	defer cancel()
+
+	result := c.ShutdownVM(shutdownCtx, &ShutdownRequestBody{
+		ForceStop: &forceStop,
+		Timeout:   &shutdownTimeoutSec,
+	})
+
+	err := result.Err()
+	if err == nil {
+		err = c.WaitForVMStatus(shutdownCtx, "stopped")
+		if err == nil {
+			return result
+		}
+	}
+
+	if ctx.Err() != nil {
+		return tasks.TaskFailed(fmt.Errorf("error shutting down VM %d: %w", c.VMID, ctx.Err()))
+	}
+
+	if shutdownCtx.Err() == nil && !strings.Contains(err.Error(), "timeout") {
+		return tasks.TaskFailedWithWarnings(fmt.Errorf("error shutting down VM %d: %w", c.VMID, err), result.Warnings())
+	}
+
+	status, statusErr := c.GetVMStatus(ctx)
+	if statusErr != nil {
+		return tasks.TaskFailed(fmt.Errorf("error shutting down VM %d: %w", c.VMID, statusErr))
+	}
+
+	if status.Status == "stopped" {
+		return tasks.TaskOKWithWarnings(result.Warnings())
+	}
+
+	tflog.Warn(ctx, "VM did not shut down gracefully in time, stopping it", map[string]any{
+		"vm_id":   c.VMID,
+		"timeout": shutdownTimeout.String(),
+	})
+
+	stopCtx, stopCancel := context.WithTimeout(ctx, stopTimeout)
+	defer stopCancel()
+
+	overrule := types.CustomBool(true)
+
+	stopResult := c.stopVM(stopCtx, &StopRequestBody{OverruleShutdown: &overrule})
+	if stopResult.Err() != nil {
+		return stopResult
+	}
+
+	if err := c.WaitForVMStatus(stopCtx, "stopped"); err != nil {
+		return tasks.TaskFailedWithWarnings(err, stopResult.Warnings())
+	}
+
+	return stopResult
+}
+
 // StartVM starts a virtual machine.
 // The returned TaskResult carries any warnings from the task log, or an error if the dispatch or task fails.
 func (c *Client) StartVM(ctx context.Context, timeoutSec int) tasks.TaskResult {
@@ -447,7 +607,11 @@ func (c *Client) StartVMAsync(ctx context.Context, timeoutSec int) (*string, err
 
 // StopVM stops a virtual machine.
 func (c *Client) StopVM(ctx context.Context) tasks.TaskResult {
-	taskID, err := c.StopVMAsync(ctx)
+	return c.stopVM(ctx, nil)
+}
+
+func (c *Client) stopVM(ctx context.Context, d *StopRequestBody) tasks.TaskResult {
+	taskID, err := c.stopVMAsync(ctx, d)
 	if err != nil {
 		return tasks.TaskFailed(err)
 	}
@@ -457,9 +621,13 @@ func (c *Client) StopVM(ctx context.Context) tasks.TaskResult {
 
 // StopVMAsync stops a virtual machine asynchronously.
 func (c *Client) StopVMAsync(ctx context.Context) (*string, error) {
+	return c.stopVMAsync(ctx, nil)
+}
+
+func (c *Client) stopVMAsync(ctx context.Context, d *StopRequestBody) (*string, error) {
 	resBody := &StopResponseBody{}
 
-	err := c.DoRequest(ctx, http.MethodPost, c.ExpandPath("status/stop"), nil, resBody)
+	err := c.DoRequest(ctx, http.MethodPost, c.ExpandPath("status/stop"), d, resBody)
 	if err != nil {
 		return nil, fmt.Errorf("error stopping VM: %w", err)
 	}
@@ -471,6 +639,16 @@ func (c *Client) StopVMAsync(ctx context.Context) (*string, error) {
 	return resBody.Data, nil
 }
 
+// UnlinkVMDisks detaches the given disks from a virtual machine and, when force is set, destroys the volumes.
+func (c *Client) UnlinkVMDisks(ctx context.Context, d *UnlinkDisksRequestBody) error {
+	err := c.DoRequest(ctx, http.MethodPut, c.ExpandPath("unlink"), d, nil)
+	if err != nil {
+		return fmt.Errorf("error unlinking VM disks: %w", err)
+	}
+
+	return nil
+}
+
 // UpdateVM updates a virtual machine.
 func (c *Client) UpdateVM(ctx context.Context, d *UpdateRequestBody) error {
 	op := retry.NewAPICallOperation("VM config update",
@@ -11,8 +11,10 @@ import (
 	"testing"
 
 	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
 
 	"github.com/bpg/terraform-provider-proxmox/proxmox/api"
+	"github.com/bpg/terraform-provider-proxmox/proxmox/types"
 )
 
 func TestIsAgentNotReadyError(t *testing.T) {
@@ -107,3 +109,87 @@ func TestIsAgentNotReadyError(t *testing.T) {
 		})
 	}
 }
+
+func TestGetAgentNetworkInterfacesUnavailable(t *testing.T) {
+	t.Parallel()
+
+	enabled := types.CustomBool(true)
+	disabled := types.CustomBool(false)
+
+	tests := []struct {
+		name    string
+		status  string
+		config  *GetResponseData
+		wantErr string
+	}{
+		{
+			name:   "template",
+			status: "stopped",
+			config: &GetResponseData{Template: &enabled, Agent: &CustomAgent{Enabled: &enabled}},
+		},
+		{
+			name:    "agent not configured",
+			status:  "running",
+			config:  &GetResponseData{},
+			wantErr: ErrAgentNotEnabled.Error(),
+		},
+		{
+			name:    "agent disabled",
+			status:  "running",
+			config:  &GetResponseData{Agent: &CustomAgent{Enabled: &disabled}},
+			wantErr: ErrAgentNotEnabled.Error(),
+		},
+		{
+			name:    "vm stopped",
+			status:  "stopped",
+			config:  &GetResponseData{Agent: &CustomAgent{Enabled: &enabled}},
+			wantErr: "the VM is stopped",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			c := &Client{}
+
+			interfaces, err := c.GetAgentNetworkInterfaces(t.Context(), &GetStatusResponseData{Status: tt.status}, tt.config)
+			assert.Empty(t, interfaces)
+
+			if tt.wantErr == "" {
+				require.NoError(t, err)
+			} else {
+				require.EqualError(t, err, tt.wantErr)
+			}
+		})
+	}
+}
+
+func TestFlattenAgentNetworkInterfaces(t *testing.T) {
+	t.Parallel()
+
+	assert.Empty(t, FlattenAgentNetworkInterfaces(nil))
+	assert.Empty(t, FlattenAgentNetworkInterfaces(&GetQEMUNetworkInterfacesResponseData{}))
+
+	data := &GetQEMUNetworkInterfacesResponseData{
+		Result: &[]GetQEMUNetworkInterfacesResponseResult{
+			{Name: "lo", MACAddress: "00:00:00:00:00:00"},
+			{
+				Name:       "eth0",
+				MACAddress: "bc:24:11:00:00:01",
+				IPAddresses: &[]GetQEMUNetworkInterfacesResponseResultIPAddress{
+					{Address: "192.168.1.10", Prefix: 24, Type: "ipv4"},
+				},
+			},
+		},
+	}
+
+	assert.Equal(t, []AgentNetworkInterface{
+		{Name: "lo", MACAddress: "00:00:00:00:00:00", IPAddresses: []AgentNetworkInterfaceIPAddress{}},
+		{
+			Name:        "eth0",
+			MACAddress:  "bc:24:11:00:00:01",
+			IPAddresses: []AgentNetworkInterfaceIPAddress{{Address: "192.168.1.10", Prefix: 24}},
+		},
+	}, FlattenAgentNetworkInterfaces(data))
+}
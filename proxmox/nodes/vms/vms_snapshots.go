@@ -0,0 +1,147 @@
+/*
+ * This Source Code Form is subject to the terms of the Mozilla Public
+ * License, v. 2.0. If a copy of the MPL was not distributed with this
+ * file, You can obtain one at https://mozilla.org/MPL/2.0/.
+ */
+
+package vms
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/http"
+	"net/url"
+
+	"github.com/bpg/terraform-provider-proxmox/proxmox/api"
+	"github.com/bpg/terraform-provider-proxmox/proxmox/nodes/tasks"
+	"github.com/bpg/terraform-provider-proxmox/proxmox/retry"
+)
+
+// CurrentSnapshotName is the name of the pseudo snapshot that represents the current state of a VM.
+const CurrentSnapshotName = "current"
+
+// snapshotPath returns the API path of a VM snapshot.
+func (c *Client) snapshotPath(name string, path string) string {
+	ep := fmt.Sprintf("snapshot/%s", url.PathEscape(name))
+	if path != "" {
+		ep = fmt.Sprintf("%s/%s", ep, path)
+	}
+
+	return c.ExpandPath(ep)
+}
+
+// CreateVMSnapshot creates a snapshot of a virtual machine and waits for the snapshot task to finish.
+func (c *Client) CreateVMSnapshot(ctx context.Context, d *SnapshotCreateRequestBody) tasks.TaskResult {
+	op := retry.NewTaskOperation("VM snapshot create",
+		retry.WithRetryIf(retry.IsTransientAPIError),
+	)
+
+	return c.Tasks().DoTask(ctx, op, func() (*string, error) {
+		resBody := &UpdateAsyncResponseBody{}
+
+		err := c.DoRequest(ctx, http.MethodPost, c.ExpandPath("snapshot"), d, resBody)
+		if err != nil {
+			return nil, fmt.Errorf("error creating VM snapshot %q: %w", d.Name, err)
+		}
+
+		if resBody.Data == nil {
+			return nil, api.ErrNoDataObjectInResponse
+		}
+
+		return resBody.Data, nil
+	})
+}
+
+// DeleteVMSnapshot deletes a snapshot of a virtual machine and waits for the snapshot task to finish.
+// Proxmox VE re-parents the children of the deleted snapshot to its parent.
+func (c *Client) DeleteVMSnapshot(ctx context.Context, name string) tasks.TaskResult {
+	op := retry.NewTaskOperation("VM snapshot delete",
+		retry.WithRetryIf(func(err error) bool {
+			return retry.IsTransientAPIError(err) && !errors.Is(err, api.ErrResourceDoesNotExist)
+		}),
+	)
+
+	return c.Tasks().DoTask(ctx, op, func() (*string, error) {
+		resBody := &UpdateAsyncResponseBody{}
+
+		err := c.DoRequest(ctx, http.MethodDelete, c.snapshotPath(name, ""), nil, resBody)
+		if err != nil {
+			return nil, fmt.Errorf("error deleting VM snapshot %q: %w", name, err)
+		}
+
+		if resBody.Data == nil {
+			return nil, api.ErrNoDataObjectInResponse
+		}
+
+		return resBody.Data, nil
+	})
+}
+
+// GetVMSnapshot retrieves a snapshot of a virtual machine from the snapshot list.
+// It returns api.ErrResourceDoesNotExist when the VM has no snapshot with the given name.
+func (c *Client) GetVMSnapshot(ctx context.Context, name string) (*SnapshotListResponseData, error) {
+	snapshots, err := c.ListVMSnapshots(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	for _, s := range snapshots {
+		if s != nil && s.Name == name {
+			return s, nil
+		}
+	}
+
+	return nil, fmt.Errorf("VM snapshot %q: %w", name, api.ErrResourceDoesNotExist)
+}
+
+// ListVMSnapshots retrieves the snapshots of a virtual machine, including the "current" pseudo snapshot.
+func (c *Client) ListVMSnapshots(ctx context.Context) ([]*SnapshotListResponseData, error) {
+	resBody := &SnapshotListResponseBody{}
+
+	err := c.DoRequest(ctx, http.MethodGet, c.ExpandPath("snapshot"), nil, resBody)
+	if err != nil {
+		return nil, fmt.Errorf("error listing VM snapshots: %w", err)
+	}
+
+	if resBody.Data == nil {
+		return nil, api.ErrNoDataObjectInResponse
+	}
+
+	return resBody.Data, nil
+}
+
+// RollbackVMSnapshot rolls a virtual machine back to a snapshot and waits for the rollback task to finish.
+// All changes made to the VM since the snapshot was taken are discarded.
+func (c *Client) RollbackVMSnapshot(ctx context.Context, name string, d *SnapshotRollbackRequestBody) tasks.TaskResult {
+	op := retry.NewTaskOperation("VM snapshot rollback",
+		retry.WithRetryIf(func(err error) bool {
+			return retry.IsTransientAPIError(err) && !errors.Is(err, api.ErrResourceDoesNotExist)
+		}),
+	)
+
+	return c.Tasks().DoTask(ctx, op, func() (*string, error) {
+		resBody := &UpdateAsyncResponseBody{}
+
+		err := c.DoRequest(ctx, http.MethodPost, c.snapshotPath(name, "rollback"), d, resBody)
+		if err != nil {
+			return nil, fmt.Errorf("error rolling back VM to snapshot %q: %w", name, err)
+		}
+
+		if resBody.Data == nil {
+			return nil, api.ErrNoDataObjectInResponse
+		}
+
+		return resBody.Data, nil
+	})
+}
+
+// UpdateVMSnapshot updates the description of a snapshot of a virtual machine.
+func (c *Client) UpdateVMSnapshot(ctx context.Context, name string, d *SnapshotUpdateRequestBody) error {
+	err := c.DoRequest(ctx, http.MethodPut, c.snapshotPath(name, "config"), d, nil)
+	if err != nil {
+		return fmt.Errorf("error updating VM snapshot %q: %w", name, err)
+	}
+
+	return nil
+}
@@ -0,0 +1,183 @@
+/*
+ * This Source Code Form is subject to the terms of the Mozilla Public
+ * License, v. 2.0. If a copy of the MPL was not distributed with this
+ * file, You can obtain one at https://mozilla.org/MPL/2.0/.
+ */
+
+package vms
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/bpg/terraform-provider-proxmox/proxmox/api"
+)
+
+const (
+	testShutdownUPID = "UPID:pve:00001234:00005678:AABBCCDD:qmshutdown:100:root@pam:"
+	testStopUPID     = "UPID:pve:00001235:00005679:AABBCCDE:qmstop:100:root@pam:"
+)
+
+// shutdownTestServer simulates a VM that either powers off on shutdown, ignores it
+// until it is forcefully stopped, or rejects the shutdown request.
+type shutdownTestServer struct {
+	ignoreShutdown bool
+	// shutdownError makes the shutdown request fail with the given HTTP status and message.
+	shutdownError     int
+	shutdownErrorText string
+
+	stopped   atomic.Bool
+	stops     atomic.Int32
+	overruled atomic.Bool
+}
+
+func (s *shutdownTestServer) handler(t *testing.T) http.Handler {
+	t.Helper()
+
+	writeJSON := func(w http.ResponseWriter, v any) {
+		w.Header().Set("Content-Type", "application/json")
+		assert.NoError(t, json.NewEncoder(w).Encode(v))
+	}
+
+	taskStatus := func(done func() bool) http.HandlerFunc {
+		return func(w http.ResponseWriter, _ *http.Request) {
+			if !done() {
+				writeJSON(w, map[string]any{"data": map[string]any{"status": "running"}})
+				return
+			}
+
+			writeJSON(w, map[string]any{"data": map[string]any{"status": "stopped", "exitstatus": "OK"}})
+		}
+	}
+
+	mux := http.NewServeMux()
+	mux.HandleFunc("POST /api2/json/qemu/100/status/shutdown", func(w http.ResponseWriter, _ *http.Request) {
+		if s.shutdownError != 0 {
+			http.Error(w, s.shutdownErrorText, s.shutdownError)
+			return
+		}
+
+		if !s.ignoreShutdown {
+			s.stopped.Store(true)
+		}
+
+		writeJSON(w, map[string]any{"data": testShutdownUPID})
+	})
+	mux.HandleFunc("POST /api2/json/qemu/100/status/stop", func(w http.ResponseWriter, r *http.Request) {
+		assert.NoError(t, r.ParseForm())
+
+		s.stops.Add(1)
+		s.overruled.Store(r.FormValue("overrule-shutdown") == "1")
+		s.stopped.Store(true)
+
+		writeJSON(w, map[string]any{"data": testStopUPID})
+	})
+	mux.HandleFunc("GET /api2/json/qemu/100/status/current", func(w http.ResponseWriter, _ *http.Request) {
+		status := "running"
+		if s.stopped.Load() {
+			status = "stopped"
+		}
+
+		writeJSON(w, map[string]any{"data": map[string]any{"status": status, "vmid": 100}})
+	})
+	mux.HandleFunc("GET /api2/json/nodes/pve/tasks/"+testShutdownUPID+"/status", taskStatus(func() bool {
+		return !s.ignoreShutdown
+	}))
+	mux.HandleFunc("GET /api2/json/nodes/pve/tasks/"+testStopUPID+"/status", taskStatus(func() bool {
+		return true
+	}))
+
+	return mux
+}
+
+func newShutdownTestClient(t *testing.T, s *shutdownTestServer) *Client {
+	t.Helper()
+
+	server := httptest.NewTLSServer(s.handler(t))
+	t.Cleanup(server.Close)
+
+	conn, err := api.NewConnection(server.URL, true, "")
+	require.NoError(t, err)
+
+	creds, err := api.NewCredentials("", "", "", "user@pve!token=test", "", "")
+	require.NoError(t, err)
+
+	c, err := api.NewClient(creds, conn)
+	require.NoError(t, err)
+
+	return &Client{Client: c, VMID: 100}
+}
+
+func TestShutdownOrStopVMShutsDownGracefully(t *testing.T) {
+	t.Parallel()
+
+	s := &shutdownTestServer{}
+	client := newShutdownTestClient(t, s)
+
+	result := client.ShutdownOrStopVM(t.Context(), 10*time.Second, 10*time.Second)
+	require.NoError(t, result.Err())
+	assert.Zero(t, s.stops.Load(), "a VM that shuts down gracefully must not be stopped")
+}
+
+func TestShutdownOrStopVMStopsAfterTimeout(t *testing.T) {
+	t.Parallel()
+
+	s := &shutdownTestServer{ignoreShutdown: true}
+	client := newShutdownTestClient(t, s)
+
+	start := time.Now()
+
+	result := client.ShutdownOrStopVM(t.Context(), time.Second, 10*time.Second)
+	require.NoError(t, result.Err())
+	assert.Equal(t, int32(1), s.stops.Load(), "the VM must be stopped once the shutdown times out")
+	assert.True(t, s.overruled.Load(), "the stop must overrule the pending shutdown task")
+	assert.GreaterOrEqual(t, time.Since(start), time.Second, "the stop must not be issued before the shutdown timeout")
+}
+
+func TestShutdownOrStopVMHonorsCancellation(t *testing.T) {
+	t.Parallel()
+
+	s := &shutdownTestServer{ignoreShutdown: true}
+	client := newShutdownTestClient(t, s)
+
+	ctx, cancel := context.WithTimeout(t.Context(), 500*time.Millisecond)
+	defer cancel()
+
+	result := client.ShutdownOrStopVM(ctx, 10*time.Second, 10*time.Second)
+	require.Error(t, result.Err())
+	assert.Zero(t, s.stops.Load(), "a cancelled shutdown must not fall back to a stop")
+}
+
+func TestShutdownOrStopVMDoesNotStopOnOtherErrors(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name   string
+		status int
+		text   string
+	}{
+		{"permission denied", http.StatusForbidden, "Permission check failed (/vms/100, VM.PowerMgmt)"},
+		{"vm locked", http.StatusInternalServerError, "VM is locked (backup)"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			s := &shutdownTestServer{shutdownError: tt.status, shutdownErrorText: tt.text}
+			client := newShutdownTestClient(t, s)
+
+			result := client.ShutdownOrStopVM(t.Context(), 10*time.Second, 10*time.Second)
+			require.Error(t, result.Err())
+			assert.Zero(t, s.stops.Load(), "a shutdown that fails for another reason than a timeout must not be stopped")
+		})
+	}
+}
@@ -103,6 +103,7 @@ type CreateRequestBody struct {
 	Overwrite            *types.CustomBool              `json:"force,omitempty"              url:"force,omitempty,int"`
 	PCIDevices           CustomPCIDevices               `json:"hostpci,omitempty"            url:"hostpci,omitempty"`
 	PoolID               *string                        `json:"pool,omitempty"               url:"pool,omitempty"`
+	RestoreStorage       *string                        `json:"storage,omitempty"            url:"storage,omitempty"`
 	Revert               *string                        `json:"revert,omitempty"             url:"revert,omitempty"`
 	RNGDevice            *CustomRNGDevice               `json:"rng0,omitempty"               url:"rng0,omitempty"`
 	SCSIHardware         *string                        `json:"scsihw,omitempty"             url:"scsihw,omitempty"`
@@ -119,6 +120,7 @@ type CreateRequestBody struct {
 	Template             *types.CustomBool              `json:"template,omitempty"           url:"template,omitempty,int"`
 	TimeDriftFixEnabled  *types.CustomBool              `json:"tdf,omitempty"                url:"tdf,omitempty,int"`
 	TPMState             *CustomTPMState                `json:"tpmstate0,omitempty"          url:"tpmstate0,omitempty"`
+	Unique               *types.CustomBool              `json:"unique,omitempty"             url:"unique,omitempty,int"`
 	USBDevices           CustomUSBDevices               `json:"usb,omitempty"                url:"usb,omitempty"`
 	VGADevice            *CustomVGADevice               `json:"vga,omitempty"                url:"vga,omitempty"`
 	VirtualCPUCount      *int64                         `json:"vcpus,omitempty"              url:"vcpus,omitempty"`
@@ -154,6 +156,19 @@ type DeleteResponseBody struct {
 	TaskID *string `json:"data,omitempty"`
 }
 
+// AgentNetworkInterface is a network interface reported by the QEMU guest agent.
+type AgentNetworkInterface struct {
+	Name        string
+	MACAddress  string
+	IPAddresses []AgentNetworkInterfaceIPAddress
+}
+
+// AgentNetworkInterfaceIPAddress is an IP address of a network interface reported by the QEMU guest agent.
+type AgentNetworkInterfaceIPAddress struct {
+	Address string
+	Prefix  int
+}
+
 // GetQEMUNetworkInterfacesResponseBody contains the body from a QEMU get network interfaces response.
 type GetQEMUNetworkInterfacesResponseBody struct {
 	Data *GetQEMUNetworkInterfacesResponseData `json:"data,omitempty"`
@@ -329,6 +344,7 @@ type ListResponseData struct {
 
 // MigrateRequestBody contains the body for a VM migration request.
 type MigrateRequestBody struct {
+	BandwidthLimit  *int              `json:"bwlimit,omitempty"          url:"bwlimit,omitempty"`
 	OnlineMigration *types.CustomBool `json:"online,omitempty"           url:"online,omitempty,int"`
 	TargetNode      string            `json:"target"                     url:"target"`
 	TargetStorage   *string           `json:"targetstorage,omitempty"    url:"targetstorage,omitempty"`
@@ -365,6 +381,11 @@ type RebootResponseBody struct {
 	Data *string `json:"data,omitempty"`
 }
 
+// ResetResponseBody contains the body from a VM reset response.
+type ResetResponseBody struct {
+	Data *string `json:"data,omitempty"`
+}
+
 // ResizeDiskRequestBody contains the body for a VM resize disk request.
 type ResizeDiskRequestBody struct {
 	Digest   *string           `json:"digest,omitempty"   url:"digest,omitempty"`
@@ -386,6 +407,39 @@ type ShutdownResponseBody struct {
 	Data *string `json:"data,omitempty"`
 }
 
+// SnapshotCreateRequestBody contains the body for a VM snapshot create request.
+type SnapshotCreateRequestBody struct {
+	Name        string            `json:"snapname"              url:"snapname"`
+	Description *string           `json:"description,omitempty" url:"description,omitempty"`
+	VMState     *types.CustomBool `json:"vmstate,omitempty"     url:"vmstate,omitempty,int"`
+}
+
+// SnapshotListResponseBody contains the body from a VM snapshot list response.
+type SnapshotListResponseBody struct {
+	Data []*SnapshotListResponseData `json:"data,omitempty"`
+}
+
+// SnapshotListResponseData contains the data of a VM snapshot list entry.
+// The list always contains an entry named "current" for the current state of the VM.
+type SnapshotListResponseData struct {
+	Name        string            `json:"name"`
+	Description *string           `json:"description,omitempty"`
+	Parent      *string           `json:"parent,omitempty"`
+	SnapTime    *int64            `json:"snaptime,omitempty"`
+	VMState     *types.CustomBool `json:"vmstate,omitempty"`
+}
+
+// SnapshotRollbackRequestBody contains the body for a VM snapshot rollback request.
+type SnapshotRollbackRequestBody struct {
+	Start *types.CustomBool `json:"start,omitempty" url:"start,omitempty,int"`
+}
+
+// SnapshotUpdateRequestBody contains the body for a VM snapshot update request.
+// An empty description clears the existing one.
+type SnapshotUpdateRequestBody struct {
+	Description string `json:"description" url:"description"`
+}
+
 // StartRequestBody contains the body for a VM start request.
 type StartRequestBody struct {
 	ForceCPU         *string           `json:"force-cpu,omitempty"         url:"force-cpu,omitempty"`
@@ -404,11 +458,22 @@ type StartResponseBody struct {
 	Data *string `json:"data,omitempty"`
 }
 
+// StopRequestBody contains the body for a VM stop request.
+type StopRequestBody struct {
+	OverruleShutdown *types.CustomBool `json:"overrule-shutdown,omitempty" url:"overrule-shutdown,omitempty,int"`
+}
+
 // StopResponseBody contains the body from a VM stop response.
 type StopResponseBody struct {
 	Data *string `json:"data,omitempty"`
 }
 
+// UnlinkDisksRequestBody contains the body for a VM disk unlink request.
+type UnlinkDisksRequestBody struct {
+	IDList []string          `json:"idlist"          url:"idlist,comma"`
+	Force  *types.CustomBool `json:"force,omitempty" url:"force,omitempty,int"`
+}
+
 // UpdateAsyncResponseBody contains the body from a VM async update response.
 type UpdateAsyncResponseBody struct {
 	Data *string `json:"data,omitempty"`
@@ -11,8 +11,11 @@ import (
 	"fmt"
 	"testing"
 
+	"github.com/google/go-querystring/query"
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
+
+	"github.com/bpg/terraform-provider-proxmox/proxmox/types"
 )
 
 func TestUnmarshalGetResponseData(t *testing.T) {
@@ -100,3 +103,55 @@ func assertDevice(t *testing.T, dev *CustomStorageDevice) {
 	assert.Equal(t, "8G", dev.Size.String())
 	assert.True(t, bool(*dev.SSD))
 }
+
+func TestBandwidthLimitQueryEncoding(t *testing.T) {
+	t.Parallel()
+
+	limit := 51200
+
+	tests := []struct {
+		name string
+		body any
+		want string
+	}{
+		{"clone", &CloneRequestBody{BandwidthLimit: &limit, VMIDNew: 100}, "51200"},
+		{"clone unlimited", &CloneRequestBody{VMIDNew: 100}, ""},
+		{"migrate", &MigrateRequestBody{BandwidthLimit: &limit, TargetNode: "pve2"}, "51200"},
+		{"migrate unlimited", &MigrateRequestBody{TargetNode: "pve2"}, ""},
+		{"move disk", &MoveDiskRequestBody{BandwidthLimit: &limit, Disk: "scsi0", TargetStorage: "local"}, "51200"},
+		{"move disk unlimited", &MoveDiskRequestBody{Disk: "scsi0", TargetStorage: "local"}, ""},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			values, err := query.Values(tt.body)
+			require.NoError(t, err)
+
+			assert.Equal(t, tt.want, values.Get("bwlimit"))
+			assert.Equal(t, tt.want != "", values.Has("bwlimit"))
+		})
+	}
+}
+
+func TestSnapshotRequestQueryEncoding(t *testing.T) {
+	t.Parallel()
+
+	values, err := query.Values(&SnapshotCreateRequestBody{
+		Name:    "before-upgrade",
+		VMState: types.CustomBool(true).Pointer(),
+	})
+	require.NoError(t, err)
+
+	assert.Equal(t, "before-upgrade", values.Get("snapname"))
+	assert.Equal(t, "1", values.Get("vmstate"))
+	assert.False(t, values.Has("description"))
+
+	// an empty description clears the existing one
+	values, err = query.Values(&SnapshotUpdateRequestBody{})
+	require.NoError(t, err)
+
+	assert.True(t, values.Has("description"))
+	assert.Empty(t, values.Get("description"))
+}
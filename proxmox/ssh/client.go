@@ -19,6 +19,7 @@ import (
 	"strconv"
 	"strings"
 	"sync"
+	"syscall"
 	"time"
 
 	"github.com/hashicorp/terraform-plugin-log/tflog"
@@ -30,6 +31,7 @@ import (
 	"golang.org/x/sync/singleflight"
 
 	"github.com/bpg/terraform-provider-proxmox/proxmox/api"
+	"github.com/bpg/terraform-provider-proxmox/proxmox/retry"
 	"github.com/bpg/terraform-provider-proxmox/utils"
 )
 
@@ -54,6 +56,14 @@ const (
 
 const defaultDialTimeout = 30 * time.Second
 
+const (
+	// DefaultUploadRetries is the default number of times a failed file upload is retried.
+	DefaultUploadRetries = 3
+
+	// DefaultUploadRetryDelay is the default initial delay between file upload retries.
+	DefaultUploadRetryDelay = 5 * time.Second
+)
+
 // NewErrUserHasNoPermission creates a new error indicating that the SSH user does not have required permissions.
 func NewErrUserHasNoPermission(username string) error {
 	return fmt.Errorf("the SSH user '%s' does not have required permissions. "+
@@ -89,6 +99,8 @@ type client struct {
 	socks5Username  string
 	socks5Password  string
 	nodeResolver    NodeResolver
+	uploadRetries   uint
+	uploadDelay     time.Duration
 	sudoCache       map[string]bool
 	sudoCacheMu     sync.RWMutex
 	sudoProbe       singleflight.Group
@@ -101,6 +113,7 @@ func NewClient(
 	privateKey string,
 	socks5Server string, socks5Username string, socks5Password string,
 	nodeResolver NodeResolver,
+	uploadRetries int, uploadRetryDelay time.Duration,
 ) (Client, error) {
 	if agent &&
 		runtime.GOOS != "linux" &&
@@ -121,6 +134,14 @@ func NewClient(
 		return nil, errors.New("node resolver is required")
 	}
 
+	if uploadRetries < 0 {
+		return nil, errors.New("upload retries must not be negative")
+	}
+
+	if uploadRetryDelay < 0 {
+		return nil, errors.New("upload retry delay must not be negative")
+	}
+
 	return &client{
 		username:        username,
 		password:        password,
@@ -132,6 +153,8 @@ func NewClient(
 		socks5Username:  socks5Username,
 		socks5Password:  socks5Password,
 		nodeResolver:    nodeResolver,
+		uploadRetries:   uint(uploadRetries),
+		uploadDelay:     uploadRetryDelay,
 		sudoCache:       make(map[string]bool),
 		sudoCacheMu:     sync.RWMutex{},
 	}, nil
@@ -346,6 +369,31 @@ func (c *client) NodeUpload(
 
 	fileSize := fileInfo.Size()
 
+	fileMode, err := parseUploadFileMode(d.Mode)
+	if err != nil {
+		return err
+	}
+
+	if d.ContentType != "" {
+		remoteFileDir = filepath.Join(remoteFileDir, d.ContentType)
+	}
+
+	remoteFilePath := strings.ReplaceAll(filepath.Join(remoteFileDir, d.FileName), `\`, "/")
+
+	return c.retryUpload(ctx, d, func() error {
+		return c.sftpUpload(ctx, ip, d, remoteFileDir, remoteFilePath, fileSize, fileMode)
+	})
+}
+
+func (c *client) sftpUpload(
+	ctx context.Context,
+	ip ProxmoxNode,
+	d *api.FileUploadRequest,
+	remoteFileDir string,
+	remoteFilePath string,
+	fileSize int64,
+	fileMode *os.FileMode,
+) error {
 	sshClient, err := c.openNodeShell(ctx, ip)
 	if err != nil {
 		return fmt.Errorf("failed to open SSH client: %w", err)
@@ -360,12 +408,6 @@ func (c *client) NodeUpload(
 		}
 	}(sshClient)
 
-	if d.ContentType != "" {
-		remoteFileDir = filepath.Join(remoteFileDir, d.ContentType)
-	}
-
-	remoteFilePath := strings.ReplaceAll(filepath.Join(remoteFileDir, d.FileName), `\`, "/")
-
 	sftpClient, err := sftp.NewClient(sshClient)
 	if err != nil {
 		return fmt.Errorf("failed to create SFTP client: %w", err)
@@ -390,46 +432,47 @@ func (c *client) NodeUpload(
 		return fmt.Errorf("failed to create file %s: %w", remoteFilePath, err)
 	}
 
-	defer func(remoteFile *sftp.File) {
-		e := remoteFile.Close()
-		if e != nil {
-			tflog.Warn(ctx, "failed to close remote file", map[string]any{
-				"error": e,
-			})
-		}
-	}(remoteFile)
-
 	bytesUploaded, err := remoteFile.ReadFrom(d.File)
-	if err != nil {
-		return fmt.Errorf("failed to upload file %s: %w", remoteFilePath, err)
+	if err == nil && bytesUploaded != fileSize {
+		err = fmt.Errorf("%w: uploaded %d bytes, expected %d bytes", errUploadTruncated, bytesUploaded, fileSize)
 	}
 
-	if bytesUploaded != fileSize {
-		return fmt.Errorf("failed to upload file %s: uploaded %d bytes, expected %d bytes",
-			remoteFilePath, bytesUploaded, fileSize)
+	if e := remoteFile.Close(); e != nil {
+		tflog.Warn(ctx, "failed to close remote file", map[string]any{
+			"error": e,
+		})
+	}
+
+	if err == nil {
+		err = c.checkUploadedFileChecksum(ctx, sshClient, d, remoteFilePath, "")
 	}
 
-	if d.Mode != "" {
-		parsedFileMode, parseErr := strconv.ParseUint(d.Mode, 8, 12)
-		if parseErr != nil {
-			return fmt.Errorf("failed to parse file mode %q: %w", d.Mode, parseErr)
+	if err != nil {
+		// do not leave a truncated file behind, it would be picked up as a valid upload otherwise
+		if e := sftpClient.Remove(remoteFilePath); e != nil {
+			tflog.Warn(ctx, "failed to remove partially uploaded file", map[string]any{
+				"remote_file_path": remoteFilePath,
+				"error":            e,
+			})
 		}
 
-		remoteStat, statErr := remoteFile.Stat()
+		return fmt.Errorf("failed to upload file %s: %w", remoteFilePath, err)
+	}
+
+	if fileMode != nil {
+		remoteStat, statErr := sftpClient.Stat(remoteFilePath)
 		if statErr != nil {
 			return fmt.Errorf("failed to read remote file %s: %w", remoteFilePath, statErr)
 		}
 
-		fileMode := os.FileMode(uint32(parsedFileMode))
-
-		if err = sftpClient.Chmod(remoteFilePath, fileMode); err != nil {
+		if err = sftpClient.Chmod(remoteFilePath, *fileMode); err != nil {
 			return fmt.Errorf("failed to change file mode of remote file from %#o (%s) to %#o (%s): %w",
-				remoteStat.Mode().Perm(), remoteStat.Mode(), fileMode.Perm(), fileMode, err)
+				remoteStat.Mode().Perm(), remoteStat.Mode(), fileMode.Perm(), *fileMode, err)
 		}
 
 		tflog.Debug(ctx, "changed mode of uploaded file", map[string]any{
 			"before": fmt.Sprintf("%#o (%s)", remoteStat.Mode().Perm(), remoteStat.Mode()),
-			"after":  fmt.Sprintf("%#o (%s)", fileMode.Perm(), fileMode),
+			"after":  fmt.Sprintf("%#o (%s)", fileMode.Perm(), *fileMode),
 		})
 	}
 
@@ -466,6 +509,31 @@ func (c *client) NodeStreamUpload(
 
 	fileSize := fileInfo.Size()
 
+	fileMode, err := parseUploadFileMode(d.Mode)
+	if err != nil {
+		return err
+	}
+
+	if d.ContentType != "" {
+		remoteFileDir = filepath.Join(remoteFileDir, d.ContentType)
+	}
+
+	remoteFilePath := strings.ReplaceAll(filepath.Join(remoteFileDir, d.FileName), `\`, "/")
+
+	return c.retryUpload(ctx, d, func() error {
+		return c.streamUpload(ctx, ip, nodeName, d, remoteFilePath, fileSize, fileMode)
+	})
+}
+
+func (c *client) streamUpload(
+	ctx context.Context,
+	ip ProxmoxNode,
+	nodeName string,
+	d *api.FileUploadRequest,
+	remoteFilePath string,
+	fileSize int64,
+	fileMode *os.FileMode,
+) error {
 	sshClient, err := c.openNodeShell(ctx, ip)
 	if err != nil {
 		return fmt.Errorf("failed to open SSH client: %w", err)
@@ -480,30 +548,29 @@ func (c *client) NodeStreamUpload(
 		}
 	}(sshClient)
 
-	if d.ContentType != "" {
-		remoteFileDir = filepath.Join(remoteFileDir, d.ContentType)
+	err = c.uploadFile(ctx, sshClient, d, remoteFilePath, nodeName)
+	if err == nil {
+		err = c.checkUploadedFile(ctx, sshClient, remoteFilePath, fileSize)
 	}
 
-	remoteFilePath := strings.ReplaceAll(filepath.Join(remoteFileDir, d.FileName), `\`, "/")
-
-	err = c.uploadFile(ctx, sshClient, d, remoteFilePath, nodeName)
-	if err != nil {
-		return err
+	if err == nil {
+		err = c.checkUploadedFileChecksum(ctx, sshClient, d, remoteFilePath, nodeName)
 	}
 
-	err = c.checkUploadedFile(ctx, sshClient, remoteFilePath, fileSize)
 	if err != nil {
+		// do not leave a truncated file behind, it would be picked up as a valid upload otherwise
+		if e := c.removeUploadedFile(ctx, sshClient, remoteFilePath, nodeName); e != nil {
+			tflog.Warn(ctx, "failed to remove partially uploaded file", map[string]any{
+				"remote_file_path": remoteFilePath,
+				"error":            e,
+			})
+		}
+
 		return err
 	}
 
-	if d.Mode != "" {
-		parsedFileMode, parseErr := strconv.ParseUint(d.Mode, 8, 12)
-		if parseErr != nil {
-			return fmt.Errorf("failed to parse file mode %q: %w", d.Mode, parseErr)
-		}
-
-		mode := uint32(parsedFileMode)
-		if err = c.changeModeUploadedFile(ctx, sshClient, remoteFilePath, os.FileMode(mode)); err != nil {
+	if fileMode != nil {
+		if err = c.changeModeUploadedFile(ctx, sshClient, remoteFilePath, *fileMode); err != nil {
 			return err
 		}
 	}
@@ -515,6 +582,83 @@ func (c *client) NodeStreamUpload(
 	return nil
 }
 
+// errUploadTruncated is returned when the uploaded file is shorter than the local one, e.g. after a dropped connection.
+var errUploadTruncated = errors.New("upload truncated")
+
+// retryUpload runs a single file upload attempt, retrying it with exponential backoff
+// up to the configured number of upload retries. The local file is rewound before every attempt.
+// Only transient network errors are retried, see isTransientUploadError.
+func (c *client) retryUpload(ctx context.Context, d *api.FileUploadRequest, attempt func() error) error {
+	op := retry.NewAPICallOperation("SSH file upload",
+		retry.WithAttempts(c.uploadRetries+1),
+		retry.WithBaseDelay(c.uploadDelay),
+		retry.WithRetryIf(isTransientUploadError),
+	)
+
+	return op.Do(ctx, func() error {
+		if _, err := d.File.Seek(0, io.SeekStart); err != nil {
+			return fmt.Errorf("failed to rewind file %s: %w", d.File.Name(), err)
+		}
+
+		return attempt()
+	})
+}
+
+// isTransientUploadError returns true for errors caused by an interrupted or unreachable connection, which may
+// succeed when the upload is attempted again. Errors such as missing permissions or a full datastore are not retried.
+func isTransientUploadError(err error) bool {
+	if err == nil {
+		return false
+	}
+
+	if errors.Is(err, errUploadTruncated) ||
+		errors.Is(err, io.EOF) ||
+		errors.Is(err, io.ErrUnexpectedEOF) ||
+		errors.Is(err, net.ErrClosed) ||
+		errors.Is(err, syscall.ECONNRESET) ||
+		errors.Is(err, syscall.ECONNABORTED) ||
+		errors.Is(err, syscall.ECONNREFUSED) ||
+		errors.Is(err, syscall.EPIPE) ||
+		errors.Is(err, syscall.ETIMEDOUT) ||
+		errors.Is(err, sftp.ErrSSHFxConnectionLost) {
+		return true
+	}
+
+	var opErr *net.OpError
+	if errors.As(err, &opErr) {
+		return true
+	}
+
+	var exitMissingErr *ssh.ExitMissingError
+	if errors.As(err, &exitMissingErr) {
+		return true
+	}
+
+	// the SSH and SFTP libraries do not always wrap the underlying network error
+	msg := err.Error()
+
+	return strings.Contains(msg, "connection reset by peer") ||
+		strings.Contains(msg, "broken pipe") ||
+		strings.Contains(msg, "connection lost") ||
+		strings.Contains(msg, "i/o timeout")
+}
+
+// parseUploadFileMode parses the octal file mode of an upload request, returning nil if it is not set.
+func parseUploadFileMode(mode string) (*os.FileMode, error) {
+	if mode == "" {
+		return nil, nil //nolint:nilnil
+	}
+
+	parsedFileMode, err := strconv.ParseUint(mode, 8, 12)
+	if err != nil {
+		return nil, fmt.Errorf("failed to parse file mode %q: %w", mode, err)
+	}
+
+	fileMode := os.FileMode(uint32(parsedFileMode))
+
+	return &fileMode, nil
+}
+
 func (c *client) uploadFile(
 	ctx context.Context,
 	sshClient *ssh.Client,
@@ -554,6 +698,41 @@ func (c *client) uploadFile(
 	return nil
 }
 
+func (c *client) removeUploadedFile(
+	ctx context.Context,
+	sshClient *ssh.Client,
+	remoteFilePath string,
+	nodeName string,
+) error {
+	sshSession, closer, err := c.openSession(ctx, sshClient)
+	defer closer()
+
+	if err != nil {
+		return fmt.Errorf("failed to open SSH session: %w", err)
+	}
+
+	sudoValue := c.getSudoValue(ctx, nodeName, nodeName != "")
+
+	sudoEnv := ""
+	if sudoValue != "" {
+		sudoEnv = fmt.Sprintf("export TRY_SUDO_USE_SUDO=%s; ", sudoValue)
+	}
+
+	script := fmt.Sprintf(`%s%s; try_sudo /bin/rm -f %s`, sudoEnv, TrySudo, remoteFilePath)
+	cmd := fmt.Sprintf(`/bin/bash -c '%s'`, strings.ReplaceAll(script, `'`, `'"'"'`))
+
+	output, err := sshSession.CombinedOutput(cmd)
+	if err != nil {
+		if len(output) > 0 {
+			return fmt.Errorf("error removing file: %w (output: %s)", err, string(output))
+		}
+
+		return fmt.Errorf("error removing file: %w", err)
+	}
+
+	return nil
+}
+
 func (c *client) checkUploadedFile(
 	ctx context.Context,
 	sshClient *ssh.Client,
@@ -586,8 +765,81 @@ func (c *client) checkUploadedFile(
 
 	bytesUploaded := remoteStat.Size()
 	if bytesUploaded != fileSize {
-		return fmt.Errorf("failed to upload file %s: uploaded %d bytes, expected %d bytes",
-			remoteFilePath, bytesUploaded, fileSize)
+		return fmt.Errorf("failed to upload file %s: %w: uploaded %d bytes, expected %d bytes",
+			remoteFilePath, errUploadTruncated, bytesUploaded, fileSize)
+	}
+
+	return nil
+}
+
+// ChecksumCommand returns the command computing a checksum with the given algorithm on a node, one of
+// `md5`, `sha256` or `sha512`. An empty algorithm means `sha256`.
+func ChecksumCommand(algorithm string) (string, error) {
+	switch algorithm {
+	case "", "sha256":
+		return "/usr/bin/sha256sum", nil
+	case "sha512":
+		return "/usr/bin/sha512sum", nil
+	case "md5":
+		return "/usr/bin/md5sum", nil
+	default:
+		return "", fmt.Errorf("unsupported checksum algorithm %q", algorithm)
+	}
+}
+
+// checkUploadedFileChecksum computes the checksum of the uploaded file on the node and compares it with
+// the expected checksum of the upload request. It is a no-op when the request has no checksum.
+func (c *client) checkUploadedFileChecksum(
+	ctx context.Context,
+	sshClient *ssh.Client,
+	req *api.FileUploadRequest,
+	remoteFilePath string,
+	nodeName string,
+) error {
+	if req.Checksum == "" {
+		return nil
+	}
+
+	algorithm := req.ChecksumAlgorithm
+	if algorithm == "" {
+		algorithm = "sha256"
+	}
+
+	sumCommand, err := ChecksumCommand(algorithm)
+	if err != nil {
+		return err
+	}
+
+	sshSession, closer, err := c.openSession(ctx, sshClient)
+	defer closer()
+
+	if err != nil {
+		return fmt.Errorf("failed to open SSH session: %w", err)
+	}
+
+	sudoValue := c.getSudoValue(ctx, nodeName, nodeName != "")
+
+	sudoEnv := ""
+	if sudoValue != "" {
+		sudoEnv = fmt.Sprintf("export TRY_SUDO_USE_SUDO=%s; ", sudoValue)
+	}
+
+	script := fmt.Sprintf(`%s%s; try_sudo %s %s`, sudoEnv, TrySudo, sumCommand, remoteFilePath)
+	cmd := fmt.Sprintf(`/bin/bash -c '%s'`, strings.ReplaceAll(script, `'`, `'"'"'`))
+
+	output, err := sshSession.CombinedOutput(cmd)
+	if err != nil {
+		if len(output) > 0 {
+			return fmt.Errorf("error computing checksum of file %s: %w (output: %s)", remoteFilePath, err, string(output))
+		}
+
+		return fmt.Errorf("error computing checksum of file %s: %w", remoteFilePath, err)
+	}
+
+	checksum, _, _ := strings.Cut(strings.TrimSpace(string(output)), " ")
+	if !strings.EqualFold(checksum, req.Checksum) {
+		return fmt.Errorf("the %s checksum %q of the uploaded file %s does not match the expected checksum %q",
+			algorithm, checksum, remoteFilePath, req.Checksum)
 	}
 
 	return nil
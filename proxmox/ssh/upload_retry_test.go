@@ -0,0 +1,136 @@
+/*
+ * This Source Code Form is subject to the terms of the Mozilla Public
+ * License, v. 2.0. If a copy of the MPL was not distributed with this
+ * file, You can obtain one at https://mozilla.org/MPL/2.0/.
+ */
+
+package ssh
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"os"
+	"syscall"
+	"testing"
+	"time"
+
+	"github.com/pkg/sftp"
+
+	"github.com/bpg/terraform-provider-proxmox/proxmox/api"
+)
+
+// TestRetryUploadRewindsFile verifies that every upload attempt reads the local
+// file from the beginning, and that attempts stop as soon as one succeeds.
+func TestRetryUploadRewindsFile(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name         string
+		retries      uint
+		failures     int
+		failure      error
+		wantAttempts int
+		wantErr      bool
+	}{
+		{name: "succeeds first time", retries: 3, failures: 0, wantAttempts: 1},
+		{name: "succeeds after retries", retries: 3, failures: 2, wantAttempts: 3},
+		{name: "retries exhausted", retries: 2, failures: 5, wantAttempts: 3, wantErr: true},
+		{name: "retries disabled", retries: 0, failures: 1, wantAttempts: 1, wantErr: true},
+		{
+			name: "permanent error not retried", retries: 3, failures: 5,
+			failure: errors.New("permission denied"), wantAttempts: 1, wantErr: true,
+		},
+	}
+
+	const content = "large-image-content"
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			tmp, err := os.CreateTemp(t.TempDir(), "image-*.iso")
+			if err != nil {
+				t.Fatalf("temp file: %v", err)
+			}
+			defer tmp.Close()
+
+			if _, err = tmp.WriteString(content); err != nil {
+				t.Fatalf("write temp file: %v", err)
+			}
+
+			c := &client{uploadRetries: tt.retries, uploadDelay: time.Millisecond}
+			req := &api.FileUploadRequest{FileName: "image.iso", File: tmp}
+
+			attempts := 0
+
+			err = c.retryUpload(context.Background(), req, func() error {
+				attempts++
+
+				// simulate a transfer interrupted half-way through
+				buf := make([]byte, len(content)/2)
+				if _, rerr := io.ReadFull(req.File, buf); rerr != nil {
+					t.Fatalf("read file: %v", rerr)
+				}
+
+				if string(buf) != content[:len(buf)] {
+					t.Fatalf("attempt %d did not start at the beginning of the file: %q", attempts, buf)
+				}
+
+				if attempts <= tt.failures {
+					if tt.failure != nil {
+						return tt.failure
+					}
+
+					return errors.New("connection reset by peer")
+				}
+
+				return nil
+			})
+
+			if tt.wantErr && err == nil {
+				t.Fatal("expected an error after exhausting the retries, got nil")
+			}
+
+			if !tt.wantErr && err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+
+			if attempts != tt.wantAttempts {
+				t.Fatalf("expected %d attempts, got %d", tt.wantAttempts, attempts)
+			}
+		})
+	}
+}
+
+// TestIsTransientUploadError verifies that only interrupted connections are retried.
+func TestIsTransientUploadError(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name string
+		err  error
+		want bool
+	}{
+		{name: "nil", err: nil, want: false},
+		{name: "eof", err: fmt.Errorf("failed to upload: %w", io.EOF), want: true},
+		{name: "connection reset", err: fmt.Errorf("write: %w", syscall.ECONNRESET), want: true},
+		{name: "truncated", err: fmt.Errorf("failed to upload file: %w", errUploadTruncated), want: true},
+		{name: "sftp connection lost", err: sftp.ErrSSHFxConnectionLost, want: true},
+		{name: "unwrapped broken pipe", err: errors.New("write tcp 10.0.0.1:22: broken pipe"), want: true},
+		{name: "permission denied", err: errors.New("tee: /var/lib/vz/snippets/a.yaml: Permission denied"), want: false},
+		{name: "no space left", err: fmt.Errorf("write: %w", syscall.ENOSPC), want: false},
+		{name: "checksum mismatch", err: errors.New("checksum mismatch"), want: false},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			if got := isTransientUploadError(tt.err); got != tt.want {
+				t.Fatalf("isTransientUploadError(%v) = %v, want %v", tt.err, got, tt.want)
+			}
+		})
+	}
+}
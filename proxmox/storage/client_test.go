@@ -10,6 +10,7 @@ import (
 	"context"
 	"net/http"
 	"testing"
+	"time"
 
 	"github.com/stretchr/testify/assert"
 )
@@ -21,6 +22,7 @@ func (fakeAPIClient) ExpandPath(path string) string                            {
 func (fakeAPIClient) IsRoot(_ context.Context) bool                            { return false }
 func (fakeAPIClient) IsRootTicket(_ context.Context) bool                      { return false }
 func (fakeAPIClient) HTTP() *http.Client                                       { return &http.Client{} }
+func (fakeAPIClient) TaskPollInterval() time.Duration                          { return time.Second }
 
 func TestClientExpandPath(t *testing.T) {
 	t.Parallel()
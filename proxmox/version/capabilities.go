@@ -30,3 +30,9 @@ func (v *ProxmoxVersion) SupportModernAptSources() bool {
 func (v *ProxmoxVersion) SupportContainerHostManaged() bool {
 	return v.GreaterThanOrEqual(version.Must(version.NewVersion("9.1.0")))
 }
+
+// SupportPoolAllowMove checks if the Proxmox version supports the `allow-move` flag when adding VMs to a pool.
+// PVE 8.1+ accepts the flag to move a VM from its current pool; older releases reject VMs that are in another pool.
+func (v *ProxmoxVersion) SupportPoolAllowMove() bool {
+	return v.GreaterThanOrEqual(version.Must(version.NewVersion("8.1.0")))
+}
@@ -18,10 +18,13 @@ import (
 
 // ProviderConfiguration is the configuration for the provider.
 type ProviderConfiguration struct {
-	apiClient      api.Client
-	sshClient      ssh.Client
-	tmpDirOverride string
-	idGenerator    cluster.IDGenerator
+	apiClient         api.Client
+	sshClient         ssh.Client
+	tmpDirOverride    string
+	forceDelete       bool
+	rebootOnChange    bool
+	vmShutdownTimeout int
+	idGenerator       cluster.IDGenerator
 }
 
 // NewProviderConfiguration creates a new provider configuration.
@@ -29,12 +32,18 @@ func NewProviderConfiguration(
 	apiClient api.Client,
 	sshClient ssh.Client,
 	tmpDirOverride string,
+	forceDelete bool,
+	rebootOnChange bool,
+	vmShutdownTimeout int,
 	idCfg cluster.IDGeneratorConfig,
 ) (ProviderConfiguration, error) {
 	cfg := ProviderConfiguration{
-		apiClient:      apiClient,
-		sshClient:      sshClient,
-		tmpDirOverride: tmpDirOverride,
+		apiClient:         apiClient,
+		sshClient:         sshClient,
+		tmpDirOverride:    tmpDirOverride,
+		forceDelete:       forceDelete,
+		rebootOnChange:    rebootOnChange,
+		vmShutdownTimeout: vmShutdownTimeout,
 	}
 
 	client, err := cfg.GetClient()
@@ -73,6 +82,21 @@ func (c *ProviderConfiguration) TempDir() string {
 	return os.TempDir()
 }
 
+// ForceDelete returns whether the protection flag of VMs and containers is cleared before deleting them.
+func (c *ProviderConfiguration) ForceDelete() bool {
+	return c.forceDelete
+}
+
+// RebootOnChange returns whether a running container is restarted when a change only takes effect after a restart.
+func (c *ProviderConfiguration) RebootOnChange() bool {
+	return c.rebootOnChange
+}
+
+// VMShutdownTimeout returns the default VM shutdown timeout in seconds, or 0 if it is not set.
+func (c *ProviderConfiguration) VMShutdownTimeout() int {
+	return c.vmShutdownTimeout
+}
+
 // GetIDGenerator returns the IDGenerator.
 func (c *ProviderConfiguration) GetIDGenerator() cluster.IDGenerator {
 	return c.idGenerator
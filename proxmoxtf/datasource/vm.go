@@ -9,6 +9,7 @@ package datasource
 import (
 	"context"
 	"errors"
+	"fmt"
 	"sort"
 	"strconv"
 	"strings"
@@ -18,16 +19,23 @@ import (
 
 	"github.com/bpg/terraform-provider-proxmox/fwprovider/migration"
 	"github.com/bpg/terraform-provider-proxmox/proxmox/api"
+	"github.com/bpg/terraform-provider-proxmox/proxmox/nodes/vms"
 	"github.com/bpg/terraform-provider-proxmox/proxmoxtf"
 )
 
 const (
-	mkDataSourceVirtualEnvironmentVMName     = "name"
-	mkDataSourceVirtualEnvironmentVMNodeName = "node_name"
-	mkDataSourceVirtualEnvironmentVMTags     = "tags"
-	mkDataSourceVirtualEnvironmentVMTemplate = "template"
-	mkDataSourceVirtualEnvironmentVMStatus   = "status"
-	mkDataSourceVirtualEnvironmentVMVMID     = "vm_id"
+	mkDataSourceVirtualEnvironmentVMAgentInterfaces           = "agent_interfaces"
+	mkDataSourceVirtualEnvironmentVMAgentInterfaceName        = "name"
+	mkDataSourceVirtualEnvironmentVMAgentInterfaceMACAddress  = "mac_address"
+	mkDataSourceVirtualEnvironmentVMAgentInterfaceIPAddresses = "ip_addresses"
+	mkDataSourceVirtualEnvironmentVMAgentInterfaceIPAddress   = "address"
+	mkDataSourceVirtualEnvironmentVMAgentInterfaceIPPrefix    = "prefix"
+	mkDataSourceVirtualEnvironmentVMName                      = "name"
+	mkDataSourceVirtualEnvironmentVMNodeName                  = "node_name"
+	mkDataSourceVirtualEnvironmentVMTags                      = "tags"
+	mkDataSourceVirtualEnvironmentVMTemplate                  = "template"
+	mkDataSourceVirtualEnvironmentVMStatus                    = "status"
+	mkDataSourceVirtualEnvironmentVMVMID                      = "vm_id"
 )
 
 // VM returns a resource for a single Proxmox VM.
@@ -35,6 +43,44 @@ func VM() *schema.Resource {
 	return &schema.Resource{
 		DeprecationMessage: migration.DeprecationMessage("proxmox_vm"),
 		Schema: map[string]*schema.Schema{
+			mkDataSourceVirtualEnvironmentVMAgentInterfaces: {
+				Type:        schema.TypeList,
+				Description: "The network interfaces reported by the QEMU guest agent",
+				Computed:    true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						mkDataSourceVirtualEnvironmentVMAgentInterfaceName: {
+							Type:        schema.TypeString,
+							Description: "The interface name",
+							Computed:    true,
+						},
+						mkDataSourceVirtualEnvironmentVMAgentInterfaceMACAddress: {
+							Type:        schema.TypeString,
+							Description: "The MAC address",
+							Computed:    true,
+						},
+						mkDataSourceVirtualEnvironmentVMAgentInterfaceIPAddresses: {
+							Type:        schema.TypeList,
+							Description: "The IP addresses",
+							Computed:    true,
+							Elem: &schema.Resource{
+								Schema: map[string]*schema.Schema{
+									mkDataSourceVirtualEnvironmentVMAgentInterfaceIPAddress: {
+										Type:        schema.TypeString,
+										Description: "The IP address",
+										Computed:    true,
+									},
+									mkDataSourceVirtualEnvironmentVMAgentInterfaceIPPrefix: {
+										Type:        schema.TypeInt,
+										Description: "The prefix length",
+										Computed:    true,
+									},
+								},
+							},
+						},
+					},
+				},
+			},
 			mkDataSourceVirtualEnvironmentVMName: {
 				Type:        schema.TypeString,
 				Description: "The VM name",
@@ -134,7 +180,57 @@ func vmRead(ctx context.Context, d *schema.ResourceData, m any) diag.Diagnostics
 	err = d.Set(mkDataSourceVirtualEnvironmentVMTags, tags)
 	diags = append(diags, diag.FromErr(err)...)
 
+	agentInterfaces, agentDiags := vmReadAgentInterfaces(ctx, client.Node(nodeName).VM(vmID), vmStatus, vmConfig)
+	diags = append(diags, agentDiags...)
+
+	err = d.Set(mkDataSourceVirtualEnvironmentVMAgentInterfaces, agentInterfaces)
+	diags = append(diags, diag.FromErr(err)...)
+
 	d.SetId(strconv.Itoa(vmID))
 
 	return diags
 }
+
+// vmReadAgentInterfaces reads the network interfaces from the QEMU guest agent. An unavailable agent is not an
+// error: an empty list is returned along with a warning explaining why.
+func vmReadAgentInterfaces(
+	ctx context.Context,
+	vmAPI *vms.Client,
+	vmStatus *vms.GetStatusResponseData,
+	vmConfig *vms.GetResponseData,
+) ([]any, diag.Diagnostics) {
+	data, err := vmAPI.GetAgentNetworkInterfaces(ctx, vmStatus, vmConfig)
+	if err != nil {
+		return []any{}, diag.Diagnostics{{
+			Severity: diag.Warning,
+			Summary:  "QEMU guest agent network interfaces are not available",
+			Detail:   fmt.Sprintf("The agent interfaces will be empty: %s.", err),
+		}}
+	}
+
+	return vmFlattenAgentInterfaces(data), nil
+}
+
+// vmFlattenAgentInterfaces converts the agent interfaces to the agent_interfaces attribute value.
+func vmFlattenAgentInterfaces(data []vms.AgentNetworkInterface) []any {
+	interfaces := make([]any, 0, len(data))
+
+	for _, iface := range data {
+		addresses := make([]any, 0, len(iface.IPAddresses))
+
+		for _, ip := range iface.IPAddresses {
+			addresses = append(addresses, map[string]any{
+				mkDataSourceVirtualEnvironmentVMAgentInterfaceIPAddress: ip.Address,
+				mkDataSourceVirtualEnvironmentVMAgentInterfaceIPPrefix:  ip.Prefix,
+			})
+		}
+
+		interfaces = append(interfaces, map[string]any{
+			mkDataSourceVirtualEnvironmentVMAgentInterfaceName:        iface.Name,
+			mkDataSourceVirtualEnvironmentVMAgentInterfaceMACAddress:  iface.MACAddress,
+			mkDataSourceVirtualEnvironmentVMAgentInterfaceIPAddresses: addresses,
+		})
+	}
+
+	return interfaces
+}
@@ -10,6 +10,9 @@ import (
 	"testing"
 
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/stretchr/testify/assert"
+
+	"github.com/bpg/terraform-provider-proxmox/proxmox/nodes/vms"
 
 	"github.com/bpg/terraform-provider-proxmox/proxmoxtf/test"
 )
@@ -32,16 +35,61 @@ func TestVMSchema(t *testing.T) {
 	s := VM().Schema
 
 	test.AssertComputedAttributes(t, s, []string{
+		mkDataSourceVirtualEnvironmentVMAgentInterfaces,
 		mkDataSourceVirtualEnvironmentVMName,
 		mkDataSourceVirtualEnvironmentVMTags,
 	})
 
 	test.AssertValueTypes(t, s, map[string]schema.ValueType{
-		mkDataSourceVirtualEnvironmentVMName:     schema.TypeString,
-		mkDataSourceVirtualEnvironmentVMNodeName: schema.TypeString,
-		mkDataSourceVirtualEnvironmentVMTags:     schema.TypeList,
-		mkDataSourceVirtualEnvironmentVMTemplate: schema.TypeBool,
-		mkDataSourceVirtualEnvironmentVMStatus:   schema.TypeString,
-		mkDataSourceVirtualEnvironmentVMVMID:     schema.TypeInt,
+		mkDataSourceVirtualEnvironmentVMAgentInterfaces: schema.TypeList,
+		mkDataSourceVirtualEnvironmentVMName:            schema.TypeString,
+		mkDataSourceVirtualEnvironmentVMNodeName:        schema.TypeString,
+		mkDataSourceVirtualEnvironmentVMTags:            schema.TypeList,
+		mkDataSourceVirtualEnvironmentVMTemplate:        schema.TypeBool,
+		mkDataSourceVirtualEnvironmentVMStatus:          schema.TypeString,
+		mkDataSourceVirtualEnvironmentVMVMID:            schema.TypeInt,
 	})
 }
+
+// TestVMFlattenAgentInterfaces tests the conversion of the agent network interfaces.
+func TestVMFlattenAgentInterfaces(t *testing.T) {
+	t.Parallel()
+
+	assert.Empty(t, vmFlattenAgentInterfaces(nil))
+
+	data := &vms.GetQEMUNetworkInterfacesResponseData{
+		Result: &[]vms.GetQEMUNetworkInterfacesResponseResult{
+			{Name: "lo", MACAddress: "00:00:00:00:00:00"},
+			{
+				Name:       "eth0",
+				MACAddress: "bc:24:11:00:00:01",
+				IPAddresses: &[]vms.GetQEMUNetworkInterfacesResponseResultIPAddress{
+					{Address: "192.168.1.10", Prefix: 24, Type: "ipv4"},
+					{Address: "fe80::1", Prefix: 64, Type: "ipv6"},
+				},
+			},
+		},
+	}
+
+	assert.Equal(t, []any{
+		map[string]any{
+			mkDataSourceVirtualEnvironmentVMAgentInterfaceName:        "lo",
+			mkDataSourceVirtualEnvironmentVMAgentInterfaceMACAddress:  "00:00:00:00:00:00",
+			mkDataSourceVirtualEnvironmentVMAgentInterfaceIPAddresses: []any{},
+		},
+		map[string]any{
+			mkDataSourceVirtualEnvironmentVMAgentInterfaceName:       "eth0",
+			mkDataSourceVirtualEnvironmentVMAgentInterfaceMACAddress: "bc:24:11:00:00:01",
+			mkDataSourceVirtualEnvironmentVMAgentInterfaceIPAddresses: []any{
+				map[string]any{
+					mkDataSourceVirtualEnvironmentVMAgentInterfaceIPAddress: "192.168.1.10",
+					mkDataSourceVirtualEnvironmentVMAgentInterfaceIPPrefix:  24,
+				},
+				map[string]any{
+					mkDataSourceVirtualEnvironmentVMAgentInterfaceIPAddress: "fe80::1",
+					mkDataSourceVirtualEnvironmentVMAgentInterfaceIPPrefix:  64,
+				},
+			},
+		},
+	}, vmFlattenAgentInterfaces(vms.FlattenAgentNetworkInterfaces(data)))
+}
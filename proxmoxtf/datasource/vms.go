@@ -19,6 +19,7 @@ import (
 	"github.com/google/uuid"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
 
 	"github.com/bpg/terraform-provider-proxmox/proxmox"
 	proxmoxapi "github.com/bpg/terraform-provider-proxmox/proxmox/api"
@@ -27,11 +28,12 @@ import (
 )
 
 const (
-	mkDataSourceVirtualEnvironmentVMs = "vms"
-	mkDataSourceFilter                = "filter"
-	mkDataSourceFilterName            = "name"
-	mkDataSourceFilterValues          = "values"
-	mkDataSourceFilterRegex           = "regex"
+	mkDataSourceVirtualEnvironmentVMs         = "vms"
+	mkDataSourceVirtualEnvironmentVMsTagRegex = "tag_regex"
+	mkDataSourceFilter                        = "filter"
+	mkDataSourceFilterName                    = "name"
+	mkDataSourceFilterValues                  = "values"
+	mkDataSourceFilterRegex                   = "regex"
 )
 
 // VMs returns a resource for the Proxmox VMs.
@@ -51,6 +53,13 @@ func VMs() *schema.Resource {
 				Optional:    true,
 				Elem:        &schema.Schema{Type: schema.TypeString},
 			},
+			mkDataSourceVirtualEnvironmentVMsTagRegex: {
+				Type: schema.TypeString,
+				Description: "Regular expression matched against the semicolon-separated, " +
+					"sorted tag list of the VM",
+				Optional:         true,
+				ValidateDiagFunc: validation.ToDiagFunc(validation.StringIsValidRegExp),
+			},
 			mkDataSourceFilter: {
 				Type:        schema.TypeList,
 				Optional:    true,
@@ -118,6 +127,15 @@ func vmsRead(ctx context.Context, d *schema.ResourceData, m any) diag.Diagnostic
 
 	sort.Strings(filterTags)
 
+	var tagRegex *regexp.Regexp
+
+	if v := d.Get(mkDataSourceVirtualEnvironmentVMsTagRegex).(string); v != "" {
+		tagRegex, err = regexp.Compile(v)
+		if err != nil {
+			return diag.Errorf("error interpreting %s '%s' as regex: %s", mkDataSourceVirtualEnvironmentVMsTagRegex, v, err)
+		}
+	}
+
 	filters := d.Get(mkDataSourceFilter).([]any)
 
 	var vms []any
@@ -161,19 +179,8 @@ func vmsRead(ctx context.Context, d *schema.ResourceData, m any) diag.Diagnostic
 				vm[mkDataSourceVirtualEnvironmentVMTags] = tags
 			}
 
-			if len(filterTags) > 0 {
-				match := true
-
-				for _, tag := range filterTags {
-					if !slices.Contains(tags, tag) {
-						match = false
-						break
-					}
-				}
-
-				if !match {
-					continue
-				}
+			if !checkVMMatchTags(tags, filterTags, tagRegex) {
+				continue
 			}
 
 			if data.Template != (*types.CustomBool)(nil) && *data.Template {
@@ -227,6 +234,22 @@ func getNodeNames(ctx context.Context, d *schema.ResourceData, api proxmox.Clien
 	return nodeNames, nil
 }
 
+// checkVMMatchTags reports whether the sorted VM tags contain all the filter tags
+// and, if set, match the tag regex.
+func checkVMMatchTags(tags []string, filterTags []string, tagRegex *regexp.Regexp) bool {
+	for _, tag := range filterTags {
+		if !slices.Contains(tags, tag) {
+			return false
+		}
+	}
+
+	if tagRegex != nil && !tagRegex.MatchString(strings.Join(tags, ";")) {
+		return false
+	}
+
+	return true
+}
+
 //nolint:dupl // TODO: refactor to avoid duplication
 func checkVMMatchFilters(vm map[string]any, filters []any) (bool, error) {
 	for _, v := range filters {
@@ -7,6 +7,7 @@
 package datasource
 
 import (
+	"regexp"
 	"testing"
 
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
@@ -36,10 +37,11 @@ func TestVMsSchema(t *testing.T) {
 	})
 
 	test.AssertValueTypes(t, s, map[string]schema.ValueType{
-		mkDataSourceVirtualEnvironmentVMNodeName: schema.TypeString,
-		mkDataSourceVirtualEnvironmentVMTags:     schema.TypeList,
-		mkDataSourceFilter:                       schema.TypeList,
-		mkDataSourceVirtualEnvironmentVMs:        schema.TypeList,
+		mkDataSourceVirtualEnvironmentVMNodeName:  schema.TypeString,
+		mkDataSourceVirtualEnvironmentVMTags:      schema.TypeList,
+		mkDataSourceVirtualEnvironmentVMsTagRegex: schema.TypeString,
+		mkDataSourceFilter:                        schema.TypeList,
+		mkDataSourceVirtualEnvironmentVMs:         schema.TypeList,
 	})
 
 	vmsSchema := test.AssertNestedSchemaExistence(t, s, mkDataSourceVirtualEnvironmentVMs)
@@ -63,3 +65,42 @@ func TestVMsSchema(t *testing.T) {
 		mkDataSourceFilterRegex:  schema.TypeBool,
 	})
 }
+
+// TestVMsCheckMatchTags tests the tags and tag_regex filters of the dataSourceVirtualEnvironmentVMs.
+func TestVMsCheckMatchTags(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name       string
+		tags       []string
+		filterTags []string
+		tagRegex   string
+		expected   bool
+	}{
+		{"no filters", []string{"env-prod-web-01"}, nil, "", true},
+		{"exact tags match", []string{"env-prod-web-01", "ubuntu"}, []string{"ubuntu"}, "", true},
+		{"exact tags mismatch", []string{"env-prod-web-01"}, []string{"ubuntu"}, "", false},
+		{"regex match", []string{"env-prod-web-01", "ubuntu"}, nil, `env-prod-web-\d+`, true},
+		{"regex mismatch", []string{"env-dev-web-01"}, nil, `env-prod-`, false},
+		{"regex against untagged VM", nil, nil, `env-prod-`, false},
+		{"regex matches joined list", []string{"a", "b"}, nil, `^a;b$`, true},
+		{"tags and regex both match", []string{"env-prod-web-01", "ubuntu"}, []string{"ubuntu"}, `env-prod-`, true},
+		{"tags match but regex does not", []string{"env-dev-web-01", "ubuntu"}, []string{"ubuntu"}, `env-prod-`, false},
+		{"regex matches but tags do not", []string{"env-prod-web-01"}, []string{"ubuntu"}, `env-prod-`, false},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			var tagRegex *regexp.Regexp
+			if tt.tagRegex != "" {
+				tagRegex = regexp.MustCompile(tt.tagRegex)
+			}
+
+			if actual := checkVMMatchTags(tt.tags, tt.filterTags, tagRegex); actual != tt.expected {
+				t.Fatalf("expected %t, got %t", tt.expected, actual)
+			}
+		})
+	}
+}
@@ -11,6 +11,7 @@ import (
 	"fmt"
 	"net"
 	"strings"
+	"time"
 
 	"github.com/hashicorp/terraform-plugin-log/tflog"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
@@ -99,7 +100,50 @@ func providerConfigure(ctx context.Context, d *schema.ResourceData) (any, diag.D
 	creds, err = api.NewCredentials(username, password, otp, apiToken, authTicket, csrfPreventionToken)
 	diags = append(diags, diag.FromErr(err)...)
 
-	conn, err = api.NewConnection(endpoint, insecure, minTLS)
+	maxConcurrentRequests := api.DefaultMaxConcurrentRequests
+	if v, ok := d.GetOkExists(mkProviderAPIMaxConcurrent); ok { //nolint:staticcheck
+		maxConcurrentRequests = v.(int)
+	} else if v, e := intEnvDefault("PROXMOX_VE_API_MAX_CONCURRENT_REQUESTS", maxConcurrentRequests); e != nil {
+		diags = append(diags, diag.FromErr(e)...)
+	} else {
+		maxConcurrentRequests = v.(int)
+	}
+
+	taskPollInterval := int(api.DefaultTaskPollInterval.Milliseconds())
+	if v, ok := d.GetOk(mkProviderAPITaskPollInterval); ok {
+		taskPollInterval = v.(int)
+	} else if v, e := intEnvDefault("PROXMOX_VE_API_TASK_POLL_INTERVAL_MS", taskPollInterval); e != nil {
+		diags = append(diags, diag.FromErr(e)...)
+	} else {
+		taskPollInterval = v.(int)
+	}
+
+	retryAttempts := api.DefaultRequestRetries
+	if v, ok := d.GetOk(mkProviderAPIRetryAttempts); ok {
+		retryAttempts = v.(int)
+	} else if v, e := intEnvDefault("PROXMOX_VE_API_RETRY_ATTEMPTS", retryAttempts); e != nil {
+		diags = append(diags, diag.FromErr(e)...)
+	} else {
+		retryAttempts = v.(int)
+	}
+
+	connOpts := []api.ConnectionOption{
+		api.WithMaxConcurrentRequests(maxConcurrentRequests),
+		api.WithTaskPollInterval(time.Duration(taskPollInterval) * time.Millisecond),
+		api.WithRequestRetries(uint(retryAttempts), api.DefaultRequestRetryDelay), //nolint:gosec
+	}
+
+	if v, ok := d.GetOk(mkProviderAPIRetryStatusCodes); ok {
+		var codes []int
+
+		for _, code := range v.([]any) {
+			codes = append(codes, code.(int))
+		}
+
+		connOpts = append(connOpts, api.WithRequestRetryClassifier(api.RetryOnStatusCodes(codes...)))
+	}
+
+	conn, err = api.NewConnection(endpoint, insecure, minTLS, connOpts...)
 	diags = append(diags, diag.FromErr(err)...)
 
 	if diags.HasError() {
@@ -193,6 +237,28 @@ func providerConfigure(ctx context.Context, d *schema.ResourceData) (any, diag.D
 		}
 	}
 
+	uploadRetries := ssh.DefaultUploadRetries
+	if v, ok := sshConf[mkProviderSSHUploadRetries]; ok {
+		uploadRetries = v.(int)
+	} else if v, e := intEnvDefault("PROXMOX_VE_SSH_UPLOAD_RETRIES", uploadRetries); e != nil {
+		diags = append(diags, diag.FromErr(e)...)
+	} else {
+		uploadRetries = v.(int)
+	}
+
+	uploadRetryDelay := int(ssh.DefaultUploadRetryDelay.Seconds())
+	if v, ok := sshConf[mkProviderSSHUploadRetryDelay]; ok {
+		uploadRetryDelay = v.(int)
+	} else if v, e := intEnvDefault("PROXMOX_VE_SSH_UPLOAD_RETRY_DELAY", uploadRetryDelay); e != nil {
+		diags = append(diags, diag.FromErr(e)...)
+	} else {
+		uploadRetryDelay = v.(int)
+	}
+
+	if diags.HasError() {
+		return nil, diags
+	}
+
 	nodeAddressSource := "api"
 	if v, ok := sshConf[mkProviderSSHNodeAddressSource]; ok && v.(string) != "" {
 		nodeAddressSource = v.(string)
@@ -224,6 +290,8 @@ func providerConfigure(ctx context.Context, d *schema.ResourceData) (any, diag.D
 		sshConf[mkProviderSSHSocks5Username].(string),
 		sshConf[mkProviderSSHSocks5Password].(string),
 		nodeResolver,
+		uploadRetries,
+		time.Duration(uploadRetryDelay)*time.Second,
 	)
 	if err != nil {
 		return nil, diag.Errorf("error creating SSH client: %s", err)
@@ -236,7 +304,21 @@ func providerConfigure(ctx context.Context, d *schema.ResourceData) (any, diag.D
 		tmpDirOverride = v.(string)
 	}
 
-	idCfg := cluster.IDGeneratorConfig{}
+	forceDelete := false
+
+	if v, ok := d.GetOk(mkProviderForceDelete); ok {
+		forceDelete = v.(bool)
+	}
+
+	rebootOnChange := false
+
+	if v, ok := d.GetOk(mkProviderRebootOnChange); ok {
+		rebootOnChange = v.(bool)
+	}
+
+	idCfg := cluster.IDGeneratorConfig{
+		ConflictRetries: cluster.DefaultIDConflictRetries,
+	}
 
 	if v, ok := d.GetOk(mkProviderRandomVMIDs); ok {
 		idCfg.RandomIDs = v.(bool)
@@ -250,7 +332,19 @@ func providerConfigure(ctx context.Context, d *schema.ResourceData) (any, diag.D
 		idCfg.RandomIDEnd = v.(int)
 	}
 
-	config, err := proxmoxtf.NewProviderConfiguration(apiClient, sshClient, tmpDirOverride, idCfg)
+	if v, ok := d.GetOkExists(mkProviderVMIDConflictRetries); ok { //nolint:staticcheck
+		idCfg.ConflictRetries = v.(int)
+	}
+
+	vmShutdownTimeout := 0
+
+	if v, ok := d.GetOk(mkProviderVMShutdownTimeout); ok {
+		vmShutdownTimeout = v.(int)
+	}
+
+	config, err := proxmoxtf.NewProviderConfiguration(
+		apiClient, sshClient, tmpDirOverride, forceDelete, rebootOnChange, vmShutdownTimeout, idCfg,
+	)
 	if err != nil {
 		return nil, diag.Errorf("error creating provider's configuration: %s", err)
 	}
@@ -7,10 +7,14 @@
 package provider
 
 import (
+	"fmt"
 	"os"
+	"strconv"
 
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+
+	"github.com/bpg/terraform-provider-proxmox/proxmox/ssh"
 )
 
 const (
@@ -20,13 +24,21 @@ const (
 	mkProviderAuthTicket           = "auth_ticket"
 	mkProviderCSRFPreventionToken  = "csrf_prevention_token" // #nosec G101
 	mkProviderAPIToken             = "api_token"
+	mkProviderAPIMaxConcurrent     = "api_max_concurrent_requests"
+	mkProviderAPITaskPollInterval  = "api_task_poll_interval_ms"
+	mkProviderAPIRetryAttempts     = "api_retry_attempts"
+	mkProviderAPIRetryStatusCodes  = "api_retry_status_codes"
 	mkProviderOTP                  = "otp"
 	mkProviderPassword             = "password"
 	mkProviderUsername             = "username"
 	mkProviderTmpDir               = "tmp_dir"
+	mkProviderForceDelete          = "force_delete"
+	mkProviderRebootOnChange       = "reboot_on_change"
 	mkProviderRandomVMIDs          = "random_vm_ids"
 	mkProviderRandomVMIDStart      = "random_vm_id_start"
 	mkProviderRandomVMIDEnd        = "random_vm_id_end"
+	mkProviderVMIDConflictRetries  = "vm_id_conflict_retries"
+	mkProviderVMShutdownTimeout    = "vm_shutdown_timeout"
 	mkProviderSSH                  = "ssh"
 	mkProviderSSHUsername          = "username"
 	mkProviderSSHPassword          = "password"
@@ -38,6 +50,8 @@ const (
 	mkProviderSSHSocks5Username    = "socks5_username"
 	mkProviderSSHSocks5Password    = "socks5_password"
 	mkProviderSSHNodeAddressSource = "node_address_source"
+	mkProviderSSHUploadRetries     = "upload_retries"
+	mkProviderSSHUploadRetryDelay  = "upload_retry_delay"
 
 	mkProviderSSHNode        = "node"
 	mkProviderSSHNodeName    = "name"
@@ -85,6 +99,39 @@ func createSchema() map[string]*schema.Schema {
 			Description: "The API token for the Proxmox VE API.",
 			// note: we allow empty string as a valid value, as it is used to unset the token in tests
 		},
+		mkProviderAPIMaxConcurrent: {
+			Type:     schema.TypeInt,
+			Optional: true,
+			Description: "The maximum number of concurrent requests against the Proxmox VE API. " +
+				"Set to `0` to disable the limit. Defaults to the value of the " +
+				"`PROXMOX_VE_API_MAX_CONCURRENT_REQUESTS` environment variable, or `8` if not set.",
+			ValidateFunc: validation.IntAtLeast(0),
+		},
+		mkProviderAPITaskPollInterval: {
+			Type:     schema.TypeInt,
+			Optional: true,
+			Description: "The maximum delay in milliseconds between two status polls of a running task. " +
+				"Defaults to the value of the `PROXMOX_VE_API_TASK_POLL_INTERVAL_MS` environment variable, " +
+				"or `1000` if not set.",
+			ValidateFunc: validation.IntAtLeast(1),
+		},
+		mkProviderAPIRetryAttempts: {
+			Type:     schema.TypeInt,
+			Optional: true,
+			Description: "The number of attempts of an API request that fails with a retryable error. " +
+				"Defaults to the value of the `PROXMOX_VE_API_RETRY_ATTEMPTS` environment variable, or `4` if not set.",
+			ValidateFunc: validation.IntAtLeast(1),
+		},
+		mkProviderAPIRetryStatusCodes: {
+			Type:     schema.TypeList,
+			Optional: true,
+			Description: "The HTTP status codes on which idempotent API requests are retried. Defaults to " +
+				"502, 503, 504, 596, and 500 responses caused by an internal error of the API daemon.",
+			Elem: &schema.Schema{
+				Type:         schema.TypeInt,
+				ValidateFunc: validation.IntBetween(400, 599),
+			},
+		},
 		mkProviderOTP: {
 			Type:        schema.TypeString,
 			Optional:    true,
@@ -238,6 +285,30 @@ func createSchema() map[string]*schema.Schema {
 							"Defaults to `api`.",
 						ValidateFunc: validation.StringInSlice([]string{"api", "dns"}, false),
 					},
+					mkProviderSSHUploadRetries: {
+						Type:     schema.TypeInt,
+						Optional: true,
+						Description: "The number of times a failed SSH file upload is retried. " +
+							"Partially uploaded files are removed from the node before retrying. " +
+							"Defaults to the value of the `PROXMOX_VE_SSH_UPLOAD_RETRIES` environment variable, " +
+							"or `3` if not set.",
+						DefaultFunc: func() (any, error) {
+							return intEnvDefault("PROXMOX_VE_SSH_UPLOAD_RETRIES", ssh.DefaultUploadRetries)
+						},
+						ValidateFunc: validation.IntBetween(0, 10),
+					},
+					mkProviderSSHUploadRetryDelay: {
+						Type:     schema.TypeInt,
+						Optional: true,
+						Description: "The initial delay in seconds between SSH file upload retries, " +
+							"doubled after every failed attempt. " +
+							"Defaults to the value of the `PROXMOX_VE_SSH_UPLOAD_RETRY_DELAY` environment variable, " +
+							"or `5` if not set.",
+						DefaultFunc: func() (any, error) {
+							return intEnvDefault("PROXMOX_VE_SSH_UPLOAD_RETRY_DELAY", int(ssh.DefaultUploadRetryDelay.Seconds()))
+						},
+						ValidateFunc: validation.IntBetween(0, 300),
+					},
 					mkProviderSSHNode: {
 						Type:        schema.TypeList,
 						Optional:    true,
@@ -276,6 +347,18 @@ func createSchema() map[string]*schema.Schema {
 			Description:  "The alternative temporary directory.",
 			ValidateFunc: validation.StringIsNotEmpty,
 		},
+		mkProviderForceDelete: {
+			Type:     schema.TypeBool,
+			Optional: true,
+			Description: "Whether to clear the protection flag of VMs and Containers " +
+				"before deleting them.",
+		},
+		mkProviderRebootOnChange: {
+			Type:     schema.TypeBool,
+			Optional: true,
+			Description: "Whether to restart a running Container when a change only takes effect " +
+				"after a restart, such as a `features` change.",
+		},
 		mkProviderRandomVMIDs: {
 			Type:        schema.TypeBool,
 			Optional:    true,
@@ -293,5 +376,34 @@ func createSchema() map[string]*schema.Schema {
 			Description:  "The ending number for random VM / Container IDs.",
 			ValidateFunc: validation.IntBetween(100, 999999999),
 		},
+		mkProviderVMIDConflictRetries: {
+			Type:     schema.TypeInt,
+			Optional: true,
+			Description: "The number of times to retry creating a VM / Container with a newly " +
+				"generated ID when the generated one is already in use. Defaults to `3`.",
+			ValidateFunc: validation.IntAtLeast(0),
+		},
+		mkProviderVMShutdownTimeout: {
+			Type:     schema.TypeInt,
+			Optional: true,
+			Description: "The default time in seconds to wait for a VM to shut down gracefully before it is " +
+				"forcefully stopped, used by VMs that leave `timeout_shutdown_vm` at its default.",
+			ValidateFunc: validation.IntAtLeast(1),
+		},
+	}
+}
+
+// intEnvDefault returns the integer value of the environment variable, or the given default if it is not set.
+func intEnvDefault(key string, defaultValue int) (any, error) {
+	v := os.Getenv(key)
+	if v == "" {
+		return defaultValue, nil
+	}
+
+	i, err := strconv.Atoi(v)
+	if err != nil {
+		return nil, fmt.Errorf("invalid %s environment variable: %w", key, err)
 	}
+
+	return i, nil
 }
@@ -8,7 +8,11 @@ package resource
 
 import (
 	"context"
+	"crypto/tls"
+	"crypto/x509"
+	"encoding/pem"
 	"fmt"
+	"strconv"
 	"strings"
 	"time"
 
@@ -23,6 +27,7 @@ import (
 const (
 	dvResourceVirtualEnvironmentCertificateCertificateChain = ""
 	dvResourceVirtualEnvironmentCertificateOverwrite        = false
+	dvResourceVirtualEnvironmentCertificateRestart          = true
 
 	mkResourceVirtualEnvironmentCertificateCertificate             = "certificate"
 	mkResourceVirtualEnvironmentCertificateCertificateChain        = "certificate_chain"
@@ -34,6 +39,7 @@ const (
 	mkResourceVirtualEnvironmentCertificatePrivateKey              = "private_key"
 	mkResourceVirtualEnvironmentCertificatePublicKeySize           = "public_key_size"
 	mkResourceVirtualEnvironmentCertificatePublicKeyType           = "public_key_type"
+	mkResourceVirtualEnvironmentCertificateRestart                 = "restart"
 	mkResourceVirtualEnvironmentCertificateSSLFingerprint          = "ssl_fingerprint"
 	mkResourceVirtualEnvironmentCertificateStartDate               = "start_date"
 	mkResourceVirtualEnvironmentCertificateSubject                 = "subject"
@@ -98,6 +104,16 @@ func Certificate() *schema.Resource {
 				Description: "The public key type",
 				Computed:    true,
 			},
+			mkResourceVirtualEnvironmentCertificateRestart: {
+				Type:        schema.TypeBool,
+				Description: "Whether to restart pveproxy to apply the certificate",
+				Optional:    true,
+				Default:     dvResourceVirtualEnvironmentCertificateRestart,
+				// states created before the attribute was added have no value, which means the default
+				DiffSuppressFunc: func(_, oldValue, newValue string, _ *schema.ResourceData) bool {
+					return oldValue == "" && newValue == strconv.FormatBool(dvResourceVirtualEnvironmentCertificateRestart)
+				},
+			},
 			mkResourceVirtualEnvironmentCertificateSSLFingerprint: {
 				Type:        schema.TypeString,
 				Description: "The SSL fingerprint",
@@ -124,7 +140,84 @@ func Certificate() *schema.Resource {
 		ReadContext:   certificateRead,
 		UpdateContext: certificateUpdate,
 		DeleteContext: certificateDelete,
+		CustomizeDiff: certificateCustomizeDiff,
+	}
+}
+
+// certificateCustomizeDiff validates at plan time that the private key matches the certificate.
+func certificateCustomizeDiff(_ context.Context, d *schema.ResourceDiff, _ any) error {
+	if !d.NewValueKnown(mkResourceVirtualEnvironmentCertificateCertificate) ||
+		!d.NewValueKnown(mkResourceVirtualEnvironmentCertificatePrivateKey) {
+		return nil
+	}
+
+	return certificateCheckKeyPair(
+		d.Get(mkResourceVirtualEnvironmentCertificateCertificate).(string),
+		d.Get(mkResourceVirtualEnvironmentCertificatePrivateKey).(string),
+	)
+}
+
+// certificateCheckKeyPair returns an error if the certificate or the private key can't be parsed,
+// or if the private key does not belong to the (first) certificate.
+func certificateCheckKeyPair(certificate, privateKey string) error {
+	if certificate == "" || privateKey == "" {
+		return nil
+	}
+
+	certificateBlock, _ := pem.Decode([]byte(certificate))
+	if certificateBlock == nil || certificateBlock.Type != "CERTIFICATE" {
+		return fmt.Errorf("the %s is not a PEM encoded certificate", mkResourceVirtualEnvironmentCertificateCertificate)
+	}
+
+	if _, err := x509.ParseCertificate(certificateBlock.Bytes); err != nil {
+		return fmt.Errorf("the %s can't be parsed: %w", mkResourceVirtualEnvironmentCertificateCertificate, err)
+	}
+
+	privateKeyBlock, _ := pem.Decode([]byte(privateKey))
+	if privateKeyBlock == nil || !strings.HasSuffix(privateKeyBlock.Type, "PRIVATE KEY") {
+		return fmt.Errorf("the %s is not a PEM encoded private key", mkResourceVirtualEnvironmentCertificatePrivateKey)
 	}
+
+	if !certificateIsPrivateKey(privateKeyBlock.Bytes) {
+		return fmt.Errorf("the %s can't be parsed", mkResourceVirtualEnvironmentCertificatePrivateKey)
+	}
+
+	if _, err := tls.X509KeyPair([]byte(certificate), []byte(privateKey)); err != nil {
+		return fmt.Errorf(
+			"the %s does not match the %s: %w",
+			mkResourceVirtualEnvironmentCertificatePrivateKey,
+			mkResourceVirtualEnvironmentCertificateCertificate,
+			err,
+		)
+	}
+
+	return nil
+}
+
+// certificateIsPrivateKey reports whether der is a PKCS #1, PKCS #8 or SEC 1 encoded private key.
+func certificateIsPrivateKey(der []byte) bool {
+	if _, err := x509.ParsePKCS8PrivateKey(der); err == nil {
+		return true
+	}
+
+	if _, err := x509.ParsePKCS1PrivateKey(der); err == nil {
+		return true
+	}
+
+	_, err := x509.ParseECPrivateKey(der)
+
+	return err == nil
+}
+
+// certificateGetRestart returns whether pveproxy is restarted, using the default for states
+// created before the attribute was added.
+func certificateGetRestart(d *schema.ResourceData) types.CustomBool {
+	//nolint:staticcheck
+	if v, ok := d.GetOkExists(mkResourceVirtualEnvironmentCertificateRestart); ok {
+		return types.CustomBool(v.(bool))
+	}
+
+	return types.CustomBool(dvResourceVirtualEnvironmentCertificateRestart)
 }
 
 func certificateCreate(ctx context.Context, d *schema.ResourceData, m any) diag.Diagnostics {
@@ -158,7 +251,7 @@ func certificateGetUpdateBody(d *schema.ResourceData) *nodes.CertificateUpdateRe
 		force = true
 	}
 
-	restart := types.CustomBool(true)
+	restart := certificateGetRestart(d)
 
 	body := &nodes.CertificateUpdateRequestBody{
 		Certificates: combinedCertificates,
@@ -332,7 +425,7 @@ func certificateDelete(ctx context.Context, d *schema.ResourceData, m any) diag.
 
 	nodeName := d.Get(mkResourceVirtualEnvironmentCertificateNodeName).(string)
 
-	restart := types.CustomBool(true)
+	restart := certificateGetRestart(d)
 
 	err = api.Node(nodeName).DeleteCertificate(
 		ctx,
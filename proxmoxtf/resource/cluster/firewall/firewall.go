@@ -226,7 +226,15 @@ func firewallUpdate(ctx context.Context, api firewall.API, d *schema.ResourceDat
 	return firewallRead(ctx, api, d)
 }
 
-func firewallDelete(_ context.Context, _ firewall.API, d *schema.ResourceData) diag.Diagnostics {
+func firewallDelete(ctx context.Context, api firewall.API, d *schema.ResourceData) diag.Diagnostics {
+	// reset the options to their defaults
+	err := api.SetGlobalOptions(ctx, &firewall.OptionsPutRequestBody{
+		Delete: []string{"ebtables", "enable", "log_ratelimit", "policy_in", "policy_out", "policy_forward"},
+	})
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
 	d.SetId("")
 
 	return nil
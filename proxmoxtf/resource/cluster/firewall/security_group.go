@@ -34,7 +34,7 @@ func SecurityGroup() *schema.Resource {
 			Type:        schema.TypeString,
 			Description: "Security group name",
 			Required:    true,
-			ForceNew:    false,
+			ForceNew:    true,
 		},
 		mkSecurityGroupComment: {
 			Type:        schema.TypeString,
@@ -134,12 +134,13 @@ func SecurityGroupRead(ctx context.Context, api clusterfirewall.API, d *schema.R
 // SecurityGroupUpdate updates a security group.
 func SecurityGroupUpdate(ctx context.Context, api clusterfirewall.API, d *schema.ResourceData) diag.Diagnostics {
 	comment := d.Get(mkSecurityGroupComment).(string)
-	newName := d.Get(mkSecurityGroupName).(string)
-	previousName := d.Id()
+	name := d.Id()
 
+	// the name is the key of the group, so a rename replaces the resource; passing the current name as the
+	// "rename" target only updates the comment.
 	body := &clusterfirewall.GroupUpdateRequestBody{
-		Group:   newName,
-		ReName:  &previousName,
+		Group:   name,
+		ReName:  &name,
 		Comment: &comment,
 	}
 
@@ -148,12 +149,12 @@ func SecurityGroupUpdate(ctx context.Context, api clusterfirewall.API, d *schema
 		return diag.FromErr(err)
 	}
 
-	diags := firewall.RulesUpdate(ctx, api.SecurityGroup(previousName), d)
+	diags := firewall.RulesUpdate(ctx, api.SecurityGroup(name), d)
 	if diags.HasError() {
 		return diags
 	}
 
-	d.SetId(newName)
+	d.SetId(name)
 
 	return SecurityGroupRead(ctx, api, d)
 }
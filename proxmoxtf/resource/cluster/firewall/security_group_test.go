@@ -42,4 +42,6 @@ func TestSecurityGroupSchema(t *testing.T) {
 	})
 
 	test.AssertNestedSchemaExistence(t, s, firewall.MkRule)
+
+	require.True(t, s[mkSecurityGroupName].ForceNew, "renaming a security group must replace it")
 }
@@ -10,6 +10,7 @@ import (
 	"context"
 	"errors"
 	"fmt"
+	"reflect"
 	"regexp"
 	"slices"
 	"sort"
@@ -70,6 +71,7 @@ const (
 	dvFeaturesKeyControl                = false
 	dvFeaturesFUSE                      = false
 	dvFeaturesMakeDeviceNode            = false
+	dvFeaturesForceRWSys                = false
 	dvHookScript                        = ""
 	dvMemoryDedicated                   = 512
 	dvMemorySwap                        = 0
@@ -95,7 +97,6 @@ const (
 	dvStartupOrder                      = -1
 	dvStartupUpDelay                    = -1
 	dvStartupDownDelay                  = -1
-	dvStartOnBoot                       = true
 	dvTemplate                          = false
 	dvTimeoutCreate                     = 1800
 	dvTimeoutClone                      = 1800
@@ -137,6 +138,7 @@ const (
 	mkFeaturesFUSE                      = "fuse"
 	mkFeaturesMakeDeviceNode            = "mknod"
 	mkFeaturesMountTypes                = "mount"
+	mkFeaturesForceRWSys                = "force_rw_sys"
 	mkHookScriptFileID                  = "hook_script_file_id"
 	mkInitialization                    = "initialization"
 	mkInitializationDNS                 = "dns"
@@ -320,20 +322,20 @@ func Container() *schema.Resource {
 				DefaultFunc: func() (any, error) {
 					return []any{
 						map[string]any{
-							mkCPUArchitecture: dvCPUArchitecture,
-							mkCPUCores:        dvCPUCores,
-							mkCPULimit:        dvCPULimit,
-							mkCPUUnits:        dvCPUUnits,
+							mkCPUCores: dvCPUCores,
+							mkCPULimit: dvCPULimit,
+							mkCPUUnits: dvCPUUnits,
 						},
 					}, nil
 				},
 				Elem: &schema.Resource{
 					Schema: map[string]*schema.Schema{
 						mkCPUArchitecture: {
-							Type:             schema.TypeString,
-							Description:      "The CPU architecture",
+							Type: schema.TypeString,
+							Description: "The CPU architecture, inferred from the OS template metadata " +
+								"when not set (defaults to `amd64` when it cannot be inferred)",
 							Optional:         true,
-							Default:          dvCPUArchitecture,
+							Computed:         true,
 							ValidateDiagFunc: CPUArchitectureValidator(),
 						},
 						mkCPUCores: {
@@ -473,6 +475,7 @@ func Container() *schema.Resource {
 							mkFeaturesFUSE:           dvFeaturesFUSE,
 							mkFeaturesMountTypes:     []any{},
 							mkFeaturesMakeDeviceNode: dvFeaturesMakeDeviceNode,
+							mkFeaturesForceRWSys:     dvFeaturesForceRWSys,
 						},
 					}, nil
 				},
@@ -511,16 +514,23 @@ func Container() *schema.Resource {
 							Optional:    true,
 							Default:     dvFeaturesMakeDeviceNode,
 						},
+						mkFeaturesForceRWSys: {
+							Type:        schema.TypeBool,
+							Description: "Whether `/sys` is mounted read-write instead of mixed in an unprivileged container",
+							Optional:    true,
+							Default:     dvFeaturesForceRWSys,
+						},
 					},
 				},
 				MaxItems: 1,
 				MinItems: 0,
 			},
 			mkHookScriptFileID: {
-				Type:        schema.TypeString,
-				Description: "A hook script",
-				Optional:    true,
-				Default:     dvHookScript,
+				Type:             schema.TypeString,
+				Description:      "A hook script",
+				Optional:         true,
+				Default:          dvHookScript,
+				ValidateDiagFunc: validators.SnippetFileID(),
 			},
 			mkInitialization: {
 				Type:        schema.TypeList,
@@ -657,7 +667,10 @@ func Container() *schema.Resource {
 										DefaultFunc: func() (any, error) {
 											return []any{}, nil
 										},
-										Elem: &schema.Schema{Type: schema.TypeString},
+										Elem: &schema.Schema{
+											Type:             schema.TypeString,
+											ValidateDiagFunc: validators.SSHPublicKey(),
+										},
 									},
 									mkInitializationUserAccountPassword: {
 										Type:        schema.TypeString,
@@ -747,7 +760,6 @@ func Container() *schema.Resource {
 				Type:        schema.TypeList,
 				Description: "A mount point",
 				Optional:    true,
-				ForceNew:    true,
 				Elem: &schema.Resource{
 					Schema: map[string]*schema.Schema{
 						mkMountPointACL: {
@@ -807,7 +819,6 @@ func Container() *schema.Resource {
 							Description:      "Volume size (only used for volume mount points)",
 							Optional:         true,
 							Default:          dvMountPointSize,
-							ForceNew:         true,
 							ValidateDiagFunc: validators.FileSize(),
 						},
 						mkMountPointVolume: {
@@ -962,10 +973,11 @@ func Container() *schema.Resource {
 							Required:    true,
 						},
 						mkNetworkInterfaceRateLimit: {
-							Type:        schema.TypeFloat,
-							Description: "The rate limit in megabytes per second",
-							Optional:    true,
-							Default:     dvNetworkInterfaceRateLimit,
+							Type:             schema.TypeFloat,
+							Description:      "The rate limit in megabytes per second, `0` for unlimited",
+							Optional:         true,
+							Default:          dvNetworkInterfaceRateLimit,
+							ValidateDiagFunc: validation.ToDiagFunc(validation.FloatAtLeast(0)),
 						},
 						mkNetworkInterfaceVLANID: {
 							Type:        schema.TypeInt,
@@ -1006,9 +1018,9 @@ func Container() *schema.Resource {
 						},
 						mkOperatingSystemType: {
 							Type:             schema.TypeString,
-							Description:      "The type",
+							Description:      "The type, inferred from the OS template metadata when not set",
 							Optional:         true,
-							Default:          dvOperatingSystemType,
+							Computed:         true,
 							ValidateDiagFunc: OperatingSystemTypeValidator(),
 						},
 					},
@@ -1070,29 +1082,31 @@ func Container() *schema.Resource {
 				MinItems: 0,
 			},
 			mkStartOnBoot: {
-				Type:        schema.TypeBool,
-				Description: "Automatically start container when the host system boots.",
-				Optional:    true,
-				ForceNew:    false,
-				Default:     dvStartOnBoot,
+				Type: schema.TypeBool,
+				Description: "Whether to start the container when the host system boots, defaults to true for a new " +
+					"container and is read back from the container on import. Unlike `started`, this does not change " +
+					"the current power state of the container.",
+				Optional: true,
+				Computed: true,
 			},
 			mkTags: {
 				Type:        schema.TypeList,
 				Description: "Tags of the container. This is only meta information.",
 				Optional:    true,
 				Elem: &schema.Schema{
-					Type:         schema.TypeString,
-					ValidateFunc: validation.StringIsNotEmpty,
+					Type:             schema.TypeString,
+					ValidateDiagFunc: validators.Tag(),
 				},
 				DiffSuppressFunc:      structure.SuppressIfListsAreEqualIgnoringOrder,
 				DiffSuppressOnRefresh: true,
 			},
 			mkTemplate: {
-				Type:        schema.TypeBool,
-				Description: "Whether to create a template",
-				Optional:    true,
-				ForceNew:    true,
-				Default:     dvTemplate,
+				Type: schema.TypeBool,
+				Description: "Whether the container should be a template. Setting this from false to true converts " +
+					"an existing container to a template in place. A template can't be converted back to a regular " +
+					"container, so setting this from true to false recreates the container.",
+				Optional: true,
+				Default:  dvTemplate,
 			},
 			mkTimeoutCreate: {
 				Type:        schema.TypeInt,
@@ -1169,6 +1183,15 @@ func Container() *schema.Resource {
 		UpdateContext: containerUpdate,
 		DeleteContext: containerDelete,
 		CustomizeDiff: customdiff.All(
+			customdiff.ForceNewIf(
+				mkTemplate,
+				func(_ context.Context, d *schema.ResourceDiff, _ any) bool {
+					// a template can't be converted back to a regular container, so it has to be recreated
+					oldValue, newValue := d.GetChange(mkTemplate)
+
+					return oldValue.(bool) && !newValue.(bool)
+				},
+			),
 			customdiff.ForceNewIf(
 				mkVMID,
 				func(_ context.Context, d *schema.ResourceDiff, _ any) bool {
@@ -1226,6 +1249,25 @@ func Container() *schema.Resource {
 					return false
 				},
 			),
+			func(_ context.Context, d *schema.ResourceDiff, _ any) error {
+				features, _ := d.Get(mkFeatures).([]any)
+				if len(features) == 0 || features[0] == nil {
+					return nil
+				}
+
+				return containerCheckFeatures(d.Get(mkUnprivileged).(bool), features[0].(map[string]any))
+			},
+			// Force recreation on any mount point change, except growing a volume mount point
+			customdiff.ForceNewIf(
+				mkMountPoint,
+				func(_ context.Context, d *schema.ResourceDiff, _ any) bool {
+					oldRaw, newRaw := d.GetChange(mkMountPoint)
+					oldList, _ := oldRaw.([]any)
+					newList, _ := newRaw.([]any)
+
+					return containerMountPointsRequireReplacement(oldList, newList)
+				},
+			),
 		),
 		Importer: &schema.ResourceImporter{
 			StateContext: func(_ context.Context, d *schema.ResourceData, _ any) ([]*schema.ResourceData, error) {
@@ -1329,14 +1371,23 @@ func containerCreateClone(ctx context.Context, d *schema.ResourceData, m any) di
 		cloneBody.PoolID = &poolID
 	}
 
-	var cloneResult tasks.TaskResult
+	sourceNodeName := nodeName
 
 	if cloneNodeName != "" && cloneNodeName != nodeName {
 		cloneBody.TargetNodeName = &nodeName
+		sourceNodeName = cloneNodeName
+	}
 
-		cloneResult = client.Node(cloneNodeName).Container(cloneVMID).CloneContainer(ctx, cloneBody)
-	} else {
-		cloneResult = client.Node(nodeName).Container(cloneVMID).CloneContainer(ctx, cloneBody)
+	vmID, cloneResult := sdkresource.CreateWithVMID(ctx, config.GetIDGenerator(), vmID, !hasVMID,
+		func(ctx context.Context, id int) tasks.TaskResult {
+			cloneBody.VMIDNew = id
+
+			return client.Node(sourceNodeName).Container(cloneVMID).CloneContainer(ctx, cloneBody)
+		},
+	)
+
+	if e := d.Set(mkVMID, vmID); e != nil {
+		return diag.FromErr(e)
 	}
 
 	diags := sdkresource.TaskResultDiags(cloneResult, "Container clone")
@@ -1351,8 +1402,7 @@ func containerCreateClone(ctx context.Context, d *schema.ResourceData, m any) di
 	// Now that the virtual machine has been cloned, we need to perform some modifications.
 	updateBody := &containers.UpdateRequestBody{}
 
-	startOnBoot := types.CustomBool(d.Get(mkStartOnBoot).(bool))
-	updateBody.StartOnBoot = &startOnBoot
+	updateBody.StartOnBoot = containerGetStartOnBoot(d)
 
 	features, err := containerGetFeatures(Container(), d)
 	if err != nil {
@@ -1392,7 +1442,9 @@ func containerCreateClone(ctx context.Context, d *schema.ResourceData, m any) di
 		cpuLimit := cpuBlock[mkCPULimit].(float64)
 		cpuUnits := cpuBlock[mkCPUUnits].(int)
 
-		updateBody.CPUArchitecture = &cpuArchitecture
+		if cpuArchitecture != "" {
+			updateBody.CPUArchitecture = &cpuArchitecture
+		}
 		updateBody.CPUCores = &cpuCores
 
 		if cpuLimit > 0 {
@@ -1681,7 +1733,10 @@ func containerCreateClone(ctx context.Context, d *schema.ResourceData, m any) di
 		operatingSystemType := operatingSystemBlock[mkOperatingSystemType].(string)
 
 		updateBody.OSTemplateFileVolume = &operatingSystemTemplateFileID
-		updateBody.OSType = &operatingSystemType
+
+		if operatingSystemType != "" {
+			updateBody.OSType = &operatingSystemType
+		}
 	}
 
 	if len(tags) > 0 {
@@ -2177,10 +2232,35 @@ func containerCreateCustom(ctx context.Context, d *schema.ResourceData, m any) d
 	operatingSystemTemplateFileID := operatingSystemBlock[mkOperatingSystemTemplateFileID].(string)
 	operatingSystemType := operatingSystemBlock[mkOperatingSystemType].(string)
 
+	// Infer the OS type and CPU architecture from the template's appliance metadata when they are not set.
+	if operatingSystemType == "" || cpuArchitecture == "" {
+		metadata, err := containerInferTemplateMetadata(ctx, client, nodeName, operatingSystemTemplateFileID)
+
+		switch {
+		case err == nil && operatingSystemType == "":
+			operatingSystemType = metadata.OSType
+		case err != nil && operatingSystemType == "":
+			return diag.Errorf(
+				"unable to infer \"%s.%s\" from template %q: %s; please set it explicitly",
+				mkOperatingSystem,
+				mkOperatingSystemType,
+				operatingSystemTemplateFileID,
+				err,
+			)
+		}
+
+		if cpuArchitecture == "" {
+			if err == nil && metadata.Architecture != "" {
+				cpuArchitecture = metadata.Architecture
+			} else {
+				cpuArchitecture = dvCPUArchitecture
+			}
+		}
+	}
+
 	poolID := d.Get(mkPoolID).(string)
 	protection := types.CustomBool(d.Get(mkProtection).(bool))
 	started := types.CustomBool(d.Get(mkStarted).(bool))
-	startOnBoot := types.CustomBool(d.Get(mkStartOnBoot).(bool))
 	startupBehavior := containerGetStartupBehavior(d)
 	tags := d.Get(mkTags).([]any)
 	template := types.CustomBool(d.Get(mkTemplate).(bool))
@@ -2204,7 +2284,7 @@ func containerCreateCustom(ctx context.Context, d *schema.ResourceData, m any) d
 		Protection:           &protection,
 		RootFS:               rootFS,
 		Start:                &started,
-		StartOnBoot:          &startOnBoot,
+		StartOnBoot:          containerGetStartOnBoot(d),
 		StartupBehavior:      startupBehavior,
 		Swap:                 &memorySwap,
 		Template:             &template,
@@ -2262,7 +2342,17 @@ func containerCreateCustom(ctx context.Context, d *schema.ResourceData, m any) d
 		createBody.EnvironmentVariables = environmentVariables
 	}
 
-	createResult := client.Node(nodeName).Container(0).CreateContainer(ctx, &createBody)
+	vmID, createResult := sdkresource.CreateWithVMID(ctx, config.GetIDGenerator(), vmID, !hasVMID,
+		func(ctx context.Context, id int) tasks.TaskResult {
+			createBody.VMID = &id
+
+			return client.Node(nodeName).Container(0).CreateContainer(ctx, &createBody)
+		},
+	)
+
+	if e := d.Set(mkVMID, vmID); e != nil {
+		return diag.FromErr(e)
+	}
 
 	diags := sdkresource.TaskResultDiags(createResult, "Container create")
 	if diags.HasError() {
@@ -2352,6 +2442,12 @@ func supportContainerHostManaged(ctx context.Context, client proxmox.Client) boo
 	return ver.SupportContainerHostManaged()
 }
 
+// containerGetStartOnBoot returns the configured start_on_boot value, or true for a new container without it.
+// The value configured on an existing container is only preserved on read and import.
+func containerGetStartOnBoot(d *schema.ResourceData) *types.CustomBool {
+	return ptr.Or(structure.GetConfiguredBool(d, mkStartOnBoot), types.CustomBool(true)).Pointer()
+}
+
 func containerGetEnvironmentVariables(d *schema.ResourceData) *containers.CustomEnvironmentVariables {
 	envVarsRaw := d.Get(mkEnvironmentVariables).(map[string]any)
 	if len(envVarsRaw) == 0 {
@@ -2509,6 +2605,102 @@ func containerGetMountPointsMap(mountPoint []any) (containers.CustomMountPoints,
 	return mountPointsMap, nil
 }
 
+// containerCheckFeatures validates the features against the container privilege level.
+func containerCheckFeatures(unprivileged bool, features map[string]any) error {
+	if unprivileged {
+		return nil
+	}
+
+	for _, k := range []string{mkFeaturesKeyControl, mkFeaturesForceRWSys} {
+		if enabled, _ := features[k].(bool); enabled {
+			return fmt.Errorf("the %s.%s feature is only supported by unprivileged containers", mkFeatures, k)
+		}
+	}
+
+	return nil
+}
+
+// containerMountPointIsBindMount reports whether the mount point volume is a host path or device.
+func containerMountPointIsBindMount(volume string) bool {
+	return strings.HasPrefix(volume, "/")
+}
+
+// containerMountPointsRequireReplacement reports whether the mount point change has to recreate the container.
+// The only change applied in place is growing the size of a volume mount point.
+func containerMountPointsRequireReplacement(oldList, newList []any) bool {
+	if len(oldList) != len(newList) {
+		return true
+	}
+
+	for i := range newList {
+		oldMap, _ := oldList[i].(map[string]any)
+		newMap, _ := newList[i].(map[string]any)
+
+		for k, newValue := range newMap {
+			if k == mkMountPointSize || k == mkMountPointPathInDatastore {
+				continue
+			}
+
+			if !reflect.DeepEqual(oldMap[k], newValue) {
+				return true
+			}
+		}
+
+		oldSize, _ := oldMap[mkMountPointSize].(string)
+		newSize, _ := newMap[mkMountPointSize].(string)
+
+		if oldSize == newSize {
+			continue
+		}
+
+		volume, _ := newMap[mkMountPointVolume].(string)
+		if containerMountPointIsBindMount(volume) {
+			return true
+		}
+
+		oldDS, err := types.ParseDiskSize(oldSize)
+		if err != nil {
+			return true
+		}
+
+		newDS, err := types.ParseDiskSize(newSize)
+		if err != nil || newDS.InMegabytes() < oldDS.InMegabytes() {
+			return true
+		}
+	}
+
+	return false
+}
+
+// containerMountPointResizes returns the resize requests for the volume mount points that have grown.
+func containerMountPointResizes(oldList, newList []any) ([]*containers.ResizeRequestBody, error) {
+	var resizes []*containers.ResizeRequestBody
+
+	for i := range min(len(oldList), len(newList)) {
+		oldMap, _ := oldList[i].(map[string]any)
+		newMap, _ := newList[i].(map[string]any)
+
+		oldSize, _ := oldMap[mkMountPointSize].(string)
+		newSize, _ := newMap[mkMountPointSize].(string)
+
+		if oldSize == newSize || newSize == "" {
+			continue
+		}
+
+		ds, err := types.ParseDiskSize(newSize)
+		if err != nil {
+			return nil, fmt.Errorf("invalid mount point size: %w", err)
+		}
+
+		resizes = append(resizes, &containers.ResizeRequestBody{
+			Disk: fmt.Sprintf("mp%d", i),
+			Size: ds.String(),
+		})
+	}
+
+	return resizes, nil
+}
+
 func containerGetStartupBehavior(d *schema.ResourceData) *containers.CustomStartupBehavior {
 	startup := d.Get(mkStartup).([]any)
 	if len(startup) > 0 && startup[0] != nil {
@@ -2568,6 +2760,7 @@ func containerGetFeaturesInternal(
 	fuse := types.CustomBool(featuresBlock[mkFeaturesFUSE].(bool))
 	mountTypes := featuresBlock[mkFeaturesMountTypes].([]any)
 	mknod := types.CustomBool(featuresBlock[mkFeaturesMakeDeviceNode].(bool))
+	forceRWSys := types.CustomBool(featuresBlock[mkFeaturesForceRWSys].(bool))
 
 	var mountTypesConverted []string
 	if mountTypes != nil {
@@ -2599,6 +2792,10 @@ func containerGetFeaturesInternal(
 		features.MakeDeviceNode = &mknod
 	}
 
+	if bool(forceRWSys) || emitFalseBools {
+		features.ForceRWSys = &forceRWSys
+	}
+
 	return &features, nil
 }
 
@@ -2909,12 +3106,19 @@ func containerRead(ctx context.Context, d *schema.ResourceData, m any) diag.Diag
 		} else {
 			features[mkFeaturesMakeDeviceNode] = dvFeaturesMakeDeviceNode
 		}
+
+		if containerConfig.Features.ForceRWSys != nil {
+			features[mkFeaturesForceRWSys] = bool(*containerConfig.Features.ForceRWSys)
+		} else {
+			features[mkFeaturesForceRWSys] = dvFeaturesForceRWSys
+		}
 	} else {
 		features[mkFeaturesNesting] = dvFeaturesNesting
 		features[mkFeaturesKeyControl] = dvFeaturesKeyControl
 		features[mkFeaturesFUSE] = dvFeaturesFUSE
 		features[mkFeaturesMountTypes] = []string{}
 		features[mkFeaturesMakeDeviceNode] = dvFeaturesMakeDeviceNode
+		features[mkFeaturesForceRWSys] = dvFeaturesForceRWSys
 	}
 
 	currentFeatures := d.Get(mkFeatures).([]any)
@@ -2929,7 +3133,8 @@ func containerRead(ctx context.Context, d *schema.ResourceData, m any) diag.Diag
 		features[mkFeaturesKeyControl] != dvFeaturesKeyControl ||
 		features[mkFeaturesFUSE] != dvFeaturesFUSE ||
 		len(features[mkFeaturesMountTypes].([]string)) > 0 ||
-		features[mkFeaturesMakeDeviceNode] != dvFeaturesMakeDeviceNode {
+		features[mkFeaturesMakeDeviceNode] != dvFeaturesMakeDeviceNode ||
+		features[mkFeaturesForceRWSys] != dvFeaturesForceRWSys {
 		err := d.Set(mkFeatures, []any{features})
 		diags = append(diags, diag.FromErr(err)...)
 	}
@@ -3208,7 +3413,7 @@ func containerRead(ctx context.Context, d *schema.ResourceData, m any) diag.Diag
 		if nv.RateLimit != nil {
 			networkInterface[mkNetworkInterfaceRateLimit] = *nv.RateLimit
 		} else {
-			networkInterface[mkNetworkInterfaceRateLimit] = 0
+			networkInterface[mkNetworkInterfaceRateLimit] = float64(0)
 		}
 
 		if nv.Tag != nil {
@@ -3393,18 +3598,9 @@ func containerRead(ctx context.Context, d *schema.ResourceData, m any) diag.Diag
 		diags = append(diags, diag.FromErr(e)...)
 	}
 
-	currentStartOnBoot := types.CustomBool(d.Get(mkStartOnBoot).(bool))
-
-	if len(clone) == 0 || !currentStartOnBoot {
-		if containerConfig.StartOnBoot != nil {
-			e = d.Set(mkStartOnBoot, bool(*containerConfig.StartOnBoot))
-		} else {
-			// PVE returns nil for onboot when it is unset (false in practice).
-			e = d.Set(mkStartOnBoot, false)
-		}
-
-		diags = append(diags, diag.FromErr(e)...)
-	}
+	// PVE returns nil for onboot when it is unset (false in practice).
+	e = d.Set(mkStartOnBoot, bool(ptr.Or(containerConfig.StartOnBoot, false)))
+	diags = append(diags, diag.FromErr(e)...)
 
 	currentHookScript := d.Get(mkHookScriptFileID).(string)
 
@@ -3449,20 +3645,8 @@ func containerRead(ctx context.Context, d *schema.ResourceData, m any) diag.Diag
 	e = d.Set(mkEnvironmentVariables, envVarsMap)
 	diags = append(diags, diag.FromErr(e)...)
 
-	template := d.Get(mkTemplate).(bool)
-
-	if len(clone) == 0 || template {
-		if containerConfig.Template != nil {
-			e = d.Set(
-				mkTemplate,
-				bool(*containerConfig.Template),
-			)
-		} else {
-			e = d.Set(mkTemplate, false)
-		}
-
-		diags = append(diags, diag.FromErr(e)...)
-	}
+	e = d.Set(mkTemplate, bool(ptr.Or(containerConfig.Template, false)))
+	diags = append(diags, diag.FromErr(e)...)
 
 	// Determine the state of the container in order to update the "started" argument.
 	status, e := containerAPI.GetContainerStatus(ctx)
@@ -3554,6 +3738,8 @@ func containerUpdate(ctx context.Context, d *schema.ResourceData, m any) diag.Di
 	bodyDirty := false
 
 	rebootRequired := false
+	liveResourcesChanged := false
+	featuresChanged := false
 	container := Container()
 
 	// Retrieve the clone argument as the update logic varies for clones.
@@ -3566,12 +3752,6 @@ func containerUpdate(ctx context.Context, d *schema.ResourceData, m any) diag.Di
 		bodyDirty = true
 	}
 
-	if d.HasChange(mkTemplate) {
-		template := types.CustomBool(d.Get(mkTemplate).(bool))
-		updateBody.Template = &template
-		bodyDirty = true
-	}
-
 	// Prepare the new console configuration.
 	if d.HasChange(mkConsole) {
 		consoleBlock, err := structure.GetSchemaBlock(
@@ -3617,7 +3797,9 @@ func containerUpdate(ctx context.Context, d *schema.ResourceData, m any) diag.Di
 		cpuLimit := cpuBlock[mkCPULimit].(float64)
 		cpuUnits := cpuBlock[mkCPUUnits].(int)
 
-		updateBody.CPUArchitecture = &cpuArchitecture
+		if cpuArchitecture != "" {
+			updateBody.CPUArchitecture = &cpuArchitecture
+		}
 		updateBody.CPUCores = &cpuCores
 
 		if cpuLimit > 0 {
@@ -3630,6 +3812,7 @@ func containerUpdate(ctx context.Context, d *schema.ResourceData, m any) diag.Di
 			updateBody.CPUUnits = &cpuUnits
 		}
 
+		liveResourcesChanged = true
 		bodyDirty = true
 	}
 
@@ -3732,6 +3915,7 @@ func containerUpdate(ctx context.Context, d *schema.ResourceData, m any) diag.Di
 		}
 
 		updateBody.Features = features
+		featuresChanged = true
 		bodyDirty = true
 	}
 
@@ -3882,7 +4066,8 @@ func containerUpdate(ctx context.Context, d *schema.ResourceData, m any) diag.Di
 		updateBody.DedicatedMemory = &memoryDedicated
 		updateBody.Swap = &memorySwap
 
-		rebootRequired = true
+		// Memory and swap are cgroup limits that PVE applies live on a running container.
+		liveResourcesChanged = true
 		bodyDirty = true
 	}
 
@@ -3932,21 +4117,26 @@ func containerUpdate(ctx context.Context, d *schema.ResourceData, m any) diag.Di
 		rebootRequired = true
 	}
 
-	// Prepare the new mount point configuration.
+	// Grow the volume mount points, any other mount point change recreates the container.
 	if d.HasChange(mkMountPoint) {
-		_, newMountPoints := d.GetChange(mkMountPoint)
+		oldMountPoints, newMountPoints := d.GetChange(mkMountPoint)
 
-		mountPoints := newMountPoints.([]any)
-
-		mountPointsMap, err := containerGetMountPointsMap(mountPoints)
+		resizes, err := containerMountPointResizes(oldMountPoints.([]any), newMountPoints.([]any))
 		if err != nil {
 			return diag.FromErr(err)
 		}
 
-		updateBody.MountPoints = mountPointsMap
+		for _, resize := range resizes {
+			resizeDiags := sdkresource.TaskResultDiags(
+				containerAPI.ResizeContainerDisk(ctx, resize),
+				"Container mount point resize",
+			)
+			if resizeDiags.HasError() {
+				return resizeDiags
+			}
 
-		rebootRequired = true
-		bodyDirty = true
+			updateDiags = append(updateDiags, resizeDiags...)
+		}
 	}
 
 	// Prepare the new network interface configuration.
@@ -4072,9 +4262,9 @@ func containerUpdate(ctx context.Context, d *schema.ResourceData, m any) diag.Di
 			return diag.FromErr(err)
 		}
 
-		operatingSystemType := operatingSystem[mkOperatingSystemType].(string)
-
-		updateBody.OSType = &operatingSystemType
+		if operatingSystemType, ok := operatingSystem[mkOperatingSystemType].(string); ok && operatingSystemType != "" {
+			updateBody.OSType = &operatingSystemType
+		}
 
 		rebootRequired = true
 		bodyDirty = true
@@ -4126,6 +4316,28 @@ func containerUpdate(ctx context.Context, d *schema.ResourceData, m any) diag.Di
 	started := d.Get(mkStarted).(bool)
 	template := d.Get(mkTemplate).(bool)
 
+	// A template can only be created from a stopped container.
+	if d.HasChange(mkTemplate) && template {
+		status, err := containerAPI.GetContainerStatus(ctx)
+		if err != nil {
+			return diag.FromErr(err)
+		}
+
+		if status.Status != "stopped" {
+			updateDiags = append(updateDiags, containerShutdown(ctx, containerAPI, d)...)
+			if updateDiags.HasError() {
+				return updateDiags
+			}
+		}
+
+		convertDiags := sdkresource.TaskResultDiags(containerAPI.ConvertToTemplate(ctx), "Container convert to template")
+		if convertDiags.HasError() {
+			return convertDiags
+		}
+
+		updateDiags = append(updateDiags, convertDiags...)
+	}
+
 	if d.HasChange(mkStarted) && !template {
 		if started {
 			updateDiags = sdkresource.TaskResultDiags(containerAPI.StartContainer(ctx), "Container start")
@@ -4133,32 +4345,29 @@ func containerUpdate(ctx context.Context, d *schema.ResourceData, m any) diag.Di
 				return updateDiags
 			}
 		} else {
-			forceStop := types.CustomBool(true)
-			// Using delete timeout here as we're in the similar situation
-			// as in the delete function, where we need to wait for the container
-			// to be stopped before we can proceed with the update.
-			// see `containerDelete` function for more details about the logic here
-			// Needs to be refactored to a common function
-			shutdownTimeoutSec := max(1, d.Get(mkTimeoutDelete).(int)-5)
-
-			shutdownDiags := sdkresource.TaskResultDiags(containerAPI.ShutdownContainer(ctx, &containers.ShutdownRequestBody{
-				ForceStop: &forceStop,
-				Timeout:   &shutdownTimeoutSec,
-			}), "Container shutdown")
-			if shutdownDiags.HasError() {
-				return shutdownDiags
-			}
-
-			updateDiags = append(updateDiags, shutdownDiags...)
-
-			if e = containerAPI.WaitForContainerStatus(ctx, "stopped"); e != nil {
-				return append(updateDiags, diag.FromErr(e)...)
+			updateDiags = append(updateDiags, containerShutdown(ctx, containerAPI, d)...)
+			if updateDiags.HasError() {
+				return updateDiags
 			}
 
 			rebootRequired = false
 		}
 	}
 
+	// Features are only applied on the next container start.
+	if !template && started && !d.HasChange(mkStarted) && featuresChanged && !rebootRequired {
+		if config.RebootOnChange() {
+			rebootRequired = true
+		} else {
+			updateDiags = append(updateDiags, diag.Diagnostic{
+				Severity: diag.Warning,
+				Summary:  "Container features change not applied live",
+				Detail: "The new features will take effect after the container is restarted, " +
+					"set 'reboot_on_change = true' in the provider configuration to restart it automatically",
+			})
+		}
+	}
+
 	// As a final step in the update procedure, we might need to reboot the container.
 	if !template && started && rebootRequired {
 		rebootTimeout := 300
@@ -4174,11 +4383,113 @@ func containerUpdate(ctx context.Context, d *schema.ResourceData, m any) diag.Di
 		}
 
 		updateDiags = append(updateDiags, rebootDiags...)
+	} else if !template && started && !d.HasChange(mkStarted) && liveResourcesChanged {
+		updateDiags = append(updateDiags, containerCheckLiveResources(ctx, containerAPI, d)...)
 	}
 
 	return append(updateDiags, containerRead(ctx, d, m)...)
 }
 
+// containerShutdown shuts the container down, and force stops it after the delete timeout.
+func containerShutdown(ctx context.Context, containerAPI *containers.Client, d *schema.ResourceData) diag.Diagnostics {
+	forceStop := types.CustomBool(true)
+	// Using delete timeout here as we're in the similar situation
+	// as in the delete function, where we need to wait for the container
+	// to be stopped before we can proceed with the update.
+	// see `containerDelete` function for more details about the logic here
+	shutdownTimeoutSec := max(1, d.Get(mkTimeoutDelete).(int)-5)
+
+	diags := sdkresource.TaskResultDiags(containerAPI.ShutdownContainer(ctx, &containers.ShutdownRequestBody{
+		ForceStop: &forceStop,
+		Timeout:   &shutdownTimeoutSec,
+	}), "Container shutdown")
+	if diags.HasError() {
+		return diags
+	}
+
+	if err := containerAPI.WaitForContainerStatus(ctx, "stopped"); err != nil {
+		return append(diags, diag.FromErr(err)...)
+	}
+
+	return diags
+}
+
+// containerCheckLiveResources warns about the CPU and memory limits PVE could not apply
+// live to a running container (e.g. lowering the memory below the current usage).
+// Such values stay pending until the next container restart.
+func containerCheckLiveResources(
+	ctx context.Context,
+	containerAPI *containers.Client,
+	d *schema.ResourceData,
+) diag.Diagnostics {
+	container := Container()
+
+	cpuBlock, err := structure.GetSchemaBlock(container, d, []string{mkCPU}, 0, true)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	memoryBlock, err := structure.GetSchemaBlock(container, d, []string{mkMemory}, 0, true)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	status, err := containerAPI.GetContainerStatus(ctx)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	var diags diag.Diagnostics
+
+	for _, msg := range containerPendingResources(
+		status,
+		cpuBlock[mkCPUCores].(int),
+		memoryBlock[mkMemoryDedicated].(int),
+		memoryBlock[mkMemorySwap].(int),
+	) {
+		diags = append(diags, diag.Diagnostic{
+			Severity: diag.Warning,
+			Summary:  "Container resource change not applied live",
+			Detail:   msg + ", the new value will take effect after the container is restarted",
+		})
+	}
+
+	return diags
+}
+
+// containerPendingResources describes every configured resource limit that does not match
+// the limit reported by the running container.
+func containerPendingResources(
+	status *containers.GetStatusResponseData,
+	cores int,
+	dedicatedMemory int,
+	swap int,
+) []string {
+	var pending []string
+
+	if status.CPUCount != nil && int(*status.CPUCount) != cores {
+		pending = append(pending, fmt.Sprintf(
+			"cpu cores are %d instead of the configured %d", int(*status.CPUCount), cores,
+		))
+	}
+
+	if status.MemoryAllocation != nil && *status.MemoryAllocation != int64(dedicatedMemory)*1024*1024 {
+		pending = append(pending, fmt.Sprintf(
+			"dedicated memory is %d MiB instead of the configured %d MiB",
+			*status.MemoryAllocation/(1024*1024), dedicatedMemory,
+		))
+	}
+
+	if status.SwapAllocation != nil && *status.SwapAllocation != int64(swap)*1024*1024 {
+		pending = append(pending, fmt.Sprintf(
+			"swap is %d MiB instead of the configured %d MiB",
+			*status.SwapAllocation/(1024*1024), swap,
+		))
+	}
+
+	return pending
+}
+
 func containerDelete(ctx context.Context, d *schema.ResourceData, m any) diag.Diagnostics {
 	deleteTimeoutSec := d.Get(mkTimeoutDelete).(int)
 
@@ -4201,6 +4512,23 @@ func containerDelete(ctx context.Context, d *schema.ResourceData, m any) diag.Di
 
 	containerAPI := client.Node(nodeName).Container(vmID)
 
+	// Proxmox VE rejects the removal of a protected container, clear the flag first when forced to.
+	if d.Get(mkProtection).(bool) {
+		if !config.ForceDelete() {
+			return diag.Errorf(
+				"container %d has the protection flag set and cannot be deleted, set `protection = false` and "+
+					"apply the change before destroying it, or enable `force_delete` in the provider configuration",
+				vmID,
+			)
+		}
+
+		protection := types.CustomBool(false)
+
+		if e := containerAPI.UpdateContainer(ctx, &containers.UpdateRequestBody{Protection: &protection}); e != nil {
+			return diag.Errorf("failed to clear the protection flag of container %d: %s", vmID, e)
+		}
+	}
+
 	// Shut down the container before deleting it.
 	status, err := containerAPI.GetContainerStatus(ctx)
 	if err != nil {
@@ -14,6 +14,7 @@ import (
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
 
+	"github.com/bpg/terraform-provider-proxmox/proxmox/nodes/containers"
 	"github.com/bpg/terraform-provider-proxmox/proxmoxtf/test"
 )
 
@@ -136,6 +137,7 @@ func TestContainerSchema(t *testing.T) {
 		mkFeaturesKeyControl,
 		mkFeaturesFUSE,
 		mkFeaturesMakeDeviceNode,
+		mkFeaturesForceRWSys,
 	})
 
 	test.AssertValueTypes(t, featuresSchema, map[string]schema.ValueType{
@@ -143,6 +145,7 @@ func TestContainerSchema(t *testing.T) {
 		mkFeaturesKeyControl:     schema.TypeBool,
 		mkFeaturesFUSE:           schema.TypeBool,
 		mkFeaturesMakeDeviceNode: schema.TypeBool,
+		mkFeaturesForceRWSys:     schema.TypeBool,
 	})
 
 	initializationSchema := test.AssertNestedSchemaExistence(
@@ -442,3 +445,177 @@ func TestInitializationDnsBlockDiffIgnore(t *testing.T) {
 		assert.Equal(t, tt.expected, actual)
 	}
 }
+
+func TestContainerPendingResources(t *testing.T) {
+	t.Parallel()
+
+	const mib = int64(1024 * 1024)
+
+	cpus := func(v float64) *float64 { return &v }
+	bytes := func(v int64) *int64 { return &v }
+
+	tests := []struct {
+		name     string
+		status   containers.GetStatusResponseData
+		expected int
+	}{
+		{
+			name: "all limits applied",
+			status: containers.GetStatusResponseData{
+				CPUCount:         cpus(4),
+				MemoryAllocation: bytes(1024 * mib),
+				SwapAllocation:   bytes(512 * mib),
+			},
+		},
+		{
+			name: "memory still pending",
+			status: containers.GetStatusResponseData{
+				CPUCount:         cpus(4),
+				MemoryAllocation: bytes(2048 * mib),
+				SwapAllocation:   bytes(512 * mib),
+			},
+			expected: 1,
+		},
+		{
+			name: "cores and swap still pending",
+			status: containers.GetStatusResponseData{
+				CPUCount:         cpus(2),
+				MemoryAllocation: bytes(1024 * mib),
+				SwapAllocation:   bytes(0),
+			},
+			expected: 2,
+		},
+		{
+			name:   "limits not reported",
+			status: containers.GetStatusResponseData{},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			pending := containerPendingResources(&tt.status, 4, 1024, 512)
+			assert.Len(t, pending, tt.expected)
+		})
+	}
+}
+
+func TestContainerCheckFeatures(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name         string
+		unprivileged bool
+		features     map[string]any
+		wantErr      bool
+	}{
+		{"docker in unprivileged", true, map[string]any{mkFeaturesNesting: true, mkFeaturesKeyControl: true}, false},
+		{"force_rw_sys in unprivileged", true, map[string]any{mkFeaturesForceRWSys: true}, false},
+		{"nesting in privileged", false, map[string]any{mkFeaturesNesting: true, mkFeaturesFUSE: true}, false},
+		{"keyctl in privileged", false, map[string]any{mkFeaturesKeyControl: true}, true},
+		{"force_rw_sys in privileged", false, map[string]any{mkFeaturesForceRWSys: true}, true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			err := containerCheckFeatures(tt.unprivileged, tt.features)
+			if tt.wantErr {
+				require.Error(t, err)
+			} else {
+				require.NoError(t, err)
+			}
+		})
+	}
+}
+
+func TestContainerMountPointsRequireReplacement(t *testing.T) {
+	t.Parallel()
+
+	mountPoint := func(volume, path, size string) map[string]any {
+		return map[string]any{
+			mkMountPointACL:       false,
+			mkMountPointBackup:    true,
+			mkMountPointPath:      path,
+			mkMountPointReadOnly:  false,
+			mkMountPointSize:      size,
+			mkMountPointVolume:    volume,
+			mkMountPointShared:    false,
+			mkMountPointReplicate: true,
+		}
+	}
+
+	tests := []struct {
+		name    string
+		oldList []any
+		newList []any
+		want    bool
+	}{
+		{
+			name:    "no change",
+			oldList: []any{mountPoint("local-lvm:vm-100-disk-1", "/data", "10G")},
+			newList: []any{mountPoint("local-lvm:vm-100-disk-1", "/data", "10G")},
+			want:    false,
+		},
+		{
+			name:    "grow volume",
+			oldList: []any{mountPoint("local-lvm:vm-100-disk-1", "/data", "10G")},
+			newList: []any{mountPoint("local-lvm:vm-100-disk-1", "/data", "20G")},
+			want:    false,
+		},
+		{
+			name:    "shrink volume",
+			oldList: []any{mountPoint("local-lvm:vm-100-disk-1", "/data", "20G")},
+			newList: []any{mountPoint("local-lvm:vm-100-disk-1", "/data", "10G")},
+			want:    true,
+		},
+		{
+			name:    "size on bind mount",
+			oldList: []any{mountPoint("/mnt/shared", "/shared", "")},
+			newList: []any{mountPoint("/mnt/shared", "/shared", "10G")},
+			want:    true,
+		},
+		{
+			name:    "path change",
+			oldList: []any{mountPoint("local-lvm:vm-100-disk-1", "/data", "10G")},
+			newList: []any{mountPoint("local-lvm:vm-100-disk-1", "/srv", "10G")},
+			want:    true,
+		},
+		{
+			name:    "mount point added",
+			oldList: []any{mountPoint("local-lvm:vm-100-disk-1", "/data", "10G")},
+			newList: []any{
+				mountPoint("local-lvm:vm-100-disk-1", "/data", "10G"),
+				mountPoint("/mnt/shared", "/shared", ""),
+			},
+			want: true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			assert.Equal(t, tt.want, containerMountPointsRequireReplacement(tt.oldList, tt.newList))
+		})
+	}
+}
+
+func TestContainerMountPointResizes(t *testing.T) {
+	t.Parallel()
+
+	oldList := []any{
+		map[string]any{mkMountPointVolume: "local-lvm:vm-100-disk-1", mkMountPointSize: "10G"},
+		map[string]any{mkMountPointVolume: "local-lvm:vm-100-disk-2", mkMountPointSize: "4G"},
+	}
+	newList := []any{
+		map[string]any{mkMountPointVolume: "local-lvm:vm-100-disk-1", mkMountPointSize: "10G"},
+		map[string]any{mkMountPointVolume: "local-lvm:vm-100-disk-2", mkMountPointSize: "8G"},
+	}
+
+	resizes, err := containerMountPointResizes(oldList, newList)
+	require.NoError(t, err)
+	assert.Equal(t, []*containers.ResizeRequestBody{{Disk: "mp1", Size: "8G"}}, resizes)
+}
@@ -0,0 +1,92 @@
+/*
+ * This Source Code Form is subject to the terms of the Mozilla Public
+ * License, v. 2.0. If a copy of the MPL was not distributed with this
+ * file, You can obtain one at https://mozilla.org/MPL/2.0/.
+ */
+
+package resource
+
+import (
+	"context"
+	"path"
+	"slices"
+	"strings"
+
+	"github.com/bpg/terraform-provider-proxmox/proxmox"
+	"github.com/bpg/terraform-provider-proxmox/proxmox/nodes"
+)
+
+// applianceOSTypes maps the distribution prefix of an appliance `os` field to the matching container OS type.
+//
+//nolint:gochecknoglobals
+var applianceOSTypes = map[string]string{
+	"almalinux":  "centos",
+	"alpine":     "alpine",
+	"archlinux":  "archlinux",
+	"centos":     "centos",
+	"debian":     "debian",
+	"devuan":     "devuan",
+	"fedora":     "fedora",
+	"gentoo":     "gentoo",
+	"nixos":      "nixos",
+	"opensuse":   "opensuse",
+	"rockylinux": "centos",
+	"ubuntu":     "ubuntu",
+}
+
+// containerTemplateMetadata holds the container settings inferred from a template's appliance metadata.
+type containerTemplateMetadata struct {
+	OSType       string
+	Architecture string
+}
+
+// containerInferTemplateMetadata looks up the OS template in the node's appliance index (aplinfo) and
+// derives the container OS type and CPU architecture from it.
+func containerInferTemplateMetadata(
+	ctx context.Context,
+	client proxmox.Client,
+	nodeName string,
+	templateFileID string,
+) (*containerTemplateMetadata, error) {
+	templates, err := client.Node(nodeName).ListApplianceTemplates(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	return containerTemplateMetadataFromAppliances(templates, templateFileID), nil
+}
+
+// containerTemplateMetadataFromAppliances finds the appliance matching the template file and
+// maps its metadata to container settings. Templates that are not listed in the index, or whose
+// distribution is not supported, are `unmanaged`.
+func containerTemplateMetadataFromAppliances(
+	templates []*nodes.ApplianceTemplateListResponseData,
+	templateFileID string,
+) *containerTemplateMetadata {
+	_, volume, _ := strings.Cut(templateFileID, ":")
+	fileName := path.Base(volume)
+
+	for _, t := range templates {
+		if t == nil || t.Template != fileName {
+			continue
+		}
+
+		distribution, _, _ := strings.Cut(t.OS, "-")
+
+		osType, ok := applianceOSTypes[strings.ToLower(distribution)]
+		if !ok {
+			osType = dvOperatingSystemType
+		}
+
+		metadata := &containerTemplateMetadata{OSType: osType}
+
+		if t.Architecture != nil && slices.Contains([]string{"amd64", "arm64", "armhf", "i386"}, *t.Architecture) {
+			metadata.Architecture = *t.Architecture
+		}
+
+		return metadata
+	}
+
+	// custom-built templates are not listed in the index, keep them unmanaged
+	return &containerTemplateMetadata{OSType: dvOperatingSystemType}
+}
@@ -0,0 +1,50 @@
+/*
+ * This Source Code Form is subject to the terms of the Mozilla Public
+ * License, v. 2.0. If a copy of the MPL was not distributed with this
+ * file, You can obtain one at https://mozilla.org/MPL/2.0/.
+ */
+
+package resource
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/bpg/terraform-provider-proxmox/proxmox/nodes"
+)
+
+func TestContainerTemplateMetadataFromAppliances(t *testing.T) {
+	t.Parallel()
+
+	templates := []*nodes.ApplianceTemplateListResponseData{
+		{OS: "debian-12", Template: "debian-12-standard_12.7-1_amd64.tar.zst", Architecture: new("amd64")},
+		{OS: "rockylinux-9", Template: "rockylinux-9-default_20240912_amd64.tar.xz", Architecture: new("amd64")},
+		{OS: "alpine-3.20", Template: "alpine-3.20-default_20240908_arm64.tar.xz", Architecture: new("arm64")},
+		{OS: "mail", Template: "proxmox-mailgateway-8.1-standard_8.1-1_amd64.tar.zst"},
+		nil,
+	}
+
+	tests := []struct {
+		name         string
+		templateID   string
+		expectedOS   string
+		expectedArch string
+	}{
+		{"debian", "local:vztmpl/debian-12-standard_12.7-1_amd64.tar.zst", "debian", "amd64"},
+		{"rocky maps to centos", "nfs:vztmpl/rockylinux-9-default_20240912_amd64.tar.xz", "centos", "amd64"},
+		{"arm64 architecture", "local:vztmpl/alpine-3.20-default_20240908_arm64.tar.xz", "alpine", "arm64"},
+		{"unsupported os", "local:vztmpl/proxmox-mailgateway-8.1-standard_8.1-1_amd64.tar.zst", "unmanaged", ""},
+		{"not in the index", "local:vztmpl/my-custom-image.tar.gz", "unmanaged", ""},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			metadata := containerTemplateMetadataFromAppliances(templates, tt.templateID)
+			assert.Equal(t, tt.expectedOS, metadata.OSType)
+			assert.Equal(t, tt.expectedArch, metadata.Architecture)
+		})
+	}
+}
@@ -9,10 +9,13 @@ package resource
 import (
 	"bytes"
 	"context"
+	"crypto/md5"
 	"crypto/sha256"
+	"crypto/sha512"
 	"crypto/tls"
 	"errors"
 	"fmt"
+	"hash"
 	"io"
 	"net/http"
 	"net/url"
@@ -32,6 +35,7 @@ import (
 
 	"github.com/bpg/terraform-provider-proxmox/proxmox"
 	"github.com/bpg/terraform-provider-proxmox/proxmox/api"
+	"github.com/bpg/terraform-provider-proxmox/proxmox/ssh"
 	"github.com/bpg/terraform-provider-proxmox/proxmox/storage"
 	"github.com/bpg/terraform-provider-proxmox/proxmox/version"
 	"github.com/bpg/terraform-provider-proxmox/proxmoxtf"
@@ -40,37 +44,43 @@ import (
 )
 
 const (
-	dvResourceVirtualEnvironmentFileSourceFileChanged  = false
-	dvResourceVirtualEnvironmentFileSourceFileChecksum = ""
-	dvResourceVirtualEnvironmentFileSourceFileFileName = ""
-	dvResourceVirtualEnvironmentFileSourceFileInsecure = false
-	dvResourceVirtualEnvironmentFileSourceFileMinTLS   = ""
-	dvResourceVirtualEnvironmentFileOverwrite          = true
-	dvResourceVirtualEnvironmentFileSourceRawResize    = 0
-	dvResourceVirtualEnvironmentFileTimeoutUpload      = 1800
-
-	mkResourceVirtualEnvironmentFileContentType          = "content_type"
-	mkResourceVirtualEnvironmentFileDatastoreID          = "datastore_id"
-	mkResourceVirtualEnvironmentFileFileModificationDate = "file_modification_date"
-	mkResourceVirtualEnvironmentFileFileName             = "file_name"
-	mkResourceVirtualEnvironmentFileFileMode             = "file_mode"
-	mkResourceVirtualEnvironmentFileFileSize             = "file_size"
-	mkResourceVirtualEnvironmentFileFileTag              = "file_tag"
-	mkResourceVirtualEnvironmentFileNodeName             = "node_name"
-	mkResourceVirtualEnvironmentFileOverwrite            = "overwrite"
-	mkResourceVirtualEnvironmentFileSourceFile           = "source_file"
-	mkResourceVirtualEnvironmentFileSourceFilePath       = "path"
-	mkResourceVirtualEnvironmentFileSourceFileChanged    = "changed"
-	mkResourceVirtualEnvironmentFileSourceFileChecksum   = "checksum"
-	mkResourceVirtualEnvironmentFileSourceFileFileName   = "file_name"
-	mkResourceVirtualEnvironmentFileSourceFileInsecure   = "insecure"
-	mkResourceVirtualEnvironmentFileSourceFileMinTLS     = "min_tls"
-	mkResourceVirtualEnvironmentFileSourceRaw            = "source_raw"
-	mkResourceVirtualEnvironmentFileSourceRawData        = "data"
-	mkResourceVirtualEnvironmentFileSourceRawFileName    = "file_name"
-	mkResourceVirtualEnvironmentFileSourceRawResize      = "resize"
-	mkResourceVirtualEnvironmentFileTimeoutUpload        = "timeout_upload"
-	mkResourceVirtualEnvironmentFileUploadMode           = "upload_mode"
+	dvResourceVirtualEnvironmentFileSourceFileChanged           = false
+	dvResourceVirtualEnvironmentFileSourceFileChecksum          = ""
+	dvResourceVirtualEnvironmentFileSourceFileChecksumAlgorithm = "sha256"
+	dvResourceVirtualEnvironmentFileSourceFileFileName          = ""
+	dvResourceVirtualEnvironmentFileSourceFileInsecure          = false
+	dvResourceVirtualEnvironmentFileSourceFileMinTLS            = ""
+	dvResourceVirtualEnvironmentFileOverwrite                   = true
+	dvResourceVirtualEnvironmentFileOverwriteUnmanaged          = false
+	dvResourceVirtualEnvironmentFileSourceRawResize             = 0
+	dvResourceVirtualEnvironmentFileTimeoutUpload               = 1800
+
+	mkResourceVirtualEnvironmentFileContentType                 = "content_type"
+	mkResourceVirtualEnvironmentFileDatastoreID                 = "datastore_id"
+	mkResourceVirtualEnvironmentFileFileModificationDate        = "file_modification_date"
+	mkResourceVirtualEnvironmentFileFileName                    = "file_name"
+	mkResourceVirtualEnvironmentFileFileMode                    = "file_mode"
+	mkResourceVirtualEnvironmentFileFileSize                    = "file_size"
+	mkResourceVirtualEnvironmentFileFileTag                     = "file_tag"
+	mkResourceVirtualEnvironmentFileNodeName                    = "node_name"
+	mkResourceVirtualEnvironmentFileOverwrite                   = "overwrite"
+	mkResourceVirtualEnvironmentFileOverwriteUnmanaged          = "overwrite_unmanaged"
+	mkResourceVirtualEnvironmentFileSourceFile                  = "source_file"
+	mkResourceVirtualEnvironmentFileSourceFilePath              = "path"
+	mkResourceVirtualEnvironmentFileSourceFileChanged           = "changed"
+	mkResourceVirtualEnvironmentFileSourceFileChecksum          = "checksum"
+	mkResourceVirtualEnvironmentFileSourceFileChecksumAlgorithm = "checksum_algorithm"
+	mkResourceVirtualEnvironmentFileSourceFileFileName          = "file_name"
+	mkResourceVirtualEnvironmentFileSourceFileInsecure          = "insecure"
+	mkResourceVirtualEnvironmentFileSourceFileMinTLS            = "min_tls"
+	mkResourceVirtualEnvironmentFileSourceFileUploadedSize      = "uploaded_size"
+	mkResourceVirtualEnvironmentFileSourceRaw                   = "source_raw"
+	mkResourceVirtualEnvironmentFileSourceRawChecksum           = "checksum"
+	mkResourceVirtualEnvironmentFileSourceRawData               = "data"
+	mkResourceVirtualEnvironmentFileSourceRawFileName           = "file_name"
+	mkResourceVirtualEnvironmentFileSourceRawResize             = "resize"
+	mkResourceVirtualEnvironmentFileTimeoutUpload               = "timeout_upload"
+	mkResourceVirtualEnvironmentFileUploadMode                  = "upload_mode"
 )
 
 // File returns a resource that manages files on a node.
@@ -154,11 +164,24 @@ func File() *schema.Resource {
 						},
 						mkResourceVirtualEnvironmentFileSourceFileChecksum: {
 							Type:        schema.TypeString,
-							Description: "The SHA256 checksum of the source file",
+							Description: "The checksum of the source file, verified before and after the upload",
 							Optional:    true,
 							ForceNew:    true,
 							Default:     dvResourceVirtualEnvironmentFileSourceFileChecksum,
 						},
+						mkResourceVirtualEnvironmentFileSourceFileChecksumAlgorithm: {
+							Type: schema.TypeString,
+							Description: "The algorithm of the source file checksum. " +
+								"Supported values: `md5|sha256|sha512`. Defaults to `sha256`.",
+							Optional: true,
+							ForceNew: true,
+							Default:  dvResourceVirtualEnvironmentFileSourceFileChecksumAlgorithm,
+							// states created before the attribute was added have no value, which means sha256
+							DiffSuppressFunc: fileSuppressChecksumAlgorithmDiff,
+							ValidateDiagFunc: validation.ToDiagFunc(
+								validation.StringInSlice([]string{"md5", "sha256", "sha512"}, false),
+							),
+						},
 						mkResourceVirtualEnvironmentFileSourceFileFileName: {
 							Type:        schema.TypeString,
 							Description: "The file name to use instead of the source file name",
@@ -181,6 +204,11 @@ func File() *schema.Resource {
 							ForceNew: true,
 							Default:  dvResourceVirtualEnvironmentFileSourceFileMinTLS,
 						},
+						mkResourceVirtualEnvironmentFileSourceFileUploadedSize: {
+							Type:        schema.TypeInt,
+							Description: "The size of the uploaded file on the node, used to detect changes of the file",
+							Computed:    true,
+						},
 					},
 				},
 				MaxItems: 1,
@@ -196,6 +224,11 @@ func File() *schema.Resource {
 				},
 				Elem: &schema.Resource{
 					Schema: map[string]*schema.Schema{
+						mkResourceVirtualEnvironmentFileSourceRawChecksum: {
+							Type:        schema.TypeString,
+							Description: "The SHA256 checksum of the uploaded file",
+							Computed:    true,
+						},
 						mkResourceVirtualEnvironmentFileSourceRawData: {
 							Type:        schema.TypeString,
 							Description: "The raw data",
@@ -242,6 +275,13 @@ func File() *schema.Resource {
 				Optional:    true,
 				Default:     dvResourceVirtualEnvironmentFileOverwrite,
 			},
+			mkResourceVirtualEnvironmentFileOverwriteUnmanaged: {
+				Type: schema.TypeBool,
+				Description: "Whether to adopt an existing file into the state without uploading it again " +
+					"when its size, and checksum when set, match the source. Otherwise, `overwrite` applies",
+				Optional: true,
+				Default:  dvResourceVirtualEnvironmentFileOverwriteUnmanaged,
+			},
 		},
 		CreateContext: fileCreate,
 		ReadContext:   fileRead,
@@ -367,6 +407,13 @@ func fileCreate(ctx context.Context, d *schema.ResourceData, m any) diag.Diagnos
 		return diag.FromErr(err)
 	}
 
+	overwrite := d.Get(mkResourceVirtualEnvironmentFileOverwrite).(bool)
+	overwriteUnmanaged := d.Get(mkResourceVirtualEnvironmentFileOverwriteUnmanaged).(bool)
+
+	// The size of the file already present in the datastore, or -1 if there is none.
+	existingFileSize := int64(-1)
+	existingVolumeID := ""
+
 	for _, file := range list {
 		volumeID, e := fileParseVolumeID(file.VolumeID)
 		if e != nil {
@@ -378,12 +425,21 @@ func fileCreate(ctx context.Context, d *schema.ResourceData, m any) diag.Diagnos
 		}
 
 		if volumeID.fileName == *fileName {
-			if d.Get(mkResourceVirtualEnvironmentFileOverwrite).(bool) {
+			if overwriteUnmanaged {
+				// Whether the file is adopted or overwritten is decided once the source is available locally.
+				existingFileSize = file.FileSize
+				existingVolumeID = file.VolumeID
+
+				continue
+			}
+
+			switch fileExistingAction(overwrite, false, false) {
+			case fileActionOverwrite:
 				diags = append(diags, diag.Diagnostic{
 					Severity: diag.Warning,
 					Summary:  fmt.Sprintf("the existing file %q has been overwritten by the resource", volumeID),
 				})
-			} else {
+			default:
 				return diag.Errorf("file %q already exists", volumeID)
 			}
 		}
@@ -394,6 +450,10 @@ func fileCreate(ctx context.Context, d *schema.ResourceData, m any) diag.Diagnos
 
 	sourceFilePathLocal := ""
 
+	// The checksum verified on the node once the upload completes, if any.
+	uploadChecksum := ""
+	uploadChecksumAlgorithm := ""
+
 	// Determine if both source_data and source_file is specified as this is not supported.
 	if len(sourceFile) > 0 && len(sourceRaw) > 0 {
 		diags = append(diags, diag.Errorf(
@@ -415,6 +475,7 @@ func fileCreate(ctx context.Context, d *schema.ResourceData, m any) diag.Diagnos
 		sourceFileBlock := sourceFile[0].(map[string]interface{})
 		sourceFilePath := sourceFileBlock[mkResourceVirtualEnvironmentFileSourceFilePath].(string)
 		sourceFileChecksum := sourceFileBlock[mkResourceVirtualEnvironmentFileSourceFileChecksum].(string)
+		sourceFileChecksumAlgorithm, _ := sourceFileBlock[mkResourceVirtualEnvironmentFileSourceFileChecksumAlgorithm].(string)
 		sourceFileMinTLS := sourceFileBlock[mkResourceVirtualEnvironmentFileSourceFileMinTLS].(string)
 		sourceFileInsecure := sourceFileBlock[mkResourceVirtualEnvironmentFileSourceFileInsecure].(bool)
 
@@ -481,7 +542,11 @@ func fileCreate(ctx context.Context, d *schema.ResourceData, m any) diag.Diagnos
 				return diag.FromErr(err)
 			}
 
-			h := sha256.New()
+			h, err := fileChecksumHash(sourceFileChecksumAlgorithm)
+			if err != nil {
+				return diag.FromErr(err)
+			}
+
 			_, err = io.Copy(h, file)
 			diags = append(diags, diag.FromErr(err)...)
 			err = file.Close()
@@ -492,17 +557,22 @@ func fileCreate(ctx context.Context, d *schema.ResourceData, m any) diag.Diagnos
 
 			calculatedChecksum := fmt.Sprintf("%x", h.Sum(nil))
 			tflog.Debug(ctx, "Calculated checksum", map[string]interface{}{
-				"source": sourceFilePath,
-				"sha256": calculatedChecksum,
+				"source":    sourceFilePath,
+				"algorithm": sourceFileChecksumAlgorithm,
+				"checksum":  calculatedChecksum,
 			})
 
-			if sourceFileChecksum != calculatedChecksum {
+			if !strings.EqualFold(sourceFileChecksum, calculatedChecksum) {
 				return diag.Errorf(
-					"the calculated SHA256 checksum \"%s\" does not match source checksum \"%s\"",
+					"the calculated %s checksum \"%s\" does not match source checksum \"%s\"",
+					strings.ToUpper(sourceFileChecksumAlgorithm),
 					calculatedChecksum,
 					sourceFileChecksum,
 				)
 			}
+
+			uploadChecksum = strings.ToLower(sourceFileChecksum)
+			uploadChecksumAlgorithm = sourceFileChecksumAlgorithm
 		}
 	}
 
@@ -514,7 +584,7 @@ func fileCreate(ctx context.Context, d *schema.ResourceData, m any) diag.Diagnos
 
 		if sourceRawResize > 0 {
 			if len(sourceRawData) <= sourceRawResize {
-				sourceRawData = fmt.Sprintf(fmt.Sprintf("%%-%dv", sourceRawResize), sourceRawData)
+				sourceRawData = fileRawSourcePad(sourceRawData, sourceRawResize)
 			} else {
 				return diag.Errorf("cannot resize %d bytes to %d bytes", len(sourceRawData), sourceRawResize)
 			}
@@ -562,11 +632,53 @@ func fileCreate(ctx context.Context, d *schema.ResourceData, m any) diag.Diagnos
 		}
 	}(file)
 
+	if existingFileSize >= 0 {
+		fileInfo, e := file.Stat()
+		if e != nil {
+			return diag.FromErr(e)
+		}
+
+		matchesSource := fileInfo.Size() == existingFileSize
+
+		// The size is compared first, the checksum is only computed on the node when it is set on the source.
+		if matchesSource && uploadChecksum != "" {
+			checksum, e := fileRemoteChecksum(ctx, capi, nodeName, existingVolumeID, uploadChecksumAlgorithm)
+			if e != nil {
+				return append(diags, diag.FromErr(e)...)
+			}
+
+			matchesSource = checksum == uploadChecksum
+		}
+
+		switch fileExistingAction(overwrite, overwriteUnmanaged, matchesSource) {
+		case fileActionAdopt:
+			tflog.Info(ctx, "Adopting existing file matching the source", map[string]any{
+				"file":     *fileName,
+				"size":     existingFileSize,
+				"checksum": uploadChecksum,
+			})
+
+			return append(diags, fileCreateRead(ctx, d, m, capi)...)
+		case fileActionOverwrite:
+			diags = append(diags, diag.Diagnostic{
+				Severity: diag.Warning,
+				Summary: fmt.Sprintf("the existing file %q does not match the source "+
+					"and has been overwritten by the resource", existingVolumeID),
+			})
+		default:
+			return append(diags, diag.Errorf(
+				"file %q already exists and does not match the source", existingVolumeID,
+			)...)
+		}
+	}
+
 	request := &api.FileUploadRequest{
-		ContentType: *contentType,
-		FileName:    *fileName,
-		File:        file,
-		Mode:        fileMode,
+		ContentType:       *contentType,
+		FileName:          *fileName,
+		File:              file,
+		Mode:              fileMode,
+		Checksum:          uploadChecksum,
+		ChecksumAlgorithm: uploadChecksumAlgorithm,
 	}
 
 	switch *contentType {
@@ -626,9 +738,36 @@ func fileCreate(ctx context.Context, d *schema.ResourceData, m any) diag.Diagnos
 
 	}
 
-	volID, di := fileGetVolumeID(ctx, d, capi)
+	return append(diags, fileCreateRead(ctx, d, m, capi)...)
+}
+
+// fileAction is the way an existing file with the same name as the source is handled on create.
+type fileAction int
+
+const (
+	fileActionRefuse fileAction = iota
+	fileActionOverwrite
+	fileActionAdopt
+)
+
+// fileExistingAction returns how an existing file with the same name as the source is handled on create.
+// With overwrite_unmanaged, a file matching the source is adopted, any other file is only overwritten
+// when overwrite is enabled.
+func fileExistingAction(overwrite, overwriteUnmanaged, matchesSource bool) fileAction {
+	if overwriteUnmanaged && matchesSource {
+		return fileActionAdopt
+	}
+
+	if overwrite {
+		return fileActionOverwrite
+	}
 
-	diags = append(diags, di...)
+	return fileActionRefuse
+}
+
+// fileCreateRead sets the ID of a created or adopted file and reads it back from the datastore.
+func fileCreateRead(ctx context.Context, d *schema.ResourceData, m any, capi proxmox.Client) diag.Diagnostics {
+	volID, diags := fileGetVolumeID(ctx, d, capi)
 	if diags.HasError() {
 		return diags
 	}
@@ -638,7 +777,7 @@ func fileCreate(ctx context.Context, d *schema.ResourceData, m any) diag.Diagnos
 	// Retry with backoff: distributed storage (Ceph) may not list the file immediately after upload.
 	errNotFound := errors.New("file not yet visible in datastore listing")
 
-	err = retry.New(
+	err := retry.New(
 		retry.Context(ctx),
 		retry.Attempts(5),
 		retry.Delay(200*time.Millisecond),
@@ -667,6 +806,64 @@ func fileCreate(ctx context.Context, d *schema.ResourceData, m any) diag.Diagnos
 	return diags
 }
 
+// fileSuppressChecksumAlgorithmDiff suppresses the diff between an unset checksum algorithm and the default one.
+func fileSuppressChecksumAlgorithmDiff(_, oldValue, newValue string, _ *schema.ResourceData) bool {
+	if oldValue == "" {
+		oldValue = dvResourceVirtualEnvironmentFileSourceFileChecksumAlgorithm
+	}
+
+	if newValue == "" {
+		newValue = dvResourceVirtualEnvironmentFileSourceFileChecksumAlgorithm
+	}
+
+	return oldValue == newValue
+}
+
+// fileRemoteChecksum computes the checksum of a datastore volume on the node using the SSH client.
+func fileRemoteChecksum(
+	ctx context.Context,
+	capi proxmox.Client,
+	nodeName string,
+	volumeID string,
+	algorithm string,
+) (string, error) {
+	sumCommand, err := ssh.ChecksumCommand(algorithm)
+	if err != nil {
+		return "", err
+	}
+
+	commands := []string{
+		`set -e`,
+		ssh.TrySudo,
+		`volume_id=` + volumeID,
+		`volume_path=$(try_sudo /usr/sbin/pvesm path $volume_id)`,
+		fmt.Sprintf(`try_sudo %s "$volume_path"`, sumCommand),
+	}
+
+	out, err := capi.SSH().ExecuteNodeCommands(ctx, nodeName, commands)
+	if err != nil {
+		return "", fmt.Errorf("failed to compute the checksum of %q: %w", volumeID, err)
+	}
+
+	checksum, _, _ := strings.Cut(strings.TrimSpace(string(out)), " ")
+
+	return strings.ToLower(checksum), nil
+}
+
+// fileChecksumHash returns a new hash for the given checksum algorithm.
+func fileChecksumHash(algorithm string) (hash.Hash, error) {
+	switch algorithm {
+	case "", "sha256":
+		return sha256.New(), nil
+	case "sha512":
+		return sha512.New(), nil
+	case "md5":
+		return md5.New(), nil //nolint:gosec
+	default:
+		return nil, fmt.Errorf("unsupported checksum algorithm %q", algorithm)
+	}
+}
+
 func fileGetContentType(ctx context.Context, d *schema.ResourceData, c proxmox.Client) (*string, diag.Diagnostics) {
 	contentType := d.Get(mkResourceVirtualEnvironmentFileContentType).(string)
 	sourceFile := d.Get(mkResourceVirtualEnvironmentFileSourceFile).([]interface{})
@@ -858,6 +1055,12 @@ func fileRead(ctx context.Context, d *schema.ResourceData, m any) diag.Diagnosti
 			err = d.Set(mkResourceVirtualEnvironmentFileContentType, v.ContentType)
 			diags = append(diags, diag.FromErr(err)...)
 
+			sourceRaw := d.Get(mkResourceVirtualEnvironmentFileSourceRaw).([]any)
+			if size := fileRawSourceSize(sourceRaw); size >= 0 {
+				err = fileReadRawSourceChecksum(ctx, d, v.VolumeID, v.FileSize)
+				diags = append(diags, diag.FromErr(err)...)
+			}
+
 			if len(sourceFile) == 0 {
 				continue
 			}
@@ -865,6 +1068,41 @@ func fileRead(ctx context.Context, d *schema.ResourceData, m any) diag.Diagnosti
 			sourceFileBlock := sourceFile[0].(map[string]any)
 			sourceFilePath := sourceFileBlock[mkResourceVirtualEnvironmentFileSourceFilePath].(string)
 
+			// The checksum is only recomputed on the node when the size of the uploaded file changed,
+			// to avoid hashing large files on every refresh. The stored size is kept until the checksum
+			// could be verified, so a mismatch is reported again on the next refresh.
+			checksumMismatch := false
+			checksumVerified := true
+			sourceFileChecksum := sourceFileBlock[mkResourceVirtualEnvironmentFileSourceFileChecksum].(string)
+			uploadedSize, _ := sourceFileBlock[mkResourceVirtualEnvironmentFileSourceFileUploadedSize].(int)
+
+			if sourceFileChecksum != "" && uploadedSize > 0 && int64(uploadedSize) != v.FileSize {
+				algorithm := sourceFileBlock[mkResourceVirtualEnvironmentFileSourceFileChecksumAlgorithm].(string)
+
+				checksum, e := fileRemoteChecksum(ctx, capi, nodeName, v.VolumeID, algorithm)
+				if e != nil {
+					diags = append(diags, diag.Diagnostic{
+						Severity: diag.Warning,
+						Summary:  fmt.Sprintf("failed to verify the checksum of the file %q", v.VolumeID),
+						Detail:   e.Error(),
+					})
+
+					checksumVerified = false
+				} else if !strings.EqualFold(checksum, sourceFileChecksum) {
+					tflog.Warn(ctx, "The checksum of the uploaded file has changed on the node", map[string]any{
+						"volume_id":         v.VolumeID,
+						"expected_checksum": sourceFileChecksum,
+						"actual_checksum":   checksum,
+					})
+
+					checksumMismatch = true
+				}
+			}
+
+			if checksumVerified && !checksumMismatch {
+				sourceFileBlock[mkResourceVirtualEnvironmentFileSourceFileUploadedSize] = int(v.FileSize)
+			}
+
 			fileModificationDate, fileSize, fileTag, err := readFileAttrs(ctx, sourceFilePath)
 			diags = append(diags, diag.FromErr(err)...)
 
@@ -888,14 +1126,13 @@ func fileRead(ctx context.Context, d *schema.ResourceData, m any) diag.Diagnosti
 				changed = lastFileMD != fileModificationDate || lastFileSize != fileSize || lastFileTag != fileTag
 			}
 
+			changed = changed || checksumMismatch
+
 			sourceFileBlock[mkResourceVirtualEnvironmentFileSourceFileChanged] = changed
 			err = d.Set(mkResourceVirtualEnvironmentFileSourceFile, sourceFile)
 			diags = append(diags, diag.FromErr(err)...)
 
-			if diags.HasError() {
-				return diags
-			}
-			return nil
+			return diags
 		}
 	}
 
@@ -908,6 +1145,81 @@ func fileRead(ctx context.Context, d *schema.ResourceData, m any) diag.Diagnosti
 	return nil
 }
 
+// fileReadRawSourceChecksum compares the file uploaded from the raw source with the content in state, using
+// the file size reported by the datastore. The checksum is computed from the uploaded content, so the file is
+// not hashed on the node on every refresh. A changed file clears the data in state, so the plan replaces the
+// file with the configured content.
+func fileReadRawSourceChecksum(ctx context.Context, d *schema.ResourceData, volumeID string, fileSize int64) error {
+	sourceRaw := d.Get(mkResourceVirtualEnvironmentFileSourceRaw).([]any)
+	block := sourceRaw[0].(map[string]any)
+
+	checksum, _ := block[mkResourceVirtualEnvironmentFileSourceRawChecksum].(string)
+	if checksum == "" {
+		// states created before the checksum was stored
+		checksum = fileRawSourceChecksum(sourceRaw)
+	}
+
+	if expectedSize := fileRawSourceSize(sourceRaw); expectedSize != fileSize {
+		tflog.Warn(ctx, "The file uploaded from the raw source has changed on the node", map[string]any{
+			"volume_id":     volumeID,
+			"expected_size": expectedSize,
+			"actual_size":   fileSize,
+		})
+
+		block[mkResourceVirtualEnvironmentFileSourceRawData] = ""
+		checksum = ""
+	}
+
+	block[mkResourceVirtualEnvironmentFileSourceRawChecksum] = checksum
+
+	return d.Set(mkResourceVirtualEnvironmentFileSourceRaw, sourceRaw)
+}
+
+// fileRawSourceChecksum returns the SHA256 checksum of the file uploaded from the raw source block, including
+// the padding to the `resize` size, or an empty string when the file is not uploaded from a raw source.
+func fileRawSourceChecksum(sourceRaw []any) string {
+	data, ok := fileRawSourceContent(sourceRaw)
+	if !ok {
+		return ""
+	}
+
+	return fmt.Sprintf("%x", sha256.Sum256([]byte(data)))
+}
+
+// fileRawSourceSize returns the size in bytes of the file uploaded from the raw source block, including the
+// padding to the `resize` size, or -1 when the file is not uploaded from a raw source.
+func fileRawSourceSize(sourceRaw []any) int64 {
+	data, ok := fileRawSourceContent(sourceRaw)
+	if !ok {
+		return -1
+	}
+
+	return int64(len(data))
+}
+
+// fileRawSourceContent returns the content uploaded from the raw source block, or false when the file is not
+// uploaded from a raw source.
+func fileRawSourceContent(sourceRaw []any) (string, bool) {
+	if len(sourceRaw) == 0 || sourceRaw[0] == nil {
+		return "", false
+	}
+
+	block := sourceRaw[0].(map[string]any)
+	data := block[mkResourceVirtualEnvironmentFileSourceRawData].(string)
+
+	return fileRawSourcePad(data, block[mkResourceVirtualEnvironmentFileSourceRawResize].(int)), true
+}
+
+// fileRawSourcePad pads the raw data with spaces to the `resize` size. Like the `%-*v` verb, the width counts
+// runes rather than bytes, so multi-byte data ends up larger than `resize` bytes.
+func fileRawSourcePad(data string, resize int) string {
+	if resize <= 0 {
+		return data
+	}
+
+	return fmt.Sprintf("%-*v", resize, data)
+}
+
 //nolint:nonamedreturns
 func readFile(
 	ctx context.Context,
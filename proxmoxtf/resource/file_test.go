@@ -76,16 +76,23 @@ func TestFileSchema(t *testing.T) {
 	test.AssertOptionalArguments(t, sourceFileSchema, []string{
 		mkResourceVirtualEnvironmentFileSourceFileChanged,
 		mkResourceVirtualEnvironmentFileSourceFileChecksum,
+		mkResourceVirtualEnvironmentFileSourceFileChecksumAlgorithm,
 		mkResourceVirtualEnvironmentFileSourceFileFileName,
 		mkResourceVirtualEnvironmentFileSourceFileInsecure,
 	})
 
+	test.AssertComputedAttributes(t, sourceFileSchema, []string{
+		mkResourceVirtualEnvironmentFileSourceFileUploadedSize,
+	})
+
 	test.AssertValueTypes(t, sourceFileSchema, map[string]schema.ValueType{
-		mkResourceVirtualEnvironmentFileSourceFileChanged:  schema.TypeBool,
-		mkResourceVirtualEnvironmentFileSourceFileChecksum: schema.TypeString,
-		mkResourceVirtualEnvironmentFileSourceFileFileName: schema.TypeString,
-		mkResourceVirtualEnvironmentFileSourceFileInsecure: schema.TypeBool,
-		mkResourceVirtualEnvironmentFileSourceFilePath:     schema.TypeString,
+		mkResourceVirtualEnvironmentFileSourceFileChanged:           schema.TypeBool,
+		mkResourceVirtualEnvironmentFileSourceFileChecksum:          schema.TypeString,
+		mkResourceVirtualEnvironmentFileSourceFileChecksumAlgorithm: schema.TypeString,
+		mkResourceVirtualEnvironmentFileSourceFileFileName:          schema.TypeString,
+		mkResourceVirtualEnvironmentFileSourceFileInsecure:          schema.TypeBool,
+		mkResourceVirtualEnvironmentFileSourceFilePath:              schema.TypeString,
+		mkResourceVirtualEnvironmentFileSourceFileUploadedSize:      schema.TypeInt,
 	})
 
 	sourceRawSchema := test.AssertNestedSchemaExistence(t, s, mkResourceVirtualEnvironmentFileSourceRaw)
@@ -99,7 +106,12 @@ func TestFileSchema(t *testing.T) {
 		mkResourceVirtualEnvironmentFileSourceRawResize,
 	})
 
+	test.AssertComputedAttributes(t, sourceRawSchema, []string{
+		mkResourceVirtualEnvironmentFileSourceRawChecksum,
+	})
+
 	test.AssertValueTypes(t, sourceRawSchema, map[string]schema.ValueType{
+		mkResourceVirtualEnvironmentFileSourceRawChecksum: schema.TypeString,
 		mkResourceVirtualEnvironmentFileSourceRawData:     schema.TypeString,
 		mkResourceVirtualEnvironmentFileSourceRawFileName: schema.TypeString,
 		mkResourceVirtualEnvironmentFileSourceRawResize:   schema.TypeInt,
@@ -149,6 +161,76 @@ func Test_fileParseVolumeID(t *testing.T) {
 	}
 }
 
+func Test_fileRawSourceSize(t *testing.T) {
+	t.Parallel()
+
+	raw := func(data string, resize int) []any {
+		return []any{map[string]any{
+			mkResourceVirtualEnvironmentFileSourceRawData:     data,
+			mkResourceVirtualEnvironmentFileSourceRawFileName: "meta.yaml",
+			mkResourceVirtualEnvironmentFileSourceRawResize:   resize,
+		}}
+	}
+
+	tests := []struct {
+		name      string
+		sourceRaw []any
+		want      int64
+	}{
+		{"no raw source", []any{}, -1},
+		{"unset raw source", []any{nil}, -1},
+		{"data", raw("local-hostname: test\n", 0), 21},
+		{"multi-byte data", raw("ü", 0), 2},
+		{"padded data", raw("abc", 10), 10},
+		{"padded multi-byte data", raw("ü", 3), 4},
+		{"resize below data size", raw("abcdef", 3), 6},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			if got := fileRawSourceSize(tt.sourceRaw); got != tt.want {
+				t.Errorf("fileRawSourceSize() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func Test_fileRawSourceChecksum(t *testing.T) {
+	t.Parallel()
+
+	raw := func(data string, resize int) []any {
+		return []any{map[string]any{
+			mkResourceVirtualEnvironmentFileSourceRawData:     data,
+			mkResourceVirtualEnvironmentFileSourceRawFileName: "meta.yaml",
+			mkResourceVirtualEnvironmentFileSourceRawResize:   resize,
+		}}
+	}
+
+	tests := []struct {
+		name      string
+		sourceRaw []any
+		want      string
+	}{
+		{"no raw source", []any{}, ""},
+		{"unset raw source", []any{nil}, ""},
+		{"data", raw("abc", 0), "ba7816bf8f01cfea414140de5dae2223b00361a396177a9cb410ff61f20015ad"},
+		{"padded data", raw("abc", 5), "a61ae8589d539f50adbd8f8f66a488818df762348b7232f9a5ed9721bd994366"},
+		{"padded multi-byte data", raw("ü", 3), "9afd0b3790ffb2bc5d02a2e93adde9639f344c563bf5e8e2c9dba5c0a4b66552"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			if got := fileRawSourceChecksum(tt.sourceRaw); got != tt.want {
+				t.Errorf("fileRawSourceChecksum() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
 func Test_fileParseImportID(t *testing.T) {
 	t.Parallel()
 
@@ -194,3 +276,59 @@ func Test_fileParseImportID(t *testing.T) {
 		})
 	}
 }
+
+func Test_fileSuppressChecksumAlgorithmDiff(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name     string
+		oldValue string
+		newValue string
+		want     bool
+	}{
+		{"unset in state", "", "sha256", true},
+		{"unchanged", "sha512", "sha512", true},
+		{"unset in state, other algorithm", "", "md5", false},
+		{"changed", "sha256", "sha512", false},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			if got := fileSuppressChecksumAlgorithmDiff("", tt.oldValue, tt.newValue, nil); got != tt.want {
+				t.Errorf("fileSuppressChecksumAlgorithmDiff() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func Test_fileExistingAction(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name               string
+		overwrite          bool
+		overwriteUnmanaged bool
+		matchesSource      bool
+		want               fileAction
+	}{
+		{"overwrite", true, false, false, fileActionOverwrite},
+		{"refuse", false, false, false, fileActionRefuse},
+		{"matching file without adoption is overwritten", true, false, true, fileActionOverwrite},
+		{"adopt matching file", true, true, true, fileActionAdopt},
+		{"adopt matching file without overwrite", false, true, true, fileActionAdopt},
+		{"overwrite different file", true, true, false, fileActionOverwrite},
+		{"refuse different file without overwrite", false, true, false, fileActionRefuse},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			if got := fileExistingAction(tt.overwrite, tt.overwriteUnmanaged, tt.matchesSource); got != tt.want {
+				t.Errorf("fileExistingAction() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
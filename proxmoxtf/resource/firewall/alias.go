@@ -14,6 +14,7 @@ import (
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 
 	"github.com/bpg/terraform-provider-proxmox/proxmox/firewall"
+	"github.com/bpg/terraform-provider-proxmox/proxmoxtf/resource/validators"
 	"github.com/bpg/terraform-provider-proxmox/proxmoxtf/structure"
 )
 
@@ -32,9 +33,10 @@ func Alias() *schema.Resource {
 			Required:    true,
 		},
 		mkAliasCIDR: {
-			Type:        schema.TypeString,
-			Description: "IP/CIDR block",
-			Required:    true,
+			Type:             schema.TypeString,
+			Description:      "IP/CIDR block",
+			Required:         true,
+			ValidateDiagFunc: validators.FirewallIPOrCIDR(),
 		},
 		mkAliasComment: {
 			Type:        schema.TypeString,
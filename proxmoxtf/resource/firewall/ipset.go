@@ -18,6 +18,7 @@ import (
 
 	proxmoxapi "github.com/bpg/terraform-provider-proxmox/proxmox/api"
 	"github.com/bpg/terraform-provider-proxmox/proxmox/firewall"
+	"github.com/bpg/terraform-provider-proxmox/proxmox/helpers/ptr"
 	"github.com/bpg/terraform-provider-proxmox/proxmox/types"
 	"github.com/bpg/terraform-provider-proxmox/proxmoxtf/structure"
 )
@@ -52,7 +53,6 @@ func IPSet() *schema.Resource {
 			Type:        schema.TypeList,
 			Description: "List of IP or Networks",
 			Optional:    true,
-			ForceNew:    true,
 			DefaultFunc: func() (any, error) {
 				return []any{}, nil
 			},
@@ -63,21 +63,18 @@ func IPSet() *schema.Resource {
 						Type:        schema.TypeString,
 						Description: "Network/IP specification in CIDR format",
 						Required:    true,
-						ForceNew:    true,
 					},
 					mkIPSetCIDRNoMatch: {
 						Type:        schema.TypeBool,
 						Description: "No match this IP/CIDR",
 						Optional:    true,
 						Default:     dvIPSetCIDRNoMatch,
-						ForceNew:    true,
 					},
 					mkIPSetCIDRComment: {
 						Type:        schema.TypeString,
 						Description: "IP/CIDR comment",
 						Optional:    true,
 						Default:     dvIPSetCIDRComment,
-						ForceNew:    true,
 					},
 				},
 			},
@@ -183,30 +180,7 @@ func ipSetCreate(ctx context.Context, api firewall.API, d *schema.ResourceData)
 	comment := d.Get(mkIPSetCIDRComment).(string)
 	name := d.Get(mkIPSetName).(string)
 
-	ipSets := d.Get(mkIPSetCIDR).([]any)
-	ipSetsArray := make(firewall.IPSetContent, len(ipSets))
-
-	for i, v := range ipSets {
-		ipSetMap := v.(map[string]any)
-		ipSetObject := firewall.IPSetGetResponseData{}
-
-		cidr := ipSetMap[mkIPSetCIDRName].(string)
-		noMatch := ipSetMap[mkIPSetCIDRNoMatch].(bool)
-
-		comm := ipSetMap[mkIPSetCIDRComment].(string)
-		if comm != "" {
-			ipSetObject.Comment = &comm
-		}
-
-		ipSetObject.CIDR = cidr
-
-		if noMatch {
-			noMatchBool := types.CustomBool(true)
-			ipSetObject.NoMatch = &noMatchBool
-		}
-
-		ipSetsArray[i] = ipSetObject
-	}
+	ipSetsArray := ipSetEntriesFromList(d.Get(mkIPSetCIDR).([]any))
 
 	body := &firewall.IPSetCreateRequestBody{
 		Comment: comment,
@@ -306,9 +280,116 @@ func ipSetUpdate(ctx context.Context, api firewall.API, d *schema.ResourceData)
 
 	d.SetId(newName)
 
+	if d.HasChange(mkIPSetCIDR) {
+		oldEntries, newEntries := d.GetChange(mkIPSetCIDR)
+
+		toDelete, toAdd, toUpdate := ipSetDiffEntries(
+			ipSetEntriesFromList(oldEntries.([]any)),
+			ipSetEntriesFromList(newEntries.([]any)),
+		)
+
+		for _, cidr := range toDelete {
+			err = api.DeleteIPSetContent(ctx, newName, cidr)
+			if err != nil {
+				return diag.FromErr(err)
+			}
+		}
+
+		for _, entry := range toAdd {
+			err = api.AddCIDRToIPSet(ctx, newName, entry)
+			if err != nil {
+				return diag.FromErr(err)
+			}
+		}
+
+		for _, entry := range toUpdate {
+			noMatch := types.CustomBool(entry.NoMatch != nil && bool(*entry.NoMatch))
+			comment := ptr.Or(entry.Comment, "")
+
+			err = api.UpdateIPSetContent(ctx, newName, entry.CIDR, &firewall.IPSetContentUpdateRequestBody{
+				NoMatch: &noMatch,
+				Comment: &comment,
+			})
+			if err != nil {
+				return diag.FromErr(err)
+			}
+		}
+	}
+
 	return ipSetRead(ctx, api, d)
 }
 
+// ipSetEntriesFromList converts the `cidr` blocks of the resource to IPSet entries.
+func ipSetEntriesFromList(list []any) firewall.IPSetContent {
+	entries := make(firewall.IPSetContent, 0, len(list))
+
+	for _, v := range list {
+		ipSetMap, ok := v.(map[string]any)
+		if !ok {
+			continue
+		}
+
+		entry := firewall.IPSetGetResponseData{
+			CIDR: ipSetMap[mkIPSetCIDRName].(string),
+		}
+
+		if comm := ipSetMap[mkIPSetCIDRComment].(string); comm != "" {
+			entry.Comment = &comm
+		}
+
+		if ipSetMap[mkIPSetCIDRNoMatch].(bool) {
+			noMatchBool := types.CustomBool(true)
+			entry.NoMatch = &noMatchBool
+		}
+
+		entries = append(entries, entry)
+	}
+
+	return entries
+}
+
+// ipSetDiffEntries compares IPSet entries by their CIDR and returns the CIDRs to delete, the entries to add,
+// and the entries whose options have changed.
+func ipSetDiffEntries(
+	oldEntries, newEntries firewall.IPSetContent,
+) ([]string, firewall.IPSetContent, firewall.IPSetContent) {
+	oldByCIDR := make(map[string]firewall.IPSetGetResponseData, len(oldEntries))
+	for _, e := range oldEntries {
+		oldByCIDR[e.CIDR] = e
+	}
+
+	newByCIDR := make(map[string]struct{}, len(newEntries))
+
+	var (
+		toDelete []string
+		toAdd    firewall.IPSetContent
+		toUpdate firewall.IPSetContent
+	)
+
+	for _, e := range newEntries {
+		newByCIDR[e.CIDR] = struct{}{}
+
+		old, found := oldByCIDR[e.CIDR]
+		if !found {
+			toAdd = append(toAdd, e)
+			continue
+		}
+
+		if ptr.Or(old.Comment, "") != ptr.Or(e.Comment, "") ||
+			ptr.Or(old.NoMatch, false) != ptr.Or(e.NoMatch, false) {
+			toUpdate = append(toUpdate, e)
+		}
+	}
+
+	for _, e := range oldEntries {
+		if _, found := newByCIDR[e.CIDR]; !found {
+			toDelete = append(toDelete, e.CIDR)
+		}
+	}
+
+	return toDelete, toAdd, toUpdate
+}
+
 func ipSetDelete(ctx context.Context, api firewall.API, d *schema.ResourceData) diag.Diagnostics {
 	diags := diag.Diagnostics{}
 
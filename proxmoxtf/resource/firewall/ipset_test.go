@@ -12,6 +12,8 @@ import (
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/stretchr/testify/require"
 
+	"github.com/bpg/terraform-provider-proxmox/proxmox/firewall"
+	"github.com/bpg/terraform-provider-proxmox/proxmox/types"
 	"github.com/bpg/terraform-provider-proxmox/proxmoxtf/test"
 )
 
@@ -62,3 +64,34 @@ func TestIPSetSchema(t *testing.T) {
 		mkIPSetCIDRNoMatch: schema.TypeBool,
 	})
 }
+
+func TestIPSetDiffEntries(t *testing.T) {
+	t.Parallel()
+
+	comment := "web"
+	noMatch := types.CustomBool(true)
+
+	oldEntries := firewall.IPSetContent{
+		{CIDR: "10.0.0.1"},
+		{CIDR: "10.0.0.2"},
+		{CIDR: "10.0.0.3", Comment: &comment},
+	}
+
+	newEntries := firewall.IPSetContent{
+		{CIDR: "10.0.0.1"},
+		{CIDR: "10.0.0.3", NoMatch: &noMatch},
+		{CIDR: "10.0.0.4"},
+	}
+
+	toDelete, toAdd, toUpdate := ipSetDiffEntries(oldEntries, newEntries)
+
+	require.Equal(t, []string{"10.0.0.2"}, toDelete)
+	require.Equal(t, firewall.IPSetContent{{CIDR: "10.0.0.4"}}, toAdd)
+	require.Equal(t, firewall.IPSetContent{{CIDR: "10.0.0.3", NoMatch: &noMatch}}, toUpdate)
+
+	toDelete, toAdd, toUpdate = ipSetDiffEntries(newEntries, newEntries)
+
+	require.Empty(t, toDelete)
+	require.Empty(t, toAdd)
+	require.Empty(t, toUpdate)
+}
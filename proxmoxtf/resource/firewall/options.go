@@ -8,6 +8,7 @@ package firewall
 
 import (
 	"context"
+	"errors"
 	"fmt"
 	"strconv"
 	"strings"
@@ -15,6 +16,7 @@ import (
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 
+	proxmoxapi "github.com/bpg/terraform-provider-proxmox/proxmox/api"
 	"github.com/bpg/terraform-provider-proxmox/proxmox/firewall"
 	"github.com/bpg/terraform-provider-proxmox/proxmox/nodes"
 	"github.com/bpg/terraform-provider-proxmox/proxmox/types"
@@ -303,7 +305,18 @@ func optionsUpdate(ctx context.Context, api firewall.API, d *schema.ResourceData
 	return optionsRead(ctx, api, d)
 }
 
-func optionsDelete(_ context.Context, _ firewall.API, d *schema.ResourceData) diag.Diagnostics {
+func optionsDelete(ctx context.Context, api firewall.API, d *schema.ResourceData) diag.Diagnostics {
+	// reset the options to their defaults, the guest may already be gone
+	err := api.SetOptions(ctx, &firewall.OptionsPutRequestBody{
+		Delete: []string{
+			"dhcp", "enable", "ipfilter", "log_level_in", "log_level_out",
+			"macfilter", "ndp", "policy_in", "policy_out", "radv",
+		},
+	})
+	if err != nil && !errors.Is(err, proxmoxapi.ErrResourceDoesNotExist) {
+		return diag.FromErr(err)
+	}
+
 	d.SetId("")
 
 	return nil
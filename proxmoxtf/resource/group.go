@@ -13,6 +13,7 @@ import (
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 
+	"github.com/bpg/terraform-provider-proxmox/proxmox"
 	"github.com/bpg/terraform-provider-proxmox/proxmox/access"
 	"github.com/bpg/terraform-provider-proxmox/proxmox/api"
 	"github.com/bpg/terraform-provider-proxmox/proxmox/types"
@@ -118,25 +119,9 @@ func groupCreate(ctx context.Context, d *schema.ResourceData, m any) diag.Diagno
 
 	aclParsed := d.Get(mkResourceVirtualEnvironmentGroupACL).(*schema.Set).List()
 
-	for _, v := range aclParsed {
-		aclDelete := types.CustomBool(false)
-		aclEntry := v.(map[string]interface{})
-		aclPropagate := types.CustomBool(
-			aclEntry[mkResourceVirtualEnvironmentGroupACLPropagate].(bool),
-		)
-
-		aclBody := &access.ACLUpdateRequestBody{
-			Delete:    &aclDelete,
-			Groups:    []string{groupID},
-			Path:      aclEntry[mkResourceVirtualEnvironmentGroupACLPath].(string),
-			Propagate: &aclPropagate,
-			Roles:     []string{aclEntry[mkResourceVirtualEnvironmentGroupACLRoleID].(string)},
-		}
-
-		err := client.Access().UpdateACL(ctx, aclBody)
-		if err != nil {
-			return diag.FromErr(err)
-		}
+	err = groupUpdateACL(ctx, client, groupID, aclParsed, false)
+	if err != nil {
+		return diag.FromErr(err)
 	}
 
 	return groupRead(ctx, d, m)
@@ -234,48 +219,18 @@ func groupUpdate(ctx context.Context, d *schema.ResourceData, m any) diag.Diagno
 		return diag.FromErr(err)
 	}
 
-	aclArgOld, aclArg := d.GetChange(mkResourceVirtualEnvironmentGroupACL)
-	aclParsedOld := aclArgOld.(*schema.Set).List()
+	if d.HasChange(mkResourceVirtualEnvironmentGroupACL) {
+		aclArgOld, aclArg := d.GetChange(mkResourceVirtualEnvironmentGroupACL)
+		aclSetOld := aclArgOld.(*schema.Set)
+		aclSet := aclArg.(*schema.Set)
 
-	for _, v := range aclParsedOld {
-		aclDelete := types.CustomBool(true)
-		aclEntry := v.(map[string]interface{})
-		aclPropagate := types.CustomBool(
-			aclEntry[mkResourceVirtualEnvironmentGroupACLPropagate].(bool),
-		)
-
-		aclBody := &access.ACLUpdateRequestBody{
-			Delete:    &aclDelete,
-			Groups:    []string{groupID},
-			Path:      aclEntry[mkResourceVirtualEnvironmentGroupACLPath].(string),
-			Propagate: &aclPropagate,
-			Roles:     []string{aclEntry[mkResourceVirtualEnvironmentGroupACLRoleID].(string)},
-		}
-
-		err := client.Access().UpdateACL(ctx, aclBody)
+		// Only touch the entries that changed, so unchanged permissions are never revoked, even briefly.
+		err = groupUpdateACL(ctx, client, groupID, aclSetOld.Difference(aclSet).List(), true)
 		if err != nil {
 			return diag.FromErr(err)
 		}
-	}
 
-	aclParsed := aclArg.(*schema.Set).List()
-
-	for _, v := range aclParsed {
-		aclDelete := types.CustomBool(false)
-		aclEntry := v.(map[string]interface{})
-		aclPropagate := types.CustomBool(
-			aclEntry[mkResourceVirtualEnvironmentGroupACLPropagate].(bool),
-		)
-
-		aclBody := &access.ACLUpdateRequestBody{
-			Delete:    &aclDelete,
-			Groups:    []string{groupID},
-			Path:      aclEntry[mkResourceVirtualEnvironmentGroupACLPath].(string),
-			Propagate: &aclPropagate,
-			Roles:     []string{aclEntry[mkResourceVirtualEnvironmentGroupACLRoleID].(string)},
-		}
-
-		err := client.Access().UpdateACL(ctx, aclBody)
+		err = groupUpdateACL(ctx, client, groupID, aclSet.Difference(aclSetOld).List(), false)
 		if err != nil {
 			return diag.FromErr(err)
 		}
@@ -295,9 +250,27 @@ func groupDelete(ctx context.Context, d *schema.ResourceData, m any) diag.Diagno
 	aclParsed := d.Get(mkResourceVirtualEnvironmentGroupACL).(*schema.Set).List()
 	groupID := d.Id()
 
-	for _, v := range aclParsed {
-		aclDelete := types.CustomBool(true)
-		aclEntry := v.(map[string]interface{})
+	err = groupUpdateACL(ctx, client, groupID, aclParsed, true)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	err = client.Access().DeleteGroup(ctx, groupID)
+
+	if err != nil && !errors.Is(err, api.ErrResourceDoesNotExist) {
+		return diag.FromErr(err)
+	}
+
+	d.SetId("")
+
+	return nil
+}
+
+// groupUpdateACL adds or removes the group's ACL entries.
+func groupUpdateACL(ctx context.Context, client proxmox.Client, groupID string, entries []any, remove bool) error {
+	for _, v := range entries {
+		aclDelete := types.CustomBool(remove)
+		aclEntry := v.(map[string]any)
 		aclPropagate := types.CustomBool(
 			aclEntry[mkResourceVirtualEnvironmentGroupACLPropagate].(bool),
 		)
@@ -310,19 +283,11 @@ func groupDelete(ctx context.Context, d *schema.ResourceData, m any) diag.Diagno
 			Roles:     []string{aclEntry[mkResourceVirtualEnvironmentGroupACLRoleID].(string)},
 		}
 
-		err = client.Access().UpdateACL(ctx, aclBody)
+		err := client.Access().UpdateACL(ctx, aclBody)
 		if err != nil {
-			return diag.FromErr(err)
+			return err
 		}
 	}
 
-	err = client.Access().DeleteGroup(ctx, groupID)
-
-	if err != nil && !errors.Is(err, api.ErrResourceDoesNotExist) {
-		return diag.FromErr(err)
-	}
-
-	d.SetId("")
-
 	return nil
 }
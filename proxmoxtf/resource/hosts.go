@@ -241,8 +241,35 @@ func hostsUpdate(ctx context.Context, d *schema.ResourceData, m any) diag.Diagno
 		body.Data += "\n"
 	}
 
+	// Send the digest of the last known file content, so PVE rejects the update
+	// instead of overwriting changes made on the node in the meantime.
+	digest := d.Get(mkResourceVirtualEnvironmentHostsDigest).(string)
+
+	if digest == "" {
+		hosts, e := api.Node(nodeName).GetHosts(ctx)
+		if e != nil {
+			return diag.FromErr(e)
+		}
+
+		if hosts.Digest != nil {
+			digest = *hosts.Digest
+		}
+	}
+
+	if digest != "" {
+		body.Digest = &digest
+	}
+
 	err = api.Node(nodeName).UpdateHosts(ctx, &body)
 	if err != nil {
+		if strings.Contains(err.Error(), "detected modified configuration") {
+			return diag.Errorf(
+				"the hosts file of node %q has been modified outside of Terraform, "+
+					"refresh the state and apply again: %s",
+				nodeName, err,
+			)
+		}
+
 		return diag.FromErr(err)
 	}
 
@@ -10,12 +10,18 @@ import (
 	"context"
 	"errors"
 	"fmt"
+	"slices"
+	"strconv"
 
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 
+	"github.com/bpg/terraform-provider-proxmox/proxmox"
 	"github.com/bpg/terraform-provider-proxmox/proxmox/api"
+	"github.com/bpg/terraform-provider-proxmox/proxmox/cluster"
 	"github.com/bpg/terraform-provider-proxmox/proxmox/pools"
+	"github.com/bpg/terraform-provider-proxmox/proxmox/types"
+	"github.com/bpg/terraform-provider-proxmox/proxmox/version"
 	"github.com/bpg/terraform-provider-proxmox/proxmoxtf"
 )
 
@@ -30,6 +36,8 @@ const (
 	mkResourceVirtualEnvironmentPoolMembersType        = "type"
 	mkResourceVirtualEnvironmentPoolMembersVMID        = "vm_id"
 	mkResourceVirtualEnvironmentPoolPoolID             = "pool_id"
+	mkResourceVirtualEnvironmentPoolStorageIDs         = "storage_ids"
+	mkResourceVirtualEnvironmentPoolVMIDs              = "vm_ids"
 )
 
 // Pool returns a resource that manages pools.
@@ -82,6 +90,18 @@ func Pool() *schema.Resource {
 				Required:    true,
 				ForceNew:    true,
 			},
+			mkResourceVirtualEnvironmentPoolStorageIDs: {
+				Type:        schema.TypeSet,
+				Description: "The identifiers of the datastores in the pool",
+				Optional:    true,
+				Elem:        &schema.Schema{Type: schema.TypeString},
+			},
+			mkResourceVirtualEnvironmentPoolVMIDs: {
+				Type:        schema.TypeSet,
+				Description: "The identifiers of the VMs and containers in the pool",
+				Optional:    true,
+				Elem:        &schema.Schema{Type: schema.TypeInt},
+			},
 		},
 		CreateContext: poolCreate,
 		ReadContext:   poolRead,
@@ -125,7 +145,29 @@ func poolCreate(ctx context.Context, d *schema.ResourceData, m any) diag.Diagnos
 
 	d.SetId(poolID)
 
-	return poolRead(ctx, d, m)
+	var diags diag.Diagnostics
+
+	vmIDs, hasVMIDs := d.GetOk(mkResourceVirtualEnvironmentPoolVMIDs)
+	storageIDs, hasStorageIDs := d.GetOk(mkResourceVirtualEnvironmentPoolStorageIDs)
+
+	if hasVMIDs || hasStorageIDs {
+		var vms, storage []string
+
+		if hasVMIDs {
+			vms = poolSetToStrings(vmIDs.(*schema.Set))
+		}
+
+		if hasStorageIDs {
+			storage = poolSetToStrings(storageIDs.(*schema.Set))
+		}
+
+		diags = append(diags, poolAddMembers(ctx, client, poolID, vms, storage)...)
+		if diags.HasError() {
+			return diags
+		}
+	}
+
+	return append(diags, poolRead(ctx, d, m)...)
 }
 
 func poolRead(ctx context.Context, d *schema.ResourceData, m any) diag.Diagnostics {
@@ -184,6 +226,20 @@ func poolRead(ctx context.Context, d *schema.ResourceData, m any) diag.Diagnosti
 	err = d.Set(mkResourceVirtualEnvironmentPoolMembers, members)
 	diags = append(diags, diag.FromErr(err)...)
 
+	vmIDs, storageIDs := poolSplitMembers(pool.Members)
+
+	// The member lists are only read back when they are managed by the resource, so the members of a pool
+	// that are assigned with the `pool_id` attribute of VMs and containers don't plan a removal.
+	if _, ok := d.GetOk(mkResourceVirtualEnvironmentPoolVMIDs); ok {
+		err = d.Set(mkResourceVirtualEnvironmentPoolVMIDs, vmIDs)
+		diags = append(diags, diag.FromErr(err)...)
+	}
+
+	if _, ok := d.GetOk(mkResourceVirtualEnvironmentPoolStorageIDs); ok {
+		err = d.Set(mkResourceVirtualEnvironmentPoolStorageIDs, storageIDs)
+		diags = append(diags, diag.FromErr(err)...)
+	}
+
 	return diags
 }
 
@@ -207,7 +263,44 @@ func poolUpdate(ctx context.Context, d *schema.ResourceData, m any) diag.Diagnos
 		return diag.FromErr(err)
 	}
 
-	return poolRead(ctx, d, m)
+	var diags diag.Diagnostics
+
+	if d.HasChanges(mkResourceVirtualEnvironmentPoolVMIDs, mkResourceVirtualEnvironmentPoolStorageIDs) {
+		oldVMs, newVMs := d.GetChange(mkResourceVirtualEnvironmentPoolVMIDs)
+		oldStorage, newStorage := d.GetChange(mkResourceVirtualEnvironmentPoolStorageIDs)
+
+		removedVMs := poolSetToStrings(oldVMs.(*schema.Set).Difference(newVMs.(*schema.Set)))
+		removedStorage := poolSetToStrings(oldStorage.(*schema.Set).Difference(newStorage.(*schema.Set)))
+
+		if len(removedVMs) > 0 || len(removedStorage) > 0 {
+			removeBody := &pools.PoolUpdateRequestBody{
+				Delete: new(types.CustomBool(true)),
+			}
+
+			if len(removedVMs) > 0 {
+				removeBody.VMs = new(types.CustomCommaSeparatedList(removedVMs))
+			}
+
+			if len(removedStorage) > 0 {
+				removeBody.Storage = new(types.CustomCommaSeparatedList(removedStorage))
+			}
+
+			err = client.Pool().UpdatePool(ctx, poolID, removeBody)
+			if err != nil {
+				return diag.Errorf("unable to remove members from pool %q: %s", poolID, err)
+			}
+		}
+
+		addedVMs := poolSetToStrings(newVMs.(*schema.Set).Difference(oldVMs.(*schema.Set)))
+		addedStorage := poolSetToStrings(newStorage.(*schema.Set).Difference(oldStorage.(*schema.Set)))
+
+		diags = append(diags, poolAddMembers(ctx, client, poolID, addedVMs, addedStorage)...)
+		if diags.HasError() {
+			return diags
+		}
+	}
+
+	return append(diags, poolRead(ctx, d, m)...)
 }
 
 func poolDelete(ctx context.Context, d *schema.ResourceData, m any) diag.Diagnostics {
@@ -229,3 +322,123 @@ func poolDelete(ctx context.Context, d *schema.ResourceData, m any) diag.Diagnos
 
 	return nil
 }
+
+// poolAddMembers adds VMs and datastores to the pool. A VM can only belong to one pool, so VMs that are
+// currently members of another pool are moved, with a warning.
+func poolAddMembers(
+	ctx context.Context,
+	client proxmox.Client,
+	poolID string,
+	vmIDs []string,
+	storageIDs []string,
+) diag.Diagnostics {
+	if len(vmIDs) == 0 && len(storageIDs) == 0 {
+		return nil
+	}
+
+	var diags diag.Diagnostics
+
+	body := &pools.PoolUpdateRequestBody{}
+
+	if len(vmIDs) > 0 {
+		resources, err := client.Cluster().GetClusterResources(ctx, "vm")
+		if err != nil {
+			return diag.Errorf("unable to list the cluster VMs: %s", err)
+		}
+
+		moved := poolMovedVMs(resources, poolID, vmIDs)
+
+		if len(moved) > 0 {
+			ver := version.MinimumProxmoxVersion
+			if versionResp, e := client.Version().Version(ctx); e == nil {
+				ver = versionResp.Version
+			}
+
+			// moving a VM from its current pool requires the allow-move flag of PVE 8.1+
+			if !ver.SupportPoolAllowMove() {
+				return diag.Errorf("VM %d is a member of pool %q and can't be moved to pool %q, "+
+					"this requires Proxmox VE 8.1 or later", moved[0].VMID, moved[0].PoolName, poolID)
+			}
+
+			body.AllowMove = new(types.CustomBool(true))
+		}
+
+		for _, m := range moved {
+			diags = append(diags, diag.Diagnostic{
+				Severity: diag.Warning,
+				Summary:  "VM moved between pools",
+				Detail: fmt.Sprintf("VM %d is a member of pool %q and will be moved to pool %q, "+
+					"as a VM can only belong to one pool.", m.VMID, m.PoolName, poolID),
+			})
+		}
+
+		body.VMs = new(types.CustomCommaSeparatedList(vmIDs))
+	}
+
+	if len(storageIDs) > 0 {
+		body.Storage = new(types.CustomCommaSeparatedList(storageIDs))
+	}
+
+	err := client.Pool().UpdatePool(ctx, poolID, body)
+	if err != nil {
+		return append(diags, diag.Errorf("unable to add members to pool %q: %s", poolID, err)...)
+	}
+
+	return diags
+}
+
+// poolMovedVMs returns the cluster resources of the given VMs that are currently members of another pool.
+func poolMovedVMs(
+	resources []*cluster.ResourcesListResponseData,
+	poolID string,
+	vmIDs []string,
+) []*cluster.ResourcesListResponseData {
+	var moved []*cluster.ResourcesListResponseData
+
+	for _, r := range resources {
+		if r == nil || r.PoolName == "" || r.PoolName == poolID {
+			continue
+		}
+
+		if slices.Contains(vmIDs, strconv.Itoa(r.VMID)) {
+			moved = append(moved, r)
+		}
+	}
+
+	return moved
+}
+
+// poolSplitMembers separates the VM and container members of the pool from its datastore members.
+func poolSplitMembers(members []pools.VirtualEnvironmentPoolGetResponseMembers) ([]int, []string) {
+	vmIDs := []int{}
+	storageIDs := []string{}
+
+	for _, member := range members {
+		switch {
+		case member.Type == "storage" && member.DatastoreID != nil:
+			storageIDs = append(storageIDs, *member.DatastoreID)
+		case member.VMID != nil:
+			vmIDs = append(vmIDs, *member.VMID)
+		}
+	}
+
+	return vmIDs, storageIDs
+}
+
+// poolSetToStrings returns the elements of the set as strings, as expected by the pool API.
+func poolSetToStrings(set *schema.Set) []string {
+	values := make([]string, 0, set.Len())
+
+	for _, v := range set.List() {
+		switch value := v.(type) {
+		case int:
+			values = append(values, strconv.Itoa(value))
+		case string:
+			values = append(values, value)
+		}
+	}
+
+	slices.Sort(values)
+
+	return values
+}
@@ -10,7 +10,10 @@ import (
 	"testing"
 
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/stretchr/testify/assert"
 
+	"github.com/bpg/terraform-provider-proxmox/proxmox/cluster"
+	"github.com/bpg/terraform-provider-proxmox/proxmox/pools"
 	"github.com/bpg/terraform-provider-proxmox/proxmoxtf/test"
 )
 
@@ -36,12 +39,19 @@ func TestPoolSchema(t *testing.T) {
 
 	test.AssertOptionalArguments(t, s, []string{
 		mkResourceVirtualEnvironmentPoolComment,
+		mkResourceVirtualEnvironmentPoolStorageIDs,
+		mkResourceVirtualEnvironmentPoolVMIDs,
 	})
 
 	test.AssertComputedAttributes(t, s, []string{
 		mkResourceVirtualEnvironmentPoolMembers,
 	})
 
+	test.AssertValueTypes(t, s, map[string]schema.ValueType{
+		mkResourceVirtualEnvironmentPoolStorageIDs: schema.TypeSet,
+		mkResourceVirtualEnvironmentPoolVMIDs:      schema.TypeSet,
+	})
+
 	membersSchema := test.AssertNestedSchemaExistence(t, s, mkResourceVirtualEnvironmentPoolMembers)
 
 	test.AssertComputedAttributes(t, membersSchema, []string{
@@ -60,3 +70,63 @@ func TestPoolSchema(t *testing.T) {
 		mkResourceVirtualEnvironmentPoolMembersVMID:        schema.TypeInt,
 	})
 }
+
+// TestPoolSplitMembers tests that VM and datastore members are reported separately.
+func TestPoolSplitMembers(t *testing.T) {
+	t.Parallel()
+
+	members := []pools.VirtualEnvironmentPoolGetResponseMembers{
+		{ID: "qemu/100", Node: "pve", Type: "qemu", VMID: new(100)},
+		{ID: "storage/pve/local", Node: "pve", Type: "storage", DatastoreID: new("local")},
+		{ID: "lxc/101", Node: "pve", Type: "lxc", VMID: new(101)},
+	}
+
+	vmIDs, storageIDs := poolSplitMembers(members)
+
+	assert.Equal(t, []int{100, 101}, vmIDs)
+	assert.Equal(t, []string{"local"}, storageIDs)
+
+	vmIDs, storageIDs = poolSplitMembers(nil)
+
+	assert.Empty(t, vmIDs)
+	assert.NotNil(t, vmIDs)
+	assert.Empty(t, storageIDs)
+	assert.NotNil(t, storageIDs)
+}
+
+// TestPoolMovedVMs tests the detection of VMs that are members of another pool.
+func TestPoolMovedVMs(t *testing.T) {
+	t.Parallel()
+
+	resources := []*cluster.ResourcesListResponseData{
+		{Type: "qemu", VMID: 100},
+		{Type: "qemu", VMID: 101, PoolName: "ops"},
+		{Type: "lxc", VMID: 102, PoolName: "dev"},
+		{Type: "qemu", VMID: 103, PoolName: "dev"},
+	}
+
+	tests := []struct {
+		name   string
+		vmIDs  []string
+		wantID []int
+	}{
+		{"not in a pool", []string{"100"}, nil},
+		{"already in the pool", []string{"101"}, nil},
+		{"in another pool", []string{"100", "102"}, []int{102}},
+		{"not requested", []string{}, nil},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			var ids []int
+
+			for _, r := range poolMovedVMs(resources, "ops", tt.vmIDs) {
+				ids = append(ids, r.VMID)
+			}
+
+			assert.Equal(t, tt.wantID, ids)
+		})
+	}
+}
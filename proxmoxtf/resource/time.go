@@ -17,6 +17,7 @@ import (
 
 	"github.com/bpg/terraform-provider-proxmox/proxmox/nodes"
 	"github.com/bpg/terraform-provider-proxmox/proxmoxtf"
+	"github.com/bpg/terraform-provider-proxmox/proxmoxtf/resource/validators"
 )
 
 const (
@@ -41,9 +42,10 @@ func Time() *schema.Resource {
 				Required:    true,
 			},
 			mkResourceVirtualEnvironmentTimeTimeZone: {
-				Type:        schema.TypeString,
-				Description: "The time zone",
-				Required:    true,
+				Type:             schema.TypeString,
+				Description:      "The time zone",
+				Required:         true,
+				ValidateDiagFunc: validators.TimeZone(),
 			},
 			mkResourceVirtualEnvironmentTimeUTCTime: {
 				Type:        schema.TypeString,
@@ -68,6 +68,35 @@ func FileID() schema.SchemaValidateDiagFunc {
 	})
 }
 
+// SnippetFileID returns a schema validation function for the identifier of a file with
+// the "snippets" content type, e.g. a hook script.
+func SnippetFileID() schema.SchemaValidateDiagFunc {
+	return validation.ToDiagFunc(func(i any, k string) ([]string, []error) {
+		v, ok := i.(string)
+
+		var es []error
+
+		if !ok {
+			es = append(es, fmt.Errorf("expected type of %s to be string", k))
+			return nil, es
+		}
+
+		if v != "" {
+			r := regexp.MustCompile(`^(?i)[a-z\d\-_.]+:snippets/.+$`)
+
+			if !r.MatchString(v) {
+				es = append(es, fmt.Errorf(
+					"expected %s to be a file on a snippets datastore (datastore-name:snippets/some-file.sh), got %s", k, v,
+				))
+
+				return nil, es
+			}
+		}
+
+		return []string{}, es
+	})
+}
+
 // FileMode is a schema validation function for file mode.
 func FileMode() schema.SchemaValidateDiagFunc {
 	return validation.ToDiagFunc(
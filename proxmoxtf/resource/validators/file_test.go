@@ -42,6 +42,38 @@ func TestFileID(t *testing.T) {
 	}
 }
 
+func TestSnippetFileID(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name  string
+		value string
+		valid bool
+	}{
+		{"empty", "", true},
+		{"invalid", "hook.sh", false},
+		{"wrong content type", "local:iso/hook.sh", false},
+		{"missing file name", "local:snippets/", false},
+		{"valid", "local:snippets/hook.sh", true},
+		{"valid when datastore name has dots", "shared.nfs:snippets/hook.pl", true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			f := SnippetFileID()
+			res := f(tt.value, nil)
+
+			if tt.valid {
+				require.Empty(t, res, "validate: '%s'", tt.value)
+			} else {
+				require.NotEmpty(t, res, "validate: '%s'", tt.value)
+			}
+		})
+	}
+}
+
 func TestFileMode(t *testing.T) {
 	t.Parallel()
 
@@ -46,3 +46,11 @@ func FirewallLogLevel() schema.SchemaValidateDiagFunc {
 		false,
 	))
 }
+
+// FirewallIPOrCIDR returns a schema validation function for an IP address or a CIDR block, e.g. of a firewall alias.
+func FirewallIPOrCIDR() schema.SchemaValidateDiagFunc {
+	return validation.ToDiagFunc(validation.Any(
+		validation.IsIPAddress,
+		validation.IsCIDR,
+	))
+}
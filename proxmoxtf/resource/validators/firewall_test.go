@@ -0,0 +1,47 @@
+/*
+ * This Source Code Form is subject to the terms of the Mozilla Public
+ * License, v. 2.0. If a copy of the MPL was not distributed with this
+ * file, You can obtain one at https://mozilla.org/MPL/2.0/.
+ */
+
+package validators
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestFirewallIPOrCIDR(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name  string
+		value string
+		valid bool
+	}{
+		{"empty", "", false},
+		{"invalid", "invalid", false},
+		{"invalid: prefix too long", "10.0.0.0/33", false},
+		{"invalid: range", "10.0.0.1-10.0.0.5", false},
+		{"valid ipv4 address", "192.168.1.10", true},
+		{"valid ipv4 cidr", "192.168.0.0/16", true},
+		{"valid ipv6 address", "fd00::1", true},
+		{"valid ipv6 cidr", "fd00::/64", true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			f := FirewallIPOrCIDR()
+			res := f(tt.value, nil)
+
+			if tt.valid {
+				require.Empty(t, res, "validate: '%s'", tt.value)
+			} else {
+				require.NotEmpty(t, res, "validate: '%s'", tt.value)
+			}
+		})
+	}
+}
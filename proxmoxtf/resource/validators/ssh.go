@@ -0,0 +1,38 @@
+/*
+ * This Source Code Form is subject to the terms of the Mozilla Public
+ * License, v. 2.0. If a copy of the MPL was not distributed with this
+ * file, You can obtain one at https://mozilla.org/MPL/2.0/.
+ */
+
+package validators
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"golang.org/x/crypto/ssh"
+)
+
+// SSHPublicKey is a schema validation function for a single public key in the OpenSSH `authorized_keys` format.
+func SSHPublicKey() schema.SchemaValidateDiagFunc {
+	return validation.ToDiagFunc(func(i any, path string) ([]string, []error) {
+		v, ok := i.(string)
+		if !ok {
+			return nil, []error{fmt.Errorf("expected type of %q to be string", path)}
+		}
+
+		k := strings.TrimSpace(v)
+
+		if strings.ContainsAny(k, "\r\n") {
+			return nil, []error{fmt.Errorf("expected %q to contain a single SSH public key, got multiple lines", path)}
+		}
+
+		if _, _, _, _, err := ssh.ParseAuthorizedKey([]byte(k)); err != nil { //nolint:dogsled
+			return nil, []error{fmt.Errorf("expected %q to be a valid SSH public key, got %q: %w", path, v, err)}
+		}
+
+		return nil, nil
+	})
+}
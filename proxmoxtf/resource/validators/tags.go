@@ -0,0 +1,45 @@
+/*
+ * This Source Code Form is subject to the terms of the Mozilla Public
+ * License, v. 2.0. If a copy of the MPL was not distributed with this
+ * file, You can obtain one at https://mozilla.org/MPL/2.0/.
+ */
+
+package validators
+
+import (
+	"fmt"
+	"regexp"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+)
+
+// tagRegex matches the tag format accepted by Proxmox VE (`pve-tag`).
+var tagRegex = regexp.MustCompile(`^(?i)[a-z0-9_][a-z0-9_\-+.]*$`)
+
+// Tag is a schema validation function for VM and container tags. Surrounding whitespace is ignored,
+// as it is trimmed before the tags are sent to Proxmox VE.
+func Tag() schema.SchemaValidateDiagFunc {
+	return validation.ToDiagFunc(func(i any, path string) ([]string, []error) {
+		v, ok := i.(string)
+		if !ok {
+			return nil, []error{fmt.Errorf("expected type of %q to be string", path)}
+		}
+
+		t := strings.TrimSpace(v)
+
+		if t == "" {
+			return nil, []error{fmt.Errorf("expected %q to be a non-empty tag", path)}
+		}
+
+		if !tagRegex.MatchString(t) {
+			return nil, []error{fmt.Errorf(
+				"expected %q to be a valid tag that starts with a letter, digit or '_' and contains only "+
+					"letters, digits, '_', '-', '+' and '.', got %q", path, v,
+			)}
+		}
+
+		return nil, nil
+	})
+}
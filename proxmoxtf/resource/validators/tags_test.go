@@ -0,0 +1,48 @@
+/*
+ * This Source Code Form is subject to the terms of the Mozilla Public
+ * License, v. 2.0. If a copy of the MPL was not distributed with this
+ * file, You can obtain one at https://mozilla.org/MPL/2.0/.
+ */
+
+package validators
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestTag(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name  string
+		value string
+		valid bool
+	}{
+		{"empty", "", false},
+		{"blank", "  ", false},
+		{"simple", "prod", true},
+		{"surrounding whitespace", " prod ", true},
+		{"special characters", "k8s_node-1.2+x", true},
+		{"inner space", "my tag", false},
+		{"comma", "a,b", false},
+		{"semicolon", "a;b", false},
+		{"leading dash", "-tag", false},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			f := Tag()
+			res := f(tt.value, nil)
+
+			if tt.valid {
+				require.Empty(t, res, "validate: '%s'", tt.value)
+			} else {
+				require.NotEmpty(t, res, "validate: '%s'", tt.value)
+			}
+		})
+	}
+}
@@ -0,0 +1,37 @@
+/*
+ * This Source Code Form is subject to the terms of the Mozilla Public
+ * License, v. 2.0. If a copy of the MPL was not distributed with this
+ * file, You can obtain one at https://mozilla.org/MPL/2.0/.
+ */
+
+package validators
+
+import (
+	"fmt"
+	"time"
+	_ "time/tzdata" // Validate against the embedded IANA time zone database
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+)
+
+// TimeZone is a schema validation function for IANA time zone names (e.g. `Europe/Paris`).
+func TimeZone() schema.SchemaValidateDiagFunc {
+	return validation.ToDiagFunc(func(i any, path string) ([]string, []error) {
+		v, ok := i.(string)
+		if !ok {
+			return nil, []error{fmt.Errorf("expected type of %q to be string", path)}
+		}
+
+		// LoadLocation maps these to the host's time zone and UTC, neither is a zone name.
+		if v == "" || v == "Local" {
+			return nil, []error{fmt.Errorf("expected %q to be an IANA time zone name, got %q", path, v)}
+		}
+
+		if _, err := time.LoadLocation(v); err != nil {
+			return nil, []error{fmt.Errorf("expected %q to be an IANA time zone name, got %q", path, v)}
+		}
+
+		return nil, nil
+	})
+}
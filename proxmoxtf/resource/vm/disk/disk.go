@@ -62,6 +62,7 @@ func UpdateClone(
 	planDisks vms.CustomStorageDevices,
 	allDiskInfo vms.CustomStorageDevices,
 	vmAPI *vms.Client,
+	bandwidthLimit *int,
 ) diag.Diagnostics {
 	var diags diag.Diagnostics
 
@@ -112,6 +113,7 @@ func UpdateClone(
 			deleteOriginalDisk := types.CustomBool(true)
 
 			diskMoveBody := &vms.MoveDiskRequestBody{
+				BandwidthLimit:     bandwidthLimit,
 				DeleteOriginalDisk: &deleteOriginalDisk,
 				Disk:               diskInterface,
 				TargetStorage:      *planDisk.DatastoreID,
@@ -152,6 +154,51 @@ func DigitPrefix(s string) string {
 	return s
 }
 
+// Interfaces returns the interfaces (e.g. `scsi0`) of the configured disks.
+func Interfaces(diskList []any) []string {
+	interfaces := make([]string, 0, len(diskList))
+
+	for _, block := range diskList {
+		if b, ok := block.(map[string]any); ok {
+			if iface, ok := b[mkDiskInterface].(string); ok && iface != "" {
+				interfaces = append(interfaces, iface)
+			}
+		}
+	}
+
+	return interfaces
+}
+
+// DatastoreIDs returns the distinct datastore IDs of the disk blocks.
+func DatastoreIDs(diskList []any) []string {
+	datastoreIDs := make([]string, 0, len(diskList))
+
+	for _, block := range diskList {
+		if b, ok := block.(map[string]any); ok {
+			if id, ok := b[mkDiskDatastoreID].(string); ok && id != "" && !slices.Contains(datastoreIDs, id) {
+				datastoreIDs = append(datastoreIDs, id)
+			}
+		}
+	}
+
+	return datastoreIDs
+}
+
+// Sizes returns the configured size of every disk block, by interface.
+func Sizes(diskList []any) map[string]int {
+	sizes := make(map[string]int, len(diskList))
+
+	for _, block := range diskList {
+		if b, ok := block.(map[string]any); ok {
+			if iface, ok := b[mkDiskInterface].(string); ok && iface != "" {
+				sizes[iface], _ = b[mkDiskSize].(int)
+			}
+		}
+	}
+
+	return sizes
+}
+
 // GetDiskDeviceObjects returns a map of disk devices for a VM.
 func GetDiskDeviceObjects(
 	d *schema.ResourceData,
@@ -254,10 +301,10 @@ func GetDiskDeviceObjects(
 			iopsReadBurstable := speedBlock[mkDiskIopsReadBurstable].(int)
 			iopsWrite := speedBlock[mkDiskIopsWrite].(int)
 			iopsWriteBurstable := speedBlock[mkDiskIopsWriteBurstable].(int)
-			speedLimitRead := speedBlock[mkDiskSpeedRead].(int)
-			speedLimitReadBurstable := speedBlock[mkDiskSpeedReadBurstable].(int)
-			speedLimitWrite := speedBlock[mkDiskSpeedWrite].(int)
-			speedLimitWriteBurstable := speedBlock[mkDiskSpeedWriteBurstable].(int)
+			speedLimitRead := speedBlock[mkDiskSpeedRead].(float64)
+			speedLimitReadBurstable := speedBlock[mkDiskSpeedReadBurstable].(float64)
+			speedLimitWrite := speedBlock[mkDiskSpeedWrite].(float64)
+			speedLimitWriteBurstable := speedBlock[mkDiskSpeedWriteBurstable].(float64)
 
 			if iopsRead > 0 {
 				diskDevice.IopsRead = &iopsRead
@@ -552,25 +599,25 @@ func Read(
 			if dd.MaxReadSpeedMbps != nil {
 				speed[mkDiskSpeedRead] = *dd.MaxReadSpeedMbps
 			} else {
-				speed[mkDiskSpeedRead] = 0
+				speed[mkDiskSpeedRead] = 0.0
 			}
 
 			if dd.BurstableReadSpeedMbps != nil {
 				speed[mkDiskSpeedReadBurstable] = *dd.BurstableReadSpeedMbps
 			} else {
-				speed[mkDiskSpeedReadBurstable] = 0
+				speed[mkDiskSpeedReadBurstable] = 0.0
 			}
 
 			if dd.MaxWriteSpeedMbps != nil {
 				speed[mkDiskSpeedWrite] = *dd.MaxWriteSpeedMbps
 			} else {
-				speed[mkDiskSpeedWrite] = 0
+				speed[mkDiskSpeedWrite] = 0.0
 			}
 
 			if dd.BurstableWriteSpeedMbps != nil {
 				speed[mkDiskSpeedWriteBurstable] = *dd.BurstableWriteSpeedMbps
 			} else {
-				speed[mkDiskSpeedWriteBurstable] = 0
+				speed[mkDiskSpeedWriteBurstable] = 0.0
 			}
 
 			disk[mkDiskSpeed] = []any{speed}
@@ -682,6 +729,15 @@ func Update(
 				rebootRequired = true
 			}
 
+			if changed := pendingOptionChanges(tmp, disk); len(changed) > 0 {
+				tflog.Debug(ctx, "disk options can't be applied live and require a reboot", map[string]any{
+					"interface": iface,
+					"options":   changed,
+				})
+
+				rebootRequired = true
+			}
+
 			// Never re-import existing disks - import_from is only for initial disk creation.
 			// See https://github.com/bpg/terraform-provider-proxmox/issues/2385
 
@@ -712,3 +768,27 @@ func Update(
 
 	return rebootRequired, diags
 }
+
+// pendingOptionChanges returns the names of the changed disk options that PVE can't apply to a running VM.
+// Such changes are stored as pending and only take effect after the next power cycle.
+func pendingOptionChanges(current, plan *vms.CustomStorageDevice) []string {
+	var changed []string
+
+	if ptr.Or(current.Cache, dvDiskCache) != ptr.Or(plan.Cache, dvDiskCache) {
+		changed = append(changed, mkDiskCache)
+	}
+
+	if ptr.Or(current.Discard, dvDiskDiscard) != ptr.Or(plan.Discard, dvDiskDiscard) {
+		changed = append(changed, mkDiskDiscard)
+	}
+
+	if ptr.Or(current.IOThread, false) != ptr.Or(plan.IOThread, false) {
+		changed = append(changed, mkDiskIOThread)
+	}
+
+	if ptr.Or(current.SSD, false) != ptr.Or(plan.SSD, false) {
+		changed = append(changed, mkDiskSSD)
+	}
+
+	return changed
+}
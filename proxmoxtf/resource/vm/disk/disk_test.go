@@ -864,10 +864,10 @@ func TestDiskSpeedSettingsPerDisk(t *testing.T) {
 					mkDiskIopsWrite:           200,
 					mkDiskIopsReadBurstable:   1000,
 					mkDiskIopsWriteBurstable:  2000,
-					mkDiskSpeedRead:           10,
-					mkDiskSpeedWrite:          20,
-					mkDiskSpeedReadBurstable:  100,
-					mkDiskSpeedWriteBurstable: 200,
+					mkDiskSpeedRead:           10.0,
+					mkDiskSpeedWrite:          20.0,
+					mkDiskSpeedReadBurstable:  100.0,
+					mkDiskSpeedWriteBurstable: 200.0,
 				},
 			},
 		},
@@ -889,10 +889,10 @@ func TestDiskSpeedSettingsPerDisk(t *testing.T) {
 					mkDiskIopsWrite:           400,
 					mkDiskIopsReadBurstable:   3000,
 					mkDiskIopsWriteBurstable:  4000,
-					mkDiskSpeedRead:           30,
-					mkDiskSpeedWrite:          40,
-					mkDiskSpeedReadBurstable:  300,
-					mkDiskSpeedWriteBurstable: 400,
+					mkDiskSpeedRead:           30.0,
+					mkDiskSpeedWrite:          40.0,
+					mkDiskSpeedReadBurstable:  300.0,
+					mkDiskSpeedWriteBurstable: 400.0,
 				},
 			},
 		},
@@ -932,13 +932,13 @@ func TestDiskSpeedSettingsPerDisk(t *testing.T) {
 	require.NotNil(t, scsi0.MaxIopsWrite, "scsi0 should have MaxIopsWrite")
 	require.Equal(t, 2000, *scsi0.MaxIopsWrite)
 	require.NotNil(t, scsi0.MaxReadSpeedMbps, "scsi0 should have MaxReadSpeedMbps")
-	require.Equal(t, 10, *scsi0.MaxReadSpeedMbps)
+	require.InDelta(t, 10.0, *scsi0.MaxReadSpeedMbps, 0)
 	require.NotNil(t, scsi0.MaxWriteSpeedMbps, "scsi0 should have MaxWriteSpeedMbps")
-	require.Equal(t, 20, *scsi0.MaxWriteSpeedMbps)
+	require.InDelta(t, 20.0, *scsi0.MaxWriteSpeedMbps, 0)
 	require.NotNil(t, scsi0.BurstableReadSpeedMbps, "scsi0 should have BurstableReadSpeedMbps")
-	require.Equal(t, 100, *scsi0.BurstableReadSpeedMbps)
+	require.InDelta(t, 100.0, *scsi0.BurstableReadSpeedMbps, 0)
 	require.NotNil(t, scsi0.BurstableWriteSpeedMbps, "scsi0 should have BurstableWriteSpeedMbps")
-	require.Equal(t, 200, *scsi0.BurstableWriteSpeedMbps)
+	require.InDelta(t, 200.0, *scsi0.BurstableWriteSpeedMbps, 0)
 
 	// verify scsi1 has DIFFERENT speed settings (not scsi0's)
 	scsi1 := diskDevices["scsi1"]
@@ -952,13 +952,13 @@ func TestDiskSpeedSettingsPerDisk(t *testing.T) {
 	require.NotNil(t, scsi1.MaxIopsWrite, "scsi1 should have MaxIopsWrite")
 	require.Equal(t, 4000, *scsi1.MaxIopsWrite, "scsi1 MaxIopsWrite should be 4000, not 2000 from scsi0")
 	require.NotNil(t, scsi1.MaxReadSpeedMbps, "scsi1 should have MaxReadSpeedMbps")
-	require.Equal(t, 30, *scsi1.MaxReadSpeedMbps, "scsi1 MaxReadSpeedMbps should be 30, not 10 from scsi0")
+	require.InDelta(t, 30.0, *scsi1.MaxReadSpeedMbps, 0, "scsi1 MaxReadSpeedMbps should be 30, not 10 from scsi0")
 	require.NotNil(t, scsi1.MaxWriteSpeedMbps, "scsi1 should have MaxWriteSpeedMbps")
-	require.Equal(t, 40, *scsi1.MaxWriteSpeedMbps, "scsi1 MaxWriteSpeedMbps should be 40, not 20 from scsi0")
+	require.InDelta(t, 40.0, *scsi1.MaxWriteSpeedMbps, 0, "scsi1 MaxWriteSpeedMbps should be 40, not 20 from scsi0")
 	require.NotNil(t, scsi1.BurstableReadSpeedMbps, "scsi1 should have BurstableReadSpeedMbps")
-	require.Equal(t, 300, *scsi1.BurstableReadSpeedMbps, "scsi1 BurstableReadSpeedMbps should be 300, not 100 from scsi0")
+	require.InDelta(t, 300.0, *scsi1.BurstableReadSpeedMbps, 0, "scsi1 BurstableReadSpeedMbps should be 300, not 100 from scsi0")
 	require.NotNil(t, scsi1.BurstableWriteSpeedMbps, "scsi1 should have BurstableWriteSpeedMbps")
-	require.Equal(t, 400, *scsi1.BurstableWriteSpeedMbps, "scsi1 BurstableWriteSpeedMbps should be 400, not 200 from scsi0")
+	require.InDelta(t, 400.0, *scsi1.BurstableWriteSpeedMbps, 0, "scsi1 BurstableWriteSpeedMbps should be 400, not 200 from scsi0")
 
 	// verify scsi2 has NO speed settings (empty speed block)
 	scsi2 := diskDevices["scsi2"]
@@ -1053,3 +1053,83 @@ func TestDiskQueuesSettings(t *testing.T) {
 	_, err = GetDiskDeviceObjects(resourceData, resource, virtioDiskList)
 	require.ErrorContains(t, err, "queues are only supported for SCSI disks")
 }
+
+// TestDiskUpdatePendingOptions verifies that cache/discard/iothread/ssd changes are applied in place with a targeted
+// disk config update, and are flagged as requiring a reboot since PVE keeps them pending on a running VM.
+func TestDiskUpdatePendingOptions(t *testing.T) {
+	t.Parallel()
+
+	datastoreID := "local"
+	on := "on"
+	enabled := types.CustomBool(true)
+
+	tests := []struct {
+		name           string
+		plan           func(d *vms.CustomStorageDevice)
+		rebootRequired bool
+	}{
+		{"discard", func(d *vms.CustomStorageDevice) { d.Discard = &on }, true},
+		{"iothread", func(d *vms.CustomStorageDevice) { d.IOThread = &enabled }, true},
+		{"ssd", func(d *vms.CustomStorageDevice) { d.SSD = &enabled }, true},
+		{"backup only", func(d *vms.CustomStorageDevice) { d.Backup = &enabled }, false},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			resourceData := schema.TestResourceDataRaw(t, Schema(), map[string]any{
+				MkDisk: []any{
+					map[string]any{
+						mkDiskInterface:   "scsi0",
+						mkDiskDatastoreID: datastoreID,
+						mkDiskSize:        10,
+						mkDiskSpeed:       []any{},
+					},
+				},
+			})
+			resourceData.MarkNewResource()
+
+			currentDisks := vms.CustomStorageDevices{
+				"scsi0": &vms.CustomStorageDevice{
+					FileVolume:  "local:vm-100-disk-0",
+					Size:        types.DiskSizeFromGigabytes(10),
+					DatastoreID: &datastoreID,
+				},
+			}
+
+			planDisk := &vms.CustomStorageDevice{
+				FileVolume:  "local:vm-100-disk-0",
+				Size:        types.DiskSizeFromGigabytes(10),
+				DatastoreID: &datastoreID,
+			}
+			tt.plan(planDisk)
+
+			updateBody := &vms.UpdateRequestBody{}
+
+			rebootRequired, diags := Update(
+				context.Background(), nil, "test-node", 100, resourceData,
+				vms.CustomStorageDevices{"scsi0": planDisk}, currentDisks, updateBody,
+			)
+			require.False(t, diags.HasError())
+			require.Equal(t, tt.rebootRequired, rebootRequired)
+			require.Contains(t, updateBody.CustomStorageDevices, "scsi0")
+		})
+	}
+}
+
+// TestDatastoreIDs tests that the datastore IDs of the disk blocks are returned once, in order.
+func TestDatastoreIDs(t *testing.T) {
+	t.Parallel()
+
+	diskList := []any{
+		map[string]any{mkDiskInterface: "scsi0", mkDiskDatastoreID: "local-lvm"},
+		map[string]any{mkDiskInterface: "scsi1", mkDiskDatastoreID: "ceph"},
+		map[string]any{mkDiskInterface: "scsi2", mkDiskDatastoreID: "local-lvm"},
+		map[string]any{mkDiskInterface: "scsi3", mkDiskDatastoreID: ""},
+		nil,
+	}
+
+	require.Equal(t, []string{"local-lvm", "ceph"}, DatastoreIDs(diskList))
+	require.Empty(t, DatastoreIDs(nil))
+}
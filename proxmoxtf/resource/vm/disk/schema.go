@@ -230,62 +230,70 @@ func Schema() map[string]*schema.Schema {
 									mkDiskIopsWrite:           0,
 									mkDiskIopsReadBurstable:   0,
 									mkDiskIopsWriteBurstable:  0,
-									mkDiskSpeedRead:           0,
-									mkDiskSpeedReadBurstable:  0,
-									mkDiskSpeedWrite:          0,
-									mkDiskSpeedWriteBurstable: 0,
+									mkDiskSpeedRead:           0.0,
+									mkDiskSpeedReadBurstable:  0.0,
+									mkDiskSpeedWrite:          0.0,
+									mkDiskSpeedWriteBurstable: 0.0,
 								},
 							}, nil
 						},
 						Elem: &schema.Resource{
 							Schema: map[string]*schema.Schema{
 								mkDiskIopsRead: {
-									Type:        schema.TypeInt,
-									Description: "The maximum read I/O in operations per second",
-									Optional:    true,
-									Default:     0,
+									Type:             schema.TypeInt,
+									Description:      "The maximum read I/O in operations per second",
+									Optional:         true,
+									Default:          0,
+									ValidateDiagFunc: validation.ToDiagFunc(validation.IntAtLeast(0)),
 								},
 								mkDiskIopsWrite: {
-									Type:        schema.TypeInt,
-									Description: "The maximum write I/O in operations per second",
-									Optional:    true,
-									Default:     0,
+									Type:             schema.TypeInt,
+									Description:      "The maximum write I/O in operations per second",
+									Optional:         true,
+									Default:          0,
+									ValidateDiagFunc: validation.ToDiagFunc(validation.IntAtLeast(0)),
 								},
 								mkDiskIopsReadBurstable: {
-									Type:        schema.TypeInt,
-									Description: "The maximum unthrottled read I/O pool in operations per second",
-									Optional:    true,
-									Default:     0,
+									Type:             schema.TypeInt,
+									Description:      "The maximum unthrottled read I/O pool in operations per second",
+									Optional:         true,
+									Default:          0,
+									ValidateDiagFunc: validation.ToDiagFunc(validation.IntAtLeast(0)),
 								},
 								mkDiskIopsWriteBurstable: {
-									Type:        schema.TypeInt,
-									Description: "The maximum unthrottled write I/O pool in operations per second",
-									Optional:    true,
-									Default:     0,
+									Type:             schema.TypeInt,
+									Description:      "The maximum unthrottled write I/O pool in operations per second",
+									Optional:         true,
+									Default:          0,
+									ValidateDiagFunc: validation.ToDiagFunc(validation.IntAtLeast(0)),
 								},
 								mkDiskSpeedRead: {
-									Type:        schema.TypeInt,
-									Description: "The maximum read speed in megabytes per second",
-									Optional:    true,
-									Default:     0,
+									Type:             schema.TypeFloat,
+									Description:      "The maximum read speed in megabytes per second",
+									Optional:         true,
+									Default:          0.0,
+									ValidateDiagFunc: validation.ToDiagFunc(validation.FloatAtLeast(0)),
 								},
 								mkDiskSpeedReadBurstable: {
-									Type:        schema.TypeInt,
-									Description: "The maximum burstable read speed in megabytes per second",
-									Optional:    true,
-									Default:     0,
+									Type:             schema.TypeFloat,
+									Description:      "The maximum burstable read speed in megabytes per second",
+									Optional:         true,
+									Default:          0.0,
+									ValidateDiagFunc: validation.ToDiagFunc(validation.FloatAtLeast(0)),
 								},
 								mkDiskSpeedWrite: {
-									Type:        schema.TypeInt,
-									Description: "The maximum write speed in megabytes per second",
-									Optional:    true,
-									Default:     0,
+									Type:             schema.TypeFloat,
+									Description:      "The maximum write speed in megabytes per second",
+									Optional:         true,
+									Default:          0.0,
+									ValidateDiagFunc: validation.ToDiagFunc(validation.FloatAtLeast(0)),
 								},
 								mkDiskSpeedWriteBurstable: {
-									Type:        schema.TypeInt,
-									Description: "The maximum burstable write speed in megabytes per second",
-									Optional:    true,
-									Default:     0,
+									Type:             schema.TypeFloat,
+									Description:      "The maximum burstable write speed in megabytes per second",
+									Optional:         true,
+									Default:          0.0,
+									ValidateDiagFunc: validation.ToDiagFunc(validation.FloatAtLeast(0)),
 								},
 							},
 						},
@@ -55,9 +55,9 @@ func TestVMSchema(t *testing.T) {
 	})
 
 	test.AssertValueTypes(t, diskSpeedSchema, map[string]schema.ValueType{
-		mkDiskSpeedRead:           schema.TypeInt,
-		mkDiskSpeedReadBurstable:  schema.TypeInt,
-		mkDiskSpeedWrite:          schema.TypeInt,
-		mkDiskSpeedWriteBurstable: schema.TypeInt,
+		mkDiskSpeedRead:           schema.TypeFloat,
+		mkDiskSpeedReadBurstable:  schema.TypeFloat,
+		mkDiskSpeedWrite:          schema.TypeFloat,
+		mkDiskSpeedWriteBurstable: schema.TypeFloat,
 	})
 }
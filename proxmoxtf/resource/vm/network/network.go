@@ -25,6 +25,12 @@ func GetNetworkDeviceObjects(d *schema.ResourceData) (vms.CustomNetworkDevices,
 	networkDevice := d.Get(MkNetworkDevice).([]any)
 	networkDeviceObjects := make(vms.CustomNetworkDevices, len(networkDevice))
 
+	// with explicit indices, the list position no longer matches the device, and the devices missing in
+	// between are left disabled so they are removed from the VM
+	if n := deviceCount(networkDevice); n > len(networkDeviceObjects) {
+		networkDeviceObjects = make(vms.CustomNetworkDevices, n)
+	}
+
 	for i, networkDeviceEntry := range networkDevice {
 		block := networkDeviceEntry.(map[string]any)
 
@@ -91,12 +97,83 @@ func GetNetworkDeviceObjects(d *schema.ResourceData) (vms.CustomNetworkDevices,
 			device.MTU = &mtu
 		}
 
-		networkDeviceObjects[i] = device
+		networkDeviceObjects[DeviceIndex(i, block)] = device
 	}
 
 	return networkDeviceObjects, nil
 }
 
+// DeviceIndex returns the index of the `net<index>` device of a network_device entry, which is its `index`
+// attribute when set and its position in the list otherwise.
+func DeviceIndex(position int, entry any) int {
+	block, ok := entry.(map[string]any)
+	if !ok {
+		return position
+	}
+
+	if index, ok := block[mkNetworkDeviceIndex].(int); ok && index >= 0 {
+		return index
+	}
+
+	return position
+}
+
+// deviceCount returns the number of `net<index>` slots covered by the network_device entries.
+func deviceCount(devices []any) int {
+	count := 0
+
+	for i, device := range devices {
+		count = max(count, DeviceIndex(i, device)+1)
+	}
+
+	return count
+}
+
+// hasIndices reports whether the network_device entries set explicit indices.
+func hasIndices(devices []any) bool {
+	for _, device := range devices {
+		if block, ok := device.(map[string]any); ok {
+			if index, ok := block[mkNetworkDeviceIndex].(int); ok && index >= 0 {
+				return true
+			}
+		}
+	}
+
+	return false
+}
+
+// CheckIndices checks that either all or none of the network devices set an explicit index, and that the
+// indices are listed in ascending order, so the list read back from the VM matches the configuration.
+func CheckIndices(devices []any) error {
+	if !hasIndices(devices) {
+		return nil
+	}
+
+	last := -1
+
+	for i, device := range devices {
+		block, ok := device.(map[string]any)
+		if !ok {
+			continue
+		}
+
+		index, _ := block[mkNetworkDeviceIndex].(int)
+		if index < 0 {
+			return fmt.Errorf("%s.%d.%s must be set when another network device sets it",
+				MkNetworkDevice, i, mkNetworkDeviceIndex)
+		}
+
+		if index <= last {
+			return fmt.Errorf("%s.%d.%s %d must be greater than the index %d of the previous network device",
+				MkNetworkDevice, i, mkNetworkDeviceIndex, index, last)
+		}
+
+		last = index
+	}
+
+	return nil
+}
+
 func valueOrDefault[T any](v *T, def T) T {
 	if v == nil {
 		return def
@@ -119,6 +196,28 @@ func getNetworkDeviceObjects(vmConfig *vms.GetResponseData) []*vms.CustomNetwork
 	return result
 }
 
+// CheckQueues checks that no network device uses more packet queues than the VM has vCPUs.
+// The check is skipped when the number of vCPUs is not known.
+func CheckQueues(devices []any, vcpus int) error {
+	if vcpus <= 0 {
+		return nil
+	}
+
+	for i, device := range devices {
+		block, ok := device.(map[string]any)
+		if !ok {
+			continue
+		}
+
+		if queues, _ := block[mkNetworkDeviceQueues].(int); queues > vcpus {
+			return fmt.Errorf("%s.%d.%s %d exceeds the %d vCPUs of the VM",
+				MkNetworkDevice, i, mkNetworkDeviceQueues, queues, vcpus)
+		}
+	}
+
+	return nil
+}
+
 // ExistingNetworkDeviceIndices returns the set of "net<i>" keys that currently exist on the VM.
 func ExistingNetworkDeviceIndices(vmConfig *vms.GetResponseData) map[string]struct{} {
 	result := make(map[string]struct{}, len(vmConfig.NetworkDevices))
@@ -137,6 +236,10 @@ func ReadNetworkDeviceObjects(d *schema.ResourceData, vmConfig *vms.GetResponseD
 	macAddresses := make([]any, 0)
 	networkDevices := make([]any, 0)
 
+	// devices keyed by an explicit index are read back without the gaps between them
+	currentDevices, _ := d.Get(MkNetworkDevice).([]any)
+	indexed := hasIndices(currentDevices)
+
 	networkDeviceObjects := getNetworkDeviceObjects(vmConfig)
 
 	for len(networkDeviceObjects) > 0 && networkDeviceObjects[len(networkDeviceObjects)-1] == nil {
@@ -144,16 +247,25 @@ func ReadNetworkDeviceObjects(d *schema.ResourceData, vmConfig *vms.GetResponseD
 		networkDeviceObjects = networkDeviceObjects[:len(networkDeviceObjects)-1]
 	}
 
-	for _, netDevice := range networkDeviceObjects {
-		if netDevice == nil {
+	for i, netDevice := range networkDeviceObjects {
+		switch {
+		case netDevice == nil && indexed:
+			macAddresses = append(macAddresses, "")
+		case netDevice == nil:
 			networkDevices = append(networkDevices, nil)
 			macAddresses = append(macAddresses, "")
-		} else {
+		default:
+			index := dvNetworkDeviceIndex
+			if indexed {
+				index = i
+			}
+
 			networkDevices = append(networkDevices, map[string]any{
 				mkNetworkDeviceBridge:       valueOrDefault(netDevice.Bridge, ""),
 				mkNetworkDeviceEnabled:      netDevice.Enabled,
 				mkNetworkDeviceDisconnected: valueOrDefault(netDevice.LinkDown, false),
 				mkNetworkDeviceFirewall:     valueOrDefault(netDevice.Firewall, false),
+				mkNetworkDeviceIndex:        index,
 				mkNetworkDeviceMACAddress:   valueOrDefault(netDevice.MACAddress, ""),
 				mkNetworkDeviceModel:        netDevice.Model,
 				mkNetworkDeviceQueues:       valueOrDefault(netDevice.Queues, 0),
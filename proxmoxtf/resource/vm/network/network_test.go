@@ -0,0 +1,180 @@
+/*
+ * This Source Code Form is subject to the terms of the Mozilla Public
+ * License, v. 2.0. If a copy of the MPL was not distributed with this
+ * file, You can obtain one at https://mozilla.org/MPL/2.0/.
+ */
+
+package network
+
+import (
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/stretchr/testify/require"
+
+	"github.com/bpg/terraform-provider-proxmox/proxmox/nodes/vms"
+)
+
+func TestCheckQueues(t *testing.T) {
+	t.Parallel()
+
+	devices := func(queues ...int) []any {
+		list := make([]any, len(queues))
+		for i, q := range queues {
+			list[i] = map[string]any{mkNetworkDeviceQueues: q}
+		}
+
+		return list
+	}
+
+	tests := []struct {
+		name    string
+		devices []any
+		vcpus   int
+		wantErr bool
+	}{
+		{"no queues", devices(0), 2, false},
+		{"equal to vcpus", devices(4), 4, false},
+		{"exceeds vcpus", devices(2, 8), 4, true},
+		{"unknown vcpus", devices(8), 0, false},
+		{"no devices", nil, 1, false},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			err := CheckQueues(tt.devices, tt.vcpus)
+			if tt.wantErr {
+				require.Error(t, err)
+			} else {
+				require.NoError(t, err)
+			}
+		})
+	}
+}
+
+func TestCheckIndices(t *testing.T) {
+	t.Parallel()
+
+	devices := func(indices ...int) []any {
+		list := make([]any, len(indices))
+		for i, index := range indices {
+			list[i] = map[string]any{mkNetworkDeviceIndex: index}
+		}
+
+		return list
+	}
+
+	tests := []struct {
+		name    string
+		devices []any
+		wantErr bool
+	}{
+		{"positional", devices(-1, -1), false},
+		{"explicit with gap", devices(0, 2), false},
+		{"mixed", devices(0, -1), true},
+		{"duplicate", devices(1, 1), true},
+		{"descending", devices(2, 0), true},
+		{"no devices", nil, false},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			err := CheckIndices(tt.devices)
+			if tt.wantErr {
+				require.Error(t, err)
+			} else {
+				require.NoError(t, err)
+			}
+		})
+	}
+}
+
+// TestGetNetworkDeviceObjectsWithIndices verifies that removing a device in the middle of a list with explicit
+// indices keeps the remaining devices on their `net<index>` slots, and only leaves the removed one disabled.
+func TestGetNetworkDeviceObjectsWithIndices(t *testing.T) {
+	t.Parallel()
+
+	d := schema.TestResourceDataRaw(t, Schema(), map[string]any{
+		MkNetworkDevice: []any{
+			map[string]any{mkNetworkDeviceIndex: 0, mkNetworkDeviceBridge: "vmbr0"},
+			map[string]any{mkNetworkDeviceIndex: 2, mkNetworkDeviceBridge: "vmbr2"},
+		},
+	})
+
+	devices, err := GetNetworkDeviceObjects(d)
+	require.NoError(t, err)
+	require.Len(t, devices, 3)
+
+	require.True(t, devices[0].Enabled)
+	require.Equal(t, "vmbr0", *devices[0].Bridge)
+	require.False(t, devices[1].Enabled)
+	require.True(t, devices[2].Enabled)
+	require.Equal(t, "vmbr2", *devices[2].Bridge)
+}
+
+// TestReadNetworkDeviceObjectsWithIndices verifies that devices keyed by an explicit index are read back
+// without the gaps between them, so the state matches the configuration.
+func TestReadNetworkDeviceObjectsWithIndices(t *testing.T) {
+	t.Parallel()
+
+	d := schema.TestResourceDataRaw(t, Schema(), map[string]any{
+		MkNetworkDevice: []any{
+			map[string]any{mkNetworkDeviceIndex: 0},
+			map[string]any{mkNetworkDeviceIndex: 2},
+		},
+	})
+
+	bridge0, bridge2 := "vmbr0", "vmbr2"
+	vmConfig := &vms.GetResponseData{
+		NetworkDevices: vms.CustomNetworkDeviceMap{
+			"net0": {Enabled: true, Bridge: &bridge0, Model: "virtio"},
+			"net2": {Enabled: true, Bridge: &bridge2, Model: "virtio"},
+		},
+	}
+
+	require.Empty(t, ReadNetworkDeviceObjects(d, vmConfig))
+
+	devices := d.Get(MkNetworkDevice).([]any)
+	require.Len(t, devices, 2)
+	require.Equal(t, 0, devices[0].(map[string]any)[mkNetworkDeviceIndex])
+	require.Equal(t, "vmbr2", devices[1].(map[string]any)[mkNetworkDeviceBridge])
+	require.Equal(t, 2, devices[1].(map[string]any)[mkNetworkDeviceIndex])
+}
+
+func TestNetworkDeviceMTUValidation(t *testing.T) {
+	t.Parallel()
+
+	validate := Schema()[MkNetworkDevice].Elem.(*schema.Resource).Schema[mkNetworkDeviceMTU].ValidateDiagFunc
+
+	tests := []struct {
+		name  string
+		mtu   int
+		valid bool
+	}{
+		{"unset", 0, true},
+		{"inherit from bridge", 1, true},
+		{"minimum", 68, true},
+		{"jumbo frames", 9000, true},
+		{"maximum", 65520, true},
+		{"too small", 67, false},
+		{"too large", 65521, false},
+		{"negative", -1, false},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			res := validate(tt.mtu, nil)
+			if tt.valid {
+				require.Empty(t, res, "validate: '%d'", tt.mtu)
+			} else {
+				require.NotEmpty(t, res, "validate: '%d'", tt.mtu)
+			}
+		})
+	}
+}
@@ -18,6 +18,7 @@ const (
 	dvNetworkDeviceBridge    = "vmbr0"
 	dvNetworkDeviceEnabled   = true
 	dvNetworkDeviceFirewall  = false
+	dvNetworkDeviceIndex     = -1
 	dvNetworkDeviceMTU       = 0
 	dvNetworkDeviceModel     = "virtio"
 	dvNetworkDeviceQueues    = 0
@@ -34,6 +35,7 @@ const (
 	mkNetworkDeviceDisconnected = "disconnected"
 	mkNetworkDeviceEnabled      = "enabled"
 	mkNetworkDeviceFirewall     = "firewall"
+	mkNetworkDeviceIndex        = "index"
 	mkNetworkDeviceMACAddress   = "mac_address"
 	mkNetworkDeviceMTU          = "mtu"
 	mkNetworkDeviceModel        = "model"
@@ -105,6 +107,14 @@ func Schema() map[string]*schema.Schema {
 						Optional:    true,
 						Default:     dvNetworkDeviceFirewall,
 					},
+					mkNetworkDeviceIndex: {
+						Type: schema.TypeInt,
+						Description: "The index of the `net<index>` device, the position in the list is used when not set. " +
+							"Either all or none of the network devices must set it",
+						Optional:         true,
+						Default:          dvNetworkDeviceIndex,
+						ValidateDiagFunc: validation.ToDiagFunc(validation.IntBetween(-1, MaxNetworkDevices-1)),
+					},
 					mkNetworkDeviceMACAddress: {
 						Type:             schema.TypeString,
 						Description:      "The MAC address",
@@ -127,16 +137,17 @@ func Schema() map[string]*schema.Schema {
 					},
 					mkNetworkDeviceQueues: {
 						Type:             schema.TypeInt,
-						Description:      "Number of packet queues to be used on the device",
+						Description:      "Number of packet queues to be used on the device, at most the number of vCPUs",
 						Optional:         true,
 						Default:          dvNetworkDeviceQueues,
 						ValidateDiagFunc: validation.ToDiagFunc(validation.IntBetween(0, 64)),
 					},
 					mkNetworkDeviceRateLimit: {
-						Type:        schema.TypeFloat,
-						Description: "The rate limit in megabytes per second",
-						Optional:    true,
-						Default:     dvNetworkDeviceRateLimit,
+						Type:             schema.TypeFloat,
+						Description:      "The rate limit in megabytes per second, `0` for unlimited",
+						Optional:         true,
+						Default:          dvNetworkDeviceRateLimit,
+						ValidateDiagFunc: validation.ToDiagFunc(validation.FloatAtLeast(0)),
 					},
 					mkNetworkDeviceVLANID: {
 						Type:        schema.TypeInt,
@@ -151,9 +162,13 @@ func Schema() map[string]*schema.Schema {
 					},
 					mkNetworkDeviceMTU: {
 						Type:        schema.TypeInt,
-						Description: "Maximum transmission unit (MTU)",
+						Description: "Maximum transmission unit (MTU), `1` to use the bridge MTU",
 						Optional:    true,
 						Default:     dvNetworkDeviceMTU,
+						ValidateDiagFunc: validation.ToDiagFunc(validation.Any(
+							validation.IntInSlice([]int{dvNetworkDeviceMTU, 1}),
+							validation.IntBetween(68, 65520),
+						)),
 					},
 				},
 			},
@@ -173,6 +188,7 @@ func Schema() map[string]*schema.Schema {
 func CustomizeDiff() []schema.CustomizeDiffFunc {
 	return []schema.CustomizeDiffFunc{
 		forceEmptyNetworkDeviceDiff,
+		validateNetworkDeviceIndices,
 		customdiff.ComputedIf(
 			mkIPv4Addresses,
 			func(_ context.Context, d *schema.ResourceDiff, _ any) bool {
@@ -220,3 +236,14 @@ func forceEmptyNetworkDeviceDiff(_ context.Context, d *schema.ResourceDiff, _ an
 
 	return nil
 }
+
+// validateNetworkDeviceIndices checks the explicit indices of the network devices during plan.
+func validateNetworkDeviceIndices(_ context.Context, d *schema.ResourceDiff, _ any) error {
+	if !d.NewValueKnown(MkNetworkDevice) {
+		return nil
+	}
+
+	devices, _ := d.Get(MkNetworkDevice).([]any)
+
+	return CheckIndices(devices)
+}
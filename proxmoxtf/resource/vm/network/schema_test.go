@@ -36,6 +36,7 @@ func TestNetworkSchema(t *testing.T) {
 	test.AssertOptionalArguments(t, deviceSchema, []string{
 		mkNetworkDeviceBridge,
 		mkNetworkDeviceEnabled,
+		mkNetworkDeviceIndex,
 		mkNetworkDeviceMACAddress,
 		mkNetworkDeviceModel,
 		mkNetworkDeviceRateLimit,
@@ -46,6 +47,7 @@ func TestNetworkSchema(t *testing.T) {
 	test.AssertValueTypes(t, deviceSchema, map[string]schema.ValueType{
 		mkNetworkDeviceBridge:     schema.TypeString,
 		mkNetworkDeviceEnabled:    schema.TypeBool,
+		mkNetworkDeviceIndex:      schema.TypeInt,
 		mkNetworkDeviceMACAddress: schema.TypeString,
 		mkNetworkDeviceModel:      schema.TypeString,
 		mkNetworkDeviceRateLimit:  schema.TypeFloat,
@@ -8,6 +8,7 @@ package resource
 
 import (
 	"fmt"
+	"net"
 	"regexp"
 	"strings"
 	"time"
@@ -149,10 +150,29 @@ func CPUTypeValidator() schema.SchemaValidateDiagFunc {
 	))
 }
 
+// CPUFlagValidator returns a schema validation function for a CPU flag. Proxmox VE only allows the flags
+// of its security-related set to be set on a VM, any other flag must go into a custom CPU model.
+func CPUFlagValidator() schema.SchemaValidateDiagFunc {
+	r := regexp.MustCompile(
+		`^[+-](?:aes|amd-no-ssb|amd-ssbd|hv-evmcs|hv-tlbflush|ibpb|md-clear|pcid|pdpe1gb|spec-ctrl|ssbd|virt-ssbd)$`,
+	)
+
+	return validation.ToDiagFunc(validation.StringMatch(
+		r, "must be '+' or '-' followed by one of aes, amd-no-ssb, amd-ssbd, hv-evmcs, hv-tlbflush, ibpb, "+
+			"md-clear, pcid, pdpe1gb, spec-ctrl, ssbd or virt-ssbd",
+	))
+}
+
 // CPUAffinityValidator returns a schema validation function for a CPU affinity.
 func CPUAffinityValidator() schema.SchemaValidateDiagFunc {
 	return validation.ToDiagFunc(
-		validation.StringMatch(regexp.MustCompile(`^\d+[\d-,]*$`), "must contain numbers or number ranges separated by ','"),
+		validation.Any(
+			validation.StringIsEmpty,
+			validation.StringMatch(
+				regexp.MustCompile(`^\d+(-\d+)?(,\d+(-\d+)?)*$`),
+				"must contain numbers or number ranges separated by ',', e.g. '0-3,8'",
+			),
+		),
 	)
 }
 
@@ -166,6 +186,36 @@ func AMDSEVTypeValidator() schema.SchemaValidateDiagFunc {
 	return validation.ToDiagFunc(validation.StringInSlice([]string{"std", "es", "snp"}, false))
 }
 
+// KVMArgumentsValidator is a schema validation function for raw KVM arguments. The arguments are passed through
+// as is, so a non-empty value only produces a warning.
+func KVMArgumentsValidator() schema.SchemaValidateDiagFunc {
+	return validation.ToDiagFunc(func(i any, k string) ([]string, []error) {
+		v, ok := i.(string)
+		if !ok {
+			return nil, []error{fmt.Errorf("expected type of %s to be string", k)}
+		}
+
+		if strings.TrimSpace(v) == "" {
+			return nil, nil
+		}
+
+		return []string{fmt.Sprintf(
+			"%s passes arguments to QEMU unchecked. This is an advanced and unsupported setting, which may "+
+				"prevent the VM from starting or break live migration.", k,
+		)}, nil
+	})
+}
+
+// HostUSBDeviceValidator is a schema validation function for host USB devices.
+func HostUSBDeviceValidator() schema.SchemaValidateDiagFunc {
+	// the vendor and product IDs may be prefixed with 0x, matching PVE's own format
+	r := regexp.MustCompile(`^(?:(?:0x)?[0-9a-fA-F]{4}:(?:0x)?[0-9a-fA-F]{4}|\d+-\d+(?:\.\d+)*|spice)$`)
+
+	return validation.ToDiagFunc(validation.StringMatch(
+		r, "must be a '[0x]<VENDOR>:[0x]<PRODUCT>' ID, a '<BUS>-<PORT>' path or 'spice'",
+	))
+}
+
 // HotplugValidator is a schema validation function for hotplug features.
 func HotplugValidator() schema.SchemaValidateDiagFunc {
 	return validation.ToDiagFunc(func(i any, k string) ([]string, []error) {
@@ -330,6 +380,51 @@ func CloudInitInterfaceValidator() schema.SchemaValidateDiagFunc {
 	))
 }
 
+// CloudInitIPv6AddressValidator is a schema validation function for the IPv6 address of a cloud-init IP config,
+// which is either an address in CIDR notation, `dhcp` for DHCPv6 or `auto` for SLAAC.
+func CloudInitIPv6AddressValidator() schema.SchemaValidateDiagFunc {
+	return validation.ToDiagFunc(func(i any, k string) ([]string, []error) {
+		v, ok := i.(string)
+		if !ok {
+			return nil, []error{fmt.Errorf("expected type of %s to be string", k)}
+		}
+
+		if v == "" || v == "dhcp" || v == "auto" {
+			return nil, nil
+		}
+
+		ip, _, err := net.ParseCIDR(v)
+		if err != nil || ip.To4() != nil {
+			return nil, []error{fmt.Errorf(
+				"expected %s to be an IPv6 address in CIDR notation, 'dhcp' or 'auto', got %q", k, v,
+			)}
+		}
+
+		return nil, nil
+	})
+}
+
+// CloudInitIPv6GatewayValidator is a schema validation function for the IPv6 gateway of a cloud-init IP config.
+func CloudInitIPv6GatewayValidator() schema.SchemaValidateDiagFunc {
+	return validation.ToDiagFunc(func(i any, k string) ([]string, []error) {
+		v, ok := i.(string)
+		if !ok {
+			return nil, []error{fmt.Errorf("expected type of %s to be string", k)}
+		}
+
+		if v == "" {
+			return nil, nil
+		}
+
+		// net.ParseIP also accepts IPv4 addresses
+		if ip := net.ParseIP(v); ip == nil || ip.To4() != nil {
+			return nil, []error{fmt.Errorf("expected %s to be an IPv6 address, got %q", k, v)}
+		}
+
+		return nil, nil
+	})
+}
+
 // CloudInitTypeValidator is a schema validation function for cloud-init types.
 func CloudInitTypeValidator() schema.SchemaValidateDiagFunc {
 	return validation.ToDiagFunc(validation.StringInSlice([]string{
@@ -350,6 +445,7 @@ func AudioDeviceValidator() schema.SchemaValidateDiagFunc {
 // AudioDriverValidator is a schema validation function for audio drivers.
 func AudioDriverValidator() schema.SchemaValidateDiagFunc {
 	return validation.ToDiagFunc(validation.StringInSlice([]string{
+		"none",
 		"spice",
 	}, false))
 }
@@ -9,6 +9,7 @@ package resource
 import (
 	"testing"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
 )
@@ -44,6 +45,217 @@ func TestCPUType(t *testing.T) {
 	}
 }
 
+func TestAudioDriver(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name  string
+		value string
+		valid bool
+	}{
+		{"empty", "", false},
+		{"invalid", "alsa", false},
+		{"valid spice", "spice", true},
+		{"valid none", "none", true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			f := AudioDriverValidator()
+			res := f(tt.value, nil)
+
+			if tt.valid {
+				require.Empty(t, res, "validate: '%s'", tt.value)
+			} else {
+				require.NotEmpty(t, res, "validate: '%s'", tt.value)
+			}
+		})
+	}
+}
+
+func TestKVMArguments(t *testing.T) {
+	t.Parallel()
+
+	f := KVMArgumentsValidator()
+
+	require.Empty(t, f("", nil))
+	require.Empty(t, f("  ", nil))
+
+	res := f("-cpu host,+kvm_pv_unhalt", nil)
+	require.Len(t, res, 1)
+	require.Equal(t, diag.Warning, res[0].Severity)
+}
+
+func TestHostUSBDevice(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name  string
+		value string
+		valid bool
+	}{
+		{"vendor and product", "1234:abCD", true},
+		{"hex prefixed vendor and product", "0x1234:0xabCD", true},
+		{"hex prefixed vendor only", "0x1234:5678", true},
+		{"bus and port", "1-2", true},
+		{"bus and nested port", "1-2.3.4", true},
+		{"spice", "spice", true},
+		{"short id", "123:5678", false},
+		{"uppercase hex prefix", "0X1234:5678", false},
+		{"open path", "1-", false},
+		{"mapping name", "usbdisk", false},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			f := HostUSBDeviceValidator()
+			res := f(tt.value, nil)
+
+			if tt.valid {
+				require.Empty(t, res, "validate: '%s'", tt.value)
+			} else {
+				require.NotEmpty(t, res, "validate: '%s'", tt.value)
+			}
+		})
+	}
+}
+
+func TestCloudInitIPv6Address(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name  string
+		value string
+		valid bool
+	}{
+		{"empty", "", true},
+		{"dhcp", "dhcp", true},
+		{"slaac", "auto", true},
+		{"static", "2001:db8::5/64", true},
+		{"no prefix", "2001:db8::5", false},
+		{"ipv4", "192.168.1.5/24", false},
+		{"invalid", "manual", false},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			f := CloudInitIPv6AddressValidator()
+			res := f(tt.value, nil)
+
+			if tt.valid {
+				require.Empty(t, res, "validate: '%s'", tt.value)
+			} else {
+				require.NotEmpty(t, res, "validate: '%s'", tt.value)
+			}
+		})
+	}
+}
+
+func TestCloudInitIPv6Gateway(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name  string
+		value string
+		valid bool
+	}{
+		{"empty", "", true},
+		{"address", "2001:db8::1", true},
+		{"cidr", "2001:db8::1/64", false},
+		{"ipv4", "192.168.1.1", false},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			f := CloudInitIPv6GatewayValidator()
+			res := f(tt.value, nil)
+
+			if tt.valid {
+				require.Empty(t, res, "validate: '%s'", tt.value)
+			} else {
+				require.NotEmpty(t, res, "validate: '%s'", tt.value)
+			}
+		})
+	}
+}
+
+func TestCPUFlag(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name  string
+		value string
+		valid bool
+	}{
+		{"enable aes", "+aes", true},
+		{"disable pcid", "-pcid", true},
+		{"md-clear", "+md-clear", true},
+		{"no prefix", "aes", false},
+		{"unknown flag", "+avx512f", false},
+		{"two flags", "+aes;+pcid", false},
+		{"empty", "", false},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			f := CPUFlagValidator()
+			res := f(tt.value, nil)
+
+			if tt.valid {
+				require.Empty(t, res, "validate: '%s'", tt.value)
+			} else {
+				require.NotEmpty(t, res, "validate: '%s'", tt.value)
+			}
+		})
+	}
+}
+
+func TestCPUAffinity(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name  string
+		value string
+		valid bool
+	}{
+		{"empty clears affinity", "", true},
+		{"single core", "0", true},
+		{"range", "0-3", true},
+		{"range and core", "0-3,8", true},
+		{"multiple ranges", "0-1,4-5,8", true},
+		{"leading comma", ",1", false},
+		{"trailing comma", "1,", false},
+		{"double dash", "0--3", false},
+		{"open range", "0-", false},
+		{"letters", "all", false},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			f := CPUAffinityValidator()
+			res := f(tt.value, nil)
+
+			if tt.valid {
+				require.Empty(t, res, "validate: '%s'", tt.value)
+			} else {
+				require.NotEmpty(t, res, "validate: '%s'", tt.value)
+			}
+		})
+	}
+}
+
 func TestMachineType(t *testing.T) {
 	t.Parallel()
 
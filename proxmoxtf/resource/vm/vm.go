@@ -11,6 +11,7 @@ import (
 	"encoding/base64"
 	"errors"
 	"fmt"
+	"maps"
 	"path/filepath"
 	"regexp"
 	"slices"
@@ -31,9 +32,13 @@ import (
 	"github.com/bpg/terraform-provider-proxmox/proxmox/api"
 	"github.com/bpg/terraform-provider-proxmox/proxmox/cluster"
 	haresources "github.com/bpg/terraform-provider-proxmox/proxmox/cluster/ha/resources"
+	"github.com/bpg/terraform-provider-proxmox/proxmox/helpers/ptr"
+	"github.com/bpg/terraform-provider-proxmox/proxmox/nodes/tasks"
 	"github.com/bpg/terraform-provider-proxmox/proxmox/nodes/vms"
 	"github.com/bpg/terraform-provider-proxmox/proxmox/pools"
+	"github.com/bpg/terraform-provider-proxmox/proxmox/storage"
 	"github.com/bpg/terraform-provider-proxmox/proxmox/types"
+	proxmoxtypes "github.com/bpg/terraform-provider-proxmox/proxmox/types/hardwaremapping"
 	"github.com/bpg/terraform-provider-proxmox/proxmoxtf"
 	sdkresource "github.com/bpg/terraform-provider-proxmox/proxmoxtf/resource"
 	"github.com/bpg/terraform-provider-proxmox/proxmoxtf/resource/validators"
@@ -46,7 +51,6 @@ import (
 const (
 	dvRebootAfterCreation    = false
 	dvRebootAfterUpdate      = true
-	dvOnBoot                 = true
 	dvACPI                   = true
 	dvAgentEnabled           = false
 	dvAgentTimeout           = "15m"
@@ -69,6 +73,7 @@ const (
 	dvCloneNodeName          = ""
 	dvCloneFull              = true
 	dvCloneRetries           = 1
+	dvCloneBandwidthLimit    = 0
 	dvCPUArchitecture        = ""
 	dvCPUCores               = 1
 	dvCPUHotplugged          = 0
@@ -104,14 +109,19 @@ const (
 	dvMemoryDedicated                   = 512
 	dvMemoryFloating                    = 0
 	dvMemoryShared                      = 0
+	dvMemoryShares                      = 1000
 	dvMemoryHugepages                   = ""
 	dvMemoryKeepHugepages               = false
 	dvMigrate                           = false
+	dvMigrationWithLocalDisks           = true
+	dvMigrationBandwidthLimit           = 0
 	dvName                              = ""
 
 	dvOperatingSystemType              = "other"
 	dvPoolID                           = ""
 	dvProtection                       = false
+	dvRestoreDatastoreID               = ""
+	dvRestoreUnique                    = false
 	dvRNGMaxBytes                      = 1024
 	dvRNGPeriod                        = 1000
 	dvSerialDeviceDevice               = "socket"
@@ -121,6 +131,8 @@ const (
 	dvSMBIOSSKU                        = ""
 	dvSMBIOSSerial                     = ""
 	dvSMBIOSVersion                    = ""
+	dvSpiceEnhancementsFolderSharing   = false
+	dvSpiceEnhancementsVideoStreaming  = "off"
 	dvStarted                          = true
 	dvStartupOrder                     = -1
 	dvStartupUpDelay                   = -1
@@ -164,6 +176,7 @@ const (
 	mkOnBoot                 = "on_boot"
 	mkBootOrder              = "boot_order"
 	mkACPI                   = "acpi"
+	mkAllowReboot            = "allow_reboot"
 	mkAgent                  = "agent"
 	mkAgentEnabled           = "enabled"
 	mkAgentTimeout           = "timeout"
@@ -194,6 +207,7 @@ const (
 	mkCloneNodeName          = "node_name"
 	mkCloneVMID              = "vm_id"
 	mkCloneFull              = "full"
+	mkCloneBandwidthLimit    = "bwlimit"
 	mkCPU                    = "cpu"
 	mkCPUArchitecture        = "architecture"
 	mkCPUCores               = "cores"
@@ -257,23 +271,35 @@ const (
 	mkInitializationNetworkDataFileID   = "network_data_file_id"
 	mkInitializationMetaDataFileID      = "meta_data_file_id"
 
-	mkKeyboardLayout      = "keyboard_layout"
-	mkKVMArguments        = "kvm_arguments"
-	mkMachine             = "machine"
-	mkMemory              = "memory"
-	mkMemoryDedicated     = "dedicated"
-	mkMemoryFloating      = "floating"
-	mkMemoryShared        = "shared"
-	mkMemoryHugepages     = "hugepages"
-	mkMemoryKeepHugepages = "keep_hugepages"
-	mkMigrate             = "migrate"
-	mkName                = "name"
+	mkFreeze                  = "freeze"
+	mkKeyboardLayout          = "keyboard_layout"
+	mkKVM                     = "kvm"
+	mkKVMArguments            = "kvm_arguments"
+	mkLocalTime               = "local_time"
+	mkMachine                 = "machine"
+	mkMemory                  = "memory"
+	mkMemoryDedicated         = "dedicated"
+	mkMemoryFloating          = "floating"
+	mkMemoryShared            = "shared"
+	mkMemoryShares            = "shares"
+	mkMemoryHugepages         = "hugepages"
+	mkMemoryKeepHugepages     = "keep_hugepages"
+	mkMigrate                 = "migrate"
+	mkMigration               = "migration"
+	mkMigrationTargetStorage  = "target_storage"
+	mkMigrationWithLocalDisks = "with_local_disks"
+	mkMigrationBandwidthLimit = "bwlimit"
+	mkName                    = "name"
 
 	mkNodeName                         = "node_name"
 	mkOperatingSystem                  = "operating_system"
 	mkOperatingSystemType              = "type"
 	mkPoolID                           = "pool_id"
 	mkProtection                       = "protection"
+	mkRestore                          = "restore"
+	mkRestoreBackupFile                = "backup_file"
+	mkRestoreDatastoreID               = "datastore_id"
+	mkRestoreUnique                    = "unique"
 	mkRNG                              = "rng"
 	mkRNGSource                        = "source"
 	mkRNGMaxBytes                      = "max_bytes"
@@ -288,6 +314,9 @@ const (
 	mkSMBIOSSerial                     = "serial"
 	mkSMBIOSUUID                       = "uuid"
 	mkSMBIOSVersion                    = "version"
+	mkSpiceEnhancements                = "spice_enhancements"
+	mkSpiceEnhancementsFolderSharing   = "folder_sharing"
+	mkSpiceEnhancementsVideoStreaming  = "video_streaming"
 	mkStarted                          = "started"
 	mkStartup                          = "startup"
 	mkStartupOrder                     = "order"
@@ -338,6 +367,18 @@ const (
 // be used for data protection.
 const MaskedPassword = "**********"
 
+// vmCloudInitPasswordForState returns the cloud-init password to store in the state. PVE never returns the
+// configured password, only a masked placeholder, so the value already known from the configuration (plaintext
+// or pre-hashed) is kept to avoid a diff on every plan. Imported VMs keep the placeholder, which is suppressed
+// by the schema diff function.
+func vmCloudInitPasswordForState(current, fromAPI string) string {
+	if fromAPI == MaskedPassword && current != "" && strings.ReplaceAll(current, "*", "") != "" {
+		return current
+	}
+
+	return fromAPI
+}
+
 // VM returns a resource that manages VMs.
 func VM() *schema.Resource {
 	s := map[string]*schema.Schema{
@@ -357,10 +398,11 @@ func VM() *schema.Resource {
 			Default:  dvRebootAfterUpdate,
 		},
 		mkOnBoot: {
-			Type:        schema.TypeBool,
-			Description: "Start VM on Node boot",
-			Optional:    true,
-			Default:     dvOnBoot,
+			Type: schema.TypeBool,
+			Description: "Whether to start the VM when the node boots, defaults to true for a new VM and is read " +
+				"back from the VM on import. Unlike `started`, this does not change the current power state of the VM",
+			Optional: true,
+			Computed: true,
 		},
 		mkBootOrder: {
 			Type:        schema.TypeList,
@@ -375,6 +417,30 @@ func VM() *schema.Resource {
 			Optional:    true,
 			Default:     dvACPI,
 		},
+		mkAllowReboot: {
+			Type:        schema.TypeBool,
+			Description: "Whether the guest is allowed to reboot, the VM is stopped on a guest reboot otherwise",
+			Optional:    true,
+			Computed:    true,
+		},
+		mkFreeze: {
+			Type:        schema.TypeBool,
+			Description: "Whether to freeze the CPU at startup",
+			Optional:    true,
+			Computed:    true,
+		},
+		mkKVM: {
+			Type:        schema.TypeBool,
+			Description: "Whether to enable KVM hardware virtualization",
+			Optional:    true,
+			Computed:    true,
+		},
+		mkLocalTime: {
+			Type:        schema.TypeBool,
+			Description: "Whether to set the real time clock to local time instead of UTC",
+			Optional:    true,
+			Computed:    true,
+		},
 		mkAgent: {
 			Type:        schema.TypeList,
 			Description: "The QEMU agent configuration",
@@ -499,10 +565,11 @@ func VM() *schema.Resource {
 			MinItems: 0,
 		},
 		mkKVMArguments: {
-			Type:        schema.TypeString,
-			Description: "The args implementation",
-			Optional:    true,
-			Default:     dvKVMArguments,
+			Type:             schema.TypeString,
+			Description:      "The args implementation",
+			Optional:         true,
+			Default:          dvKVMArguments,
+			ValidateDiagFunc: KVMArgumentsValidator(),
 		},
 		mkAudioDevice: {
 			Type:        schema.TypeList,
@@ -547,7 +614,7 @@ func VM() *schema.Resource {
 		},
 		mkCDROM: {
 			Type:        schema.TypeList,
-			Description: "The CDROM drive",
+			Description: "The CDROM drives",
 			Optional:    true,
 			DefaultFunc: func() (any, error) {
 				return []any{
@@ -586,7 +653,6 @@ func VM() *schema.Resource {
 					},
 				},
 			},
-			MaxItems: 1,
 			MinItems: 0,
 		},
 		mkClone: {
@@ -633,6 +699,50 @@ func VM() *schema.Resource {
 						ForceNew:    true,
 						Default:     dvCloneFull,
 					},
+					mkCloneBandwidthLimit: {
+						Type: schema.TypeInt,
+						Description: "The bandwidth limit in KiB/s for the clone, and the migration and disk moves " +
+							"that follow it. 0 means unlimited",
+						Optional:     true,
+						ForceNew:     true,
+						Default:      dvCloneBandwidthLimit,
+						ValidateFunc: validation.IntAtLeast(0),
+					},
+				},
+			},
+			MaxItems: 1,
+			MinItems: 0,
+		},
+		mkRestore: {
+			Type: schema.TypeList,
+			Description: "The restore configuration, the VM is created from a backup. Only used on creation, " +
+				"changes are ignored afterwards",
+			Optional:         true,
+			ConflictsWith:    []string{mkClone},
+			DiffSuppressFunc: vmRestoreDiffSuppress,
+			Elem: &schema.Resource{
+				Schema: map[string]*schema.Schema{
+					mkRestoreBackupFile: {
+						Type:             schema.TypeString,
+						Description:      "The volume ID of the backup file",
+						Required:         true,
+						DiffSuppressFunc: vmRestoreDiffSuppress,
+						ValidateDiagFunc: validators.FileID(),
+					},
+					mkRestoreDatastoreID: {
+						Type:             schema.TypeString,
+						Description:      "The ID of the datastore to restore the disks to",
+						Optional:         true,
+						Default:          dvRestoreDatastoreID,
+						DiffSuppressFunc: vmRestoreDiffSuppress,
+					},
+					mkRestoreUnique: {
+						Type:             schema.TypeBool,
+						Description:      "Whether to assign unique random ethernet addresses",
+						Optional:         true,
+						Default:          dvRestoreUnique,
+						DiffSuppressFunc: vmRestoreDiffSuppress,
+					},
 				},
 			},
 			MaxItems: 1,
@@ -676,12 +786,15 @@ func VM() *schema.Resource {
 					},
 					mkCPUFlags: {
 						Type:        schema.TypeList,
-						Description: "The CPU flags",
+						Description: "The CPU flags, e.g. `+aes` or `-pcid`",
 						Optional:    true,
 						DefaultFunc: func() (any, error) {
 							return []any{}, nil
 						},
-						Elem: &schema.Schema{Type: schema.TypeString},
+						Elem: &schema.Schema{
+							Type:             schema.TypeString,
+							ValidateDiagFunc: CPUFlagValidator(),
+						},
 					},
 					mkCPUHotplugged: {
 						Type:             schema.TypeInt,
@@ -692,7 +805,7 @@ func VM() *schema.Resource {
 					},
 					mkCPULimit: {
 						Type:        schema.TypeFloat,
-						Description: "Limit of CPU usage",
+						Description: "Limit of CPU usage, in number of cores. Value 0 indicates no limit",
 						Optional:    true,
 						Default:     dvCPULimit,
 						ValidateDiagFunc: validation.ToDiagFunc(
@@ -721,7 +834,7 @@ func VM() *schema.Resource {
 					},
 					mkCPUUnits: {
 						Type:        schema.TypeInt,
-						Description: "The CPU units",
+						Description: "The CPU weight of the VM relative to the other VMs and containers",
 						Optional:    true,
 						Computed:    true,
 						ValidateDiagFunc: validation.ToDiagFunc(
@@ -934,16 +1047,18 @@ func VM() *schema.Resource {
 									Elem: &schema.Resource{
 										Schema: map[string]*schema.Schema{
 											mkInitializationIPConfigIPv6Address: {
-												Type:        schema.TypeString,
-												Description: "The IPv6 address",
-												Optional:    true,
-												Default:     dvInitializationIPConfigIPv6Address,
+												Type:             schema.TypeString,
+												Description:      "The IPv6 address in CIDR notation, `dhcp` for DHCPv6 or `auto` for SLAAC",
+												Optional:         true,
+												Default:          dvInitializationIPConfigIPv6Address,
+												ValidateDiagFunc: CloudInitIPv6AddressValidator(),
 											},
 											mkInitializationIPConfigIPv6Gateway: {
-												Type:        schema.TypeString,
-												Description: "The IPv6 gateway",
-												Optional:    true,
-												Default:     dvInitializationIPConfigIPv6Gateway,
+												Type:             schema.TypeString,
+												Description:      "The IPv6 gateway",
+												Optional:         true,
+												Default:          dvInitializationIPConfigIPv6Gateway,
+												ValidateDiagFunc: CloudInitIPv6GatewayValidator(),
 											},
 										},
 									},
@@ -968,7 +1083,13 @@ func VM() *schema.Resource {
 									Type:        schema.TypeList,
 									Description: "The SSH keys",
 									Optional:    true,
-									Elem:        &schema.Schema{Type: schema.TypeString},
+									Elem: &schema.Schema{
+										Type: schema.TypeString,
+										// keys are stored without surrounding whitespace, e.g. the trailing newline of a key file
+										DiffSuppressFunc: func(_, oldVal, newVal string, _ *schema.ResourceData) bool {
+											return strings.TrimSpace(oldVal) == strings.TrimSpace(newVal)
+										},
+									},
 								},
 								mkInitializationUserAccountPassword: {
 									Type:        schema.TypeString,
@@ -1112,13 +1233,15 @@ func VM() *schema.Resource {
 			Elem: &schema.Resource{
 				Schema: map[string]*schema.Schema{
 					mkHostUSBDevice: {
-						Type:        schema.TypeString,
-						Description: "The USB device ID for Proxmox, in form of '<MANUFACTURER>:<ID>'",
-						Optional:    true,
+						Type: schema.TypeString,
+						Description: "The USB device of the host, either as '<VENDOR>:<PRODUCT>' ID, '<BUS>-<PORT>' path " +
+							"or 'spice'. Use either this or mapping",
+						Optional:         true,
+						ValidateDiagFunc: HostUSBDeviceValidator(),
 					},
 					mkHostUSBDeviceMapping: {
 						Type:        schema.TypeString,
-						Description: "The resource mapping name of the device, for example usbdisk. Use either this or id.",
+						Description: "The resource mapping name of the device, for example usbdisk. Use either this or host",
 						Optional:    true,
 					},
 					mkHostUSBDeviceUSB3: {
@@ -1128,6 +1251,7 @@ func VM() *schema.Resource {
 					},
 				},
 			},
+			MaxItems: maxResourceVirtualEnvironmentVMHostUSBDevices,
 		},
 		mkHotplug: {
 			Type: schema.TypeString,
@@ -1175,6 +1299,7 @@ func VM() *schema.Resource {
 						mkMemoryDedicated:     dvMemoryDedicated,
 						mkMemoryFloating:      dvMemoryFloating,
 						mkMemoryShared:        dvMemoryShared,
+						mkMemoryShares:        dvMemoryShares,
 						mkMemoryHugepages:     dvMemoryHugepages,
 						mkMemoryKeepHugepages: dvMemoryKeepHugepages,
 					},
@@ -1209,9 +1334,18 @@ func VM() *schema.Resource {
 							validation.IntBetween(0, 268435456),
 						),
 					},
+					mkMemoryShares: {
+						Type:        schema.TypeInt,
+						Description: "The memory shares for auto-ballooning",
+						Optional:    true,
+						Default:     dvMemoryShares,
+						ValidateDiagFunc: validation.ToDiagFunc(
+							validation.IntBetween(0, 50000),
+						),
+					},
 					mkMemoryHugepages: {
 						Type:         schema.TypeString,
-						Description:  "Enable/disable hugepages memory",
+						Description:  "The hugepage size in MiB (2 or 1024), or any",
 						Optional:     true,
 						Default:      dvMemoryHugepages,
 						RequiredWith: []string{"cpu.0.numa"},
@@ -1314,6 +1448,36 @@ func VM() *schema.Resource {
 			Optional:    true,
 			Default:     dvMigrate,
 		},
+		mkMigration: {
+			Type:        schema.TypeList,
+			Description: "The migration options used when the VM is migrated on node change, or its disks are moved",
+			Optional:    true,
+			MaxItems:    1,
+			Elem: &schema.Resource{
+				Schema: map[string]*schema.Schema{
+					mkMigrationTargetStorage: {
+						Type: schema.TypeString,
+						Description: "The datastore on the target node to move local disks to. " +
+							"Defaults to the same datastore as on the source node",
+						Optional:     true,
+						ValidateFunc: validation.StringIsNotEmpty,
+					},
+					mkMigrationWithLocalDisks: {
+						Type:        schema.TypeBool,
+						Description: "Whether to migrate local disks along with the VM",
+						Optional:    true,
+						Default:     dvMigrationWithLocalDisks,
+					},
+					mkMigrationBandwidthLimit: {
+						Type:         schema.TypeInt,
+						Description:  "The bandwidth limit in KiB/s for the migration and disk moves. 0 means unlimited",
+						Optional:     true,
+						Default:      dvMigrationBandwidthLimit,
+						ValidateFunc: validation.IntAtLeast(0),
+					},
+				},
+			},
+		},
 		mkOperatingSystem: {
 			Type:        schema.TypeList,
 			Description: "The operating system configuration",
@@ -1368,8 +1532,12 @@ func VM() *schema.Resource {
 						Description: "The file on the host to gather entropy from. " +
 							"In most cases, `/dev/urandom` should be preferred over `/dev/random` " +
 							"to avoid entropy-starvation issues on the host.",
-						ValidateFunc: validation.StringIsNotEmpty,
-						Required:     true,
+						ValidateDiagFunc: validation.ToDiagFunc(validation.StringInSlice([]string{
+							"/dev/hwrng",
+							"/dev/random",
+							"/dev/urandom",
+						}, false)),
+						Required: true,
 					},
 					mkRNGMaxBytes: {
 						Type: schema.TypeInt,
@@ -1452,10 +1620,11 @@ func VM() *schema.Resource {
 						Default:     dvSMBIOSSKU,
 					},
 					mkSMBIOSUUID: {
-						Type:        schema.TypeString,
-						Description: "Sets SMBIOS UUID",
-						Optional:    true,
-						Computed:    true,
+						Type:             schema.TypeString,
+						Description:      "Sets SMBIOS UUID",
+						Optional:         true,
+						Computed:         true,
+						ValidateDiagFunc: validation.ToDiagFunc(validation.IsUUID),
 					},
 					mkSMBIOSVersion: {
 						Type:        schema.TypeString,
@@ -1468,6 +1637,37 @@ func VM() *schema.Resource {
 			MaxItems: 1,
 			MinItems: 0,
 		},
+		mkSpiceEnhancements: {
+			Type:        schema.TypeList,
+			Description: "The SPICE enhancements, for use with a SPICE display (e.g. `vga.type = \"qxl\"`)",
+			Optional:    true,
+			DefaultFunc: func() (any, error) {
+				return []any{}, nil
+			},
+			Elem: &schema.Resource{
+				Schema: map[string]*schema.Schema{
+					mkSpiceEnhancementsFolderSharing: {
+						Type:        schema.TypeBool,
+						Description: "Whether to enable folder sharing through the SPICE WebDAV channel",
+						Optional:    true,
+						Default:     dvSpiceEnhancementsFolderSharing,
+					},
+					mkSpiceEnhancementsVideoStreaming: {
+						Type:        schema.TypeString,
+						Description: "The video streaming mode: `off`, `all` or `filter`",
+						Optional:    true,
+						Default:     dvSpiceEnhancementsVideoStreaming,
+						ValidateDiagFunc: validation.ToDiagFunc(validation.StringInSlice([]string{
+							"off",
+							"all",
+							"filter",
+						}, false)),
+					},
+				},
+			},
+			MaxItems: 1,
+			MinItems: 0,
+		},
 		mkStarted: {
 			Type:        schema.TypeBool,
 			Description: "Whether to start the virtual machine",
@@ -1517,8 +1717,8 @@ func VM() *schema.Resource {
 			Description: "Tags of the virtual machine. This is only meta information.",
 			Optional:    true,
 			Elem: &schema.Schema{
-				Type:         schema.TypeString,
-				ValidateFunc: validation.StringIsNotEmpty,
+				Type:             schema.TypeString,
+				ValidateDiagFunc: validators.Tag(),
 			},
 			DiffSuppressFunc:      structure.SuppressIfListsAreEqualIgnoringOrder,
 			DiffSuppressOnRefresh: true,
@@ -1526,7 +1726,8 @@ func VM() *schema.Resource {
 		mkTemplate: {
 			Type: schema.TypeBool,
 			Description: "Whether the VM should be a template. Setting this from false to true converts an " +
-				"existing VM to a template in place. Converting a template back to a regular VM is not supported.",
+				"existing VM to a template in place. A template can't be converted back to a regular VM, so " +
+				"setting this from true to false recreates the VM.",
 			Optional: true,
 			Default:  dvTemplate,
 		},
@@ -1558,13 +1759,13 @@ func VM() *schema.Resource {
 		},
 		mkTimeoutReboot: {
 			Type:        schema.TypeInt,
-			Description: "Reboot timeout",
+			Description: "Reboot timeout, after which the VM is stopped and started",
 			Optional:    true,
 			Default:     dvTimeoutReboot,
 		},
 		mkTimeoutShutdownVM: {
 			Type:        schema.TypeInt,
-			Description: "Shutdown timeout",
+			Description: "Shutdown timeout, after which the VM is forcefully stopped",
 			Optional:    true,
 			Default:     dvTimeoutShutdownVM,
 		},
@@ -1678,10 +1879,11 @@ func VM() *schema.Resource {
 			ValidateDiagFunc: SCSIHardwareValidator(),
 		},
 		mkHookScriptFileID: {
-			Type:        schema.TypeString,
-			Description: "A hook script",
-			Optional:    true,
-			Default:     dvHookScript,
+			Type:             schema.TypeString,
+			Description:      "A hook script",
+			Optional:         true,
+			Default:          dvHookScript,
+			ValidateDiagFunc: validators.SnippetFileID(),
 		},
 		mkStopOnDestroy: {
 			Type:        schema.TypeBool,
@@ -1780,16 +1982,37 @@ func VM() *schema.Resource {
 			),
 			customdiff.ForceNewIf(
 				mkNodeName,
-				func(_ context.Context, d *schema.ResourceDiff, _ any) bool {
+				func(ctx context.Context, d *schema.ResourceDiff, m any) bool {
 					if !d.HasChange(mkNodeName) {
 						return false
 					}
 
-					return !d.Get(mkMigrate).(bool)
+					return !d.Get(mkMigrate).(bool) || vmMigrationRequiresReplace(ctx, d, m)
+				},
+			),
+			customdiff.ForceNewIf(
+				mkTemplate,
+				func(_ context.Context, d *schema.ResourceDiff, _ any) bool {
+					// a template can't be converted back to a regular VM, so it has to be recreated
+					oldValue, newValue := d.GetChange(mkTemplate)
+
+					return oldValue.(bool) && !newValue.(bool)
 				},
 			),
 			forceNewOnTPMVersionChange,
 			forceNewOnEFIDiskTypeChange,
+			validateCloudInitMetaDataFile,
+			validateNUMATopology,
+			validateSerialConsole,
+			validateBootOrder,
+			validateMemory,
+			forceEmptyBootOrderDiff,
+			validateCloudInitInterface,
+			validateCDROMInterfaces,
+			validateHostUSBDevices,
+			validateCPULimit,
+			validateNetworkQueues,
+			validateTemplateDisks,
 		),
 		Importer: &schema.ResourceImporter{
 			StateContext: func(_ context.Context, d *schema.ResourceData, _ any) ([]*schema.ResourceData, error) {
@@ -1927,145 +2150,922 @@ func forceNewOnEFIDiskTypeChange(ctx context.Context, d *schema.ResourceDiff, _
 	return nil
 }
 
-func vmCreate(ctx context.Context, d *schema.ResourceData, m any) diag.Diagnostics {
-	clone := d.Get(mkClone).([]any)
+// vmMigrationRequiresReplace reports whether a node change has to re-create the VM although migration is enabled.
+// This is the case when a disk is on a datastore that is not shared, and neither local disks are migrated
+// nor a target storage is set.
+func vmMigrationRequiresReplace(ctx context.Context, d *schema.ResourceDiff, m any) bool {
+	migration, _ := d.Get(mkMigration).([]any)
+	if len(migration) > 0 && migration[0] != nil {
+		migrationBlock := migration[0].(map[string]any)
 
-	// reset the default timeout for the create operation
-	ctx = context.WithoutCancel(ctx)
+		if migrationBlock[mkMigrationTargetStorage].(string) != "" || migrationBlock[mkMigrationWithLocalDisks].(bool) {
+			return false
+		}
+	} else if dvMigrationWithLocalDisks {
+		return false
+	}
 
-	if len(clone) > 0 {
-		return vmCreateClone(ctx, d, m)
+	config, ok := m.(proxmoxtf.ProviderConfiguration)
+	if !ok {
+		return false
 	}
 
-	return vmCreateCustom(ctx, d, m)
-}
+	client, err := config.GetClient()
+	if err != nil {
+		return false
+	}
 
-// Check for an existing CloudInit IDE drive. If no such drive is found, return the specified `defaultValue`.
-func findExistingCloudInitDrive(vmConfig *vms.GetResponseData, vmID int, defaultValue string) (string, *vms.CustomStorageDevice) {
-	devs := vmConfig.StorageDevices.Filter(func(device *vms.CustomStorageDevice) bool {
-		return device.IsCloudInitDrive(vmID)
-	})
+	diskList, _ := d.Get(disk.MkDisk).([]any)
+	datastoreIDs := disk.DatastoreIDs(diskList)
 
-	for iface, device := range devs {
-		return iface, device
+	for key, datastoreKey := range map[string]string{
+		mkEFIDisk:        mkEFIDiskDatastoreID,
+		mkTPMState:       mkTPMStateDatastoreID,
+		mkInitialization: mkInitializationDatastoreID,
+	} {
+		if block, _ := d.Get(key).([]any); len(block) > 0 && block[0] != nil {
+			if id, _ := block[0].(map[string]any)[datastoreKey].(string); id != "" {
+				datastoreIDs = append(datastoreIDs, id)
+			}
+		}
 	}
 
-	return defaultValue, nil
-}
+	for _, datastoreID := range datastoreIDs {
+		datastore, err := client.Storage().GetDatastore(ctx, &storage.DatastoreGetRequest{ID: &datastoreID})
+		if err != nil {
+			tflog.Warn(ctx, "unable to read the datastore, assuming the VM can be migrated", map[string]any{
+				"datastore_id": datastoreID,
+				"error":        err,
+			})
 
-// Return a pointer to the storage device configuration based on a name. The device name is assumed to be a
-// valid ide, sata, or scsi interface name.
-func getStorageDevice(vmConfig *vms.GetResponseData, deviceName string) *vms.CustomStorageDevice {
-	if dev, ok := vmConfig.StorageDevices[deviceName]; ok {
-		return dev
+			continue
+		}
+
+		if datastore.Shared == nil || !bool(*datastore.Shared) {
+			return true
+		}
 	}
 
-	return nil
+	return false
 }
 
-// Delete IDE interfaces that can then be used for CloudInit. The first interface will always
-// be deleted. The second will be deleted only if it isn't empty and isn't the same as the
-// first.
-func deleteIdeDrives(ctx context.Context, vmAPI *vms.Client, itf1 string, itf2 string) diag.Diagnostics {
-	ddUpdateBody := &vms.UpdateRequestBody{}
-	ddUpdateBody.Delete = append(ddUpdateBody.Delete, itf1)
-	tflog.Debug(ctx, fmt.Sprintf("Deleting IDE interface '%s'", itf1))
+// validateCloudInitMetaDataFile makes sure a changed meta data file exists on a datastore
+// that supports snippets, so a broken `cicustom` value is caught during plan.
+func validateCloudInitMetaDataFile(ctx context.Context, d *schema.ResourceDiff, m any) error {
+	key := fmt.Sprintf("%s.0.%s", mkInitialization, mkInitializationMetaDataFileID)
 
-	if itf2 != "" && itf2 != itf1 {
-		ddUpdateBody.Delete = append(ddUpdateBody.Delete, itf2)
-		tflog.Debug(ctx, fmt.Sprintf("Deleting IDE interface '%s'", itf2))
+	if !d.HasChange(key) || !d.NewValueKnown(key) || !d.NewValueKnown(mkNodeName) {
+		return nil
 	}
 
-	e := vmAPI.UpdateVM(ctx, ddUpdateBody)
-	if e != nil {
-		return diag.FromErr(e)
+	fileID, _ := d.Get(key).(string)
+	if fileID == "" {
+		return nil
 	}
 
-	return nil
-}
+	config, ok := m.(proxmoxtf.ProviderConfiguration)
+	if !ok {
+		return nil
+	}
 
-// Start the VM, then wait for it to actually start; it may not be started immediately if running in HA mode.
-func vmStart(ctx context.Context, vmAPI *vms.Client, d *schema.ResourceData) diag.Diagnostics {
-	tflog.Debug(ctx, "Starting VM")
+	client, err := config.GetClient()
+	if err != nil {
+		return err
+	}
 
-	startTimeoutSec := d.Get(mkTimeoutStartVM).(int)
+	datastoreID, _, _ := strings.Cut(fileID, ":")
 
-	ctx, cancel := context.WithTimeout(ctx, time.Duration(startTimeoutSec)*time.Second)
-	defer cancel()
+	datastore, err := client.Storage().GetDatastore(ctx, &storage.DatastoreGetRequest{ID: &datastoreID})
+	if err != nil {
+		return fmt.Errorf("unable to read datastore %q of %s %q: %w", datastoreID, key, fileID, err)
+	}
 
-	diags := sdkresource.TaskResultDiags(vmAPI.StartVM(ctx, startTimeoutSec), "VM start")
-	if diags.HasError() {
-		return diags
+	if datastore.ContentTypes == nil || !slices.Contains([]string(*datastore.ContentTypes), "snippets") {
+		return fmt.Errorf("%s %q: datastore %q does not support the \"snippets\" content type", key, fileID, datastoreID)
 	}
 
-	return append(diags, diag.FromErr(vmAPI.WaitForVMStatus(ctx, "running"))...)
-}
+	nodeName := d.Get(mkNodeName).(string)
 
-// Shutdown the VM, then wait for it to actually shut down (it may not be shut down immediately if
-// running in HA mode).
-func vmShutdown(ctx context.Context, vmAPI *vms.Client, d *schema.ResourceData) diag.Diagnostics {
-	tflog.Debug(ctx, "Shutting down VM")
+	_, err = client.Node(nodeName).Storage(datastoreID).GetDatastoreFile(ctx, fileID)
+	if errors.Is(err, api.ErrResourceDoesNotExist) {
+		return fmt.Errorf("%s %q: file does not exist on node %q", key, fileID, nodeName)
+	}
 
-	forceStop := types.CustomBool(true)
-	shutdownTimeoutSec := d.Get(mkTimeoutShutdownVM).(int)
+	if err != nil {
+		return fmt.Errorf("unable to read %s %q: %w", key, fileID, err)
+	}
 
-	ctx, cancel := context.WithTimeout(ctx, time.Duration(shutdownTimeoutSec)*time.Second)
-	defer cancel()
+	return nil
+}
 
-	diags := sdkresource.TaskResultDiags(vmAPI.ShutdownVM(ctx, &vms.ShutdownRequestBody{
-		ForceStop: &forceStop,
-		Timeout:   &shutdownTimeoutSec,
-	}), "VM shutdown")
-	if diags.HasError() {
-		return diags
+// validateNUMATopology checks that the CPUs of the NUMA nodes do not overlap and cover all vCPUs of the VM.
+func validateNUMATopology(_ context.Context, d *schema.ResourceDiff, _ any) error {
+	// unknown values read as zero, so the topology can only be checked once all of them are known
+	for _, key := range []string{
+		mkNUMA,
+		fmt.Sprintf("%s.0.%s", mkCPU, mkCPUCores),
+		fmt.Sprintf("%s.0.%s", mkCPU, mkCPUSockets),
+	} {
+		if !d.NewValueKnown(key) {
+			return nil
+		}
 	}
 
-	return append(diags, diag.FromErr(vmAPI.WaitForVMStatus(ctx, "stopped"))...)
-}
+	numa, _ := d.Get(mkNUMA).([]any)
+	if len(numa) == 0 {
+		return nil
+	}
 
-// Forcefully stop the VM, then wait for it to actually stop.
-func vmStop(ctx context.Context, vmAPI *vms.Client, d *schema.ResourceData) diag.Diagnostics {
-	tflog.Debug(ctx, "Stopping VM")
+	cpuIDs := make([]string, 0, len(numa))
 
-	stopTimeout := d.Get(mkTimeoutStopVM).(int)
+	for _, v := range numa {
+		block, ok := v.(map[string]any)
+		if !ok {
+			continue
+		}
 
-	ctx, cancel := context.WithTimeout(ctx, time.Duration(stopTimeout)*time.Second)
-	defer cancel()
+		ids, _ := block[mkNUMACPUIDs].(string)
+		if ids == "" {
+			// unknown or not set yet, the schema validation reports missing values
+			return nil
+		}
 
-	diags := sdkresource.TaskResultDiags(vmAPI.StopVM(ctx), "VM stop")
-	if diags.HasError() {
-		return diags
+		cpuIDs = append(cpuIDs, ids)
 	}
 
-	return append(diags, diag.FromErr(vmAPI.WaitForVMStatus(ctx, "stopped"))...)
+	cores := dvCPUCores
+	sockets := dvCPUSockets
+
+	if cpu, ok := d.Get(mkCPU).([]any); ok && len(cpu) > 0 && cpu[0] != nil {
+		cpuBlock := cpu[0].(map[string]any)
+		cores = cpuBlock[mkCPUCores].(int)
+		sockets = cpuBlock[mkCPUSockets].(int)
+	}
+
+	return vmCheckNUMACPUs(cpuIDs, cores*sockets)
 }
 
-type vmPowerTracker struct {
-	// VM was stopped during the update by the provider
-	stoppedByProvider bool
-	// reboot_after_update warning was already emitted for this operation
-	rebootAfterUpdateWarningEmitted bool
+// vmDiffHasSource reports whether the VM is cloned or restored, and inherits its configuration from the source.
+func vmDiffHasSource(d *schema.ResourceDiff) bool {
+	for _, key := range []string{mkClone, mkRestore} {
+		if block, ok := d.Get(key).([]any); ok && len(block) > 0 {
+			return true
+		}
+	}
+
+	return false
 }
 
-// EnsureStopped ensures the VM is stopped, shutting it down if it's running.
-func (t *vmPowerTracker) EnsureStopped(ctx context.Context, vmAPI *vms.Client, d *schema.ResourceData) diag.Diagnostics {
-	vmStatus, err := vmAPI.GetVMStatus(ctx)
-	if err != nil {
-		return diag.FromErr(err)
+// validateSerialConsole checks that a serial VGA type (`serialN`) refers to a configured serial device.
+// Cloned VMs are skipped, as they may inherit the serial devices of the source VM.
+func validateSerialConsole(_ context.Context, d *schema.ResourceDiff, _ any) error {
+	if !d.NewValueKnown(mkVGA) || !d.NewValueKnown(mkSerialDevice) {
+		return nil
 	}
 
-	if vmStatus == nil || vmStatus.Status == "stopped" {
+	if vmDiffHasSource(d) {
 		return nil
 	}
 
-	agentEnabled, diags := isAgentEnabled(ctx, vmAPI)
-	if diags.HasError() {
-		return diags
+	vga, _ := d.Get(mkVGA).([]any)
+	if len(vga) == 0 || vga[0] == nil {
+		return nil
 	}
 
-	if agentEnabled {
-		diags = append(diags, vmShutdown(ctx, vmAPI, d)...)
-		if diags.HasError() {
+	vgaType, _ := vga[0].(map[string]any)[mkVGAType].(string)
+	serialDevices, _ := d.Get(mkSerialDevice).([]any)
+
+	return vmCheckSerialConsole(vgaType, len(serialDevices))
+}
+
+func validateCloudInitInterface(_ context.Context, d *schema.ResourceDiff, _ any) error {
+	for _, key := range []string{mkInitialization, disk.MkDisk, mkCDROM} {
+		if !d.NewValueKnown(key) {
+			return nil
+		}
+	}
+
+	// cloned and restored VMs inherit their disks from the source, which are not known at plan time
+	if vmDiffHasSource(d) {
+		return nil
+	}
+
+	initialization, _ := d.Get(mkInitialization).([]any)
+	if len(initialization) == 0 || initialization[0] == nil {
+		return nil
+	}
+
+	initializationInterface, _ := initialization[0].(map[string]any)[mkInitializationInterface].(string)
+
+	cdrom, _ := d.Get(mkCDROM).([]any)
+	diskList, _ := d.Get(disk.MkDisk).([]any)
+
+	return vmCheckCloudInitInterface(initializationInterface, disk.Interfaces(diskList), vmCDROMInterfaces(cdrom))
+}
+
+// vmCheckCloudInitInterface checks that the cloud-init drive interface is not used by a disk or a CD-ROM drive.
+func vmCheckCloudInitInterface(initializationInterface string, diskInterfaces []string, cdromInterfaces []string) error {
+	if initializationInterface == "" {
+		return nil
+	}
+
+	if slices.Contains(diskInterfaces, initializationInterface) {
+		return fmt.Errorf(
+			"%s.0.%s %q is already used by a %s block",
+			mkInitialization, mkInitializationInterface, initializationInterface, disk.MkDisk,
+		)
+	}
+
+	if slices.Contains(cdromInterfaces, initializationInterface) {
+		return fmt.Errorf(
+			"%s.0.%s %q is already used by a %s drive",
+			mkInitialization, mkInitializationInterface, initializationInterface, mkCDROM,
+		)
+	}
+
+	return nil
+}
+
+// validateCDROMInterfaces checks the cdrom block interfaces at plan time.
+func validateCDROMInterfaces(_ context.Context, d *schema.ResourceDiff, _ any) error {
+	for _, key := range []string{disk.MkDisk, mkCDROM} {
+		if !d.NewValueKnown(key) {
+			return nil
+		}
+	}
+
+	cdrom, _ := d.Get(mkCDROM).([]any)
+	if len(cdrom) == 0 {
+		return nil
+	}
+
+	diskList, _ := d.Get(disk.MkDisk).([]any)
+
+	return vmCheckCDROMInterfaces(vmCDROMInterfaces(cdrom), disk.Interfaces(diskList))
+}
+
+// vmCheckCDROMInterfaces checks that every CD-ROM drive has its own interface, which is not used by a disk.
+func vmCheckCDROMInterfaces(cdromInterfaces []string, diskInterfaces []string) error {
+	seen := make(map[string]struct{}, len(cdromInterfaces))
+
+	for _, iface := range cdromInterfaces {
+		if _, ok := seen[iface]; ok {
+			return fmt.Errorf("%s.%s %q is used by more than one %s drive", mkCDROM, mkCDROMInterface, iface, mkCDROM)
+		}
+
+		seen[iface] = struct{}{}
+
+		if slices.Contains(diskInterfaces, iface) {
+			return fmt.Errorf("%s.%s %q is already used by a %s block", mkCDROM, mkCDROMInterface, iface, disk.MkDisk)
+		}
+	}
+
+	return nil
+}
+
+// validateTemplateDisks rejects disk resizes of an existing template at plan time.
+func validateTemplateDisks(_ context.Context, d *schema.ResourceDiff, _ any) error {
+	if d.Id() == "" || !d.HasChange(disk.MkDisk) || !d.NewValueKnown(disk.MkDisk) {
+		return nil
+	}
+
+	oldTemplate, newTemplate := d.GetChange(mkTemplate)
+	if !oldTemplate.(bool) || !newTemplate.(bool) {
+		return nil
+	}
+
+	oldDisks, newDisks := d.GetChange(disk.MkDisk)
+
+	return vmCheckTemplateDiskSizes(disk.Sizes(oldDisks.([]any)), disk.Sizes(newDisks.([]any)))
+}
+
+// vmCheckTemplateDiskSizes checks that no disk of a template changes its size.
+func vmCheckTemplateDiskSizes(oldSizes map[string]int, newSizes map[string]int) error {
+	for _, iface := range slices.Sorted(maps.Keys(newSizes)) {
+		if oldSize, ok := oldSizes[iface]; ok && oldSize != newSizes[iface] {
+			return fmt.Errorf("the %s %q of a template can't be resized, as linked clones depend on its base "+
+				"volumes; resize the disk before converting the VM to a template", disk.MkDisk, iface)
+		}
+	}
+
+	return nil
+}
+
+// validateCPULimit checks the cpu limit against the configured cores at plan time.
+func validateCPULimit(_ context.Context, d *schema.ResourceDiff, _ any) error {
+	if !d.NewValueKnown(mkCPU) {
+		return nil
+	}
+
+	// unknown values read as zero, so the limit can only be checked once all of them are known
+	for _, key := range []string{mkCPULimit, mkCPUCores, mkCPUSockets} {
+		if !d.NewValueKnown(fmt.Sprintf("%s.0.%s", mkCPU, key)) {
+			return nil
+		}
+	}
+
+	cpu, _ := d.Get(mkCPU).([]any)
+	if len(cpu) == 0 || cpu[0] == nil {
+		return nil
+	}
+
+	cpuBlock := cpu[0].(map[string]any)
+
+	limit, _ := cpuBlock[mkCPULimit].(float64)
+	cores, _ := cpuBlock[mkCPUCores].(int)
+	sockets, _ := cpuBlock[mkCPUSockets].(int)
+
+	return vmCheckCPULimit(limit, cores, sockets)
+}
+
+// vmCheckCPULimit checks that the cpu limit does not exceed the total number of configured cores.
+func vmCheckCPULimit(limit float64, cores int, sockets int) error {
+	if total := cores * sockets; total > 0 && limit > float64(total) {
+		return fmt.Errorf("%s.%s %v exceeds the %d configured cores (%s x %s)",
+			mkCPU, mkCPULimit, limit, total, mkCPUCores, mkCPUSockets)
+	}
+
+	return nil
+}
+
+// validateNetworkQueues checks that the network device queues don't exceed the configured vCPUs.
+func validateNetworkQueues(_ context.Context, d *schema.ResourceDiff, _ any) error {
+	if !d.NewValueKnown(mkCPU) || !d.NewValueKnown(network.MkNetworkDevice) {
+		return nil
+	}
+
+	cpu, _ := d.Get(mkCPU).([]any)
+	if len(cpu) == 0 || cpu[0] == nil {
+		return nil
+	}
+
+	cpuBlock := cpu[0].(map[string]any)
+
+	cores, _ := cpuBlock[mkCPUCores].(int)
+	sockets, _ := cpuBlock[mkCPUSockets].(int)
+
+	networkDevices, _ := d.Get(network.MkNetworkDevice).([]any)
+
+	return network.CheckQueues(networkDevices, cores*sockets)
+}
+
+// validateHostUSBDevices checks the usb blocks at plan time.
+func validateHostUSBDevices(_ context.Context, d *schema.ResourceDiff, _ any) error {
+	usb := d.GetRawConfig().GetAttr(mkHostUSB)
+	if usb.IsNull() || !usb.IsKnown() {
+		return nil
+	}
+
+	for i, block := range usb.AsValueSlice() {
+		host := block.GetAttr(mkHostUSBDevice)
+		mapping := block.GetAttr(mkHostUSBDeviceMapping)
+
+		if !host.IsKnown() || !mapping.IsKnown() {
+			continue
+		}
+
+		if err := vmCheckHostUSBDevice(i, !host.IsNull() && host.AsString() != "",
+			!mapping.IsNull() && mapping.AsString() != ""); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// vmCheckHostUSBDevice checks that a usb block sets exactly one of host and mapping.
+func vmCheckHostUSBDevice(index int, hasHost bool, hasMapping bool) error {
+	if hasHost == hasMapping {
+		return fmt.Errorf("%s.%d: exactly one of %s or %s must be set", mkHostUSB, index, mkHostUSBDevice, mkHostUSBDeviceMapping)
+	}
+
+	return nil
+}
+
+// vmCheckHostUSBMappings checks that every USB hardware mapping used by the VM has a device on the node.
+func vmCheckHostUSBMappings(ctx context.Context, client proxmox.Client, nodeName string, devices vms.CustomUSBDevices) error {
+	for _, device := range devices {
+		if device.Mapping == nil {
+			continue
+		}
+
+		data, err := client.Cluster().HardwareMapping().Get(ctx, proxmoxtypes.TypeUSB, *device.Mapping)
+		if err != nil {
+			return fmt.Errorf("unable to resolve %s.%s %q: %w", mkHostUSB, mkHostUSBDeviceMapping, *device.Mapping, err)
+		}
+
+		if err = vmCheckHostUSBMappingNode(*device.Mapping, nodeName, data.Map); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// vmCheckHostUSBMappingNode checks that a USB hardware mapping has a device on the node.
+func vmCheckHostUSBMappingNode(name string, nodeName string, deviceMaps []proxmoxtypes.Map) error {
+	for _, m := range deviceMaps {
+		if m.Node == nodeName {
+			return nil
+		}
+	}
+
+	return fmt.Errorf("USB hardware mapping %q has no device on node %q", name, nodeName)
+}
+
+// forceEmptyBootOrderDiff forces a diff when the user explicitly sets boot_order = [] to clear the boot order,
+// which is otherwise indistinguishable from an unset (computed) boot order.
+func forceEmptyBootOrderDiff(_ context.Context, d *schema.ResourceDiff, _ any) error {
+	bootOrderConfig := d.GetRawConfig().GetAttr(mkBootOrder)
+	if bootOrderConfig.IsNull() || !bootOrderConfig.IsKnown() || bootOrderConfig.LengthInt() > 0 {
+		return nil
+	}
+
+	if current, ok := d.Get(mkBootOrder).([]any); ok && len(current) > 0 {
+		if err := d.SetNew(mkBootOrder, []any{}); err != nil {
+			return fmt.Errorf("error forcing empty %s diff: %w", mkBootOrder, err)
+		}
+	}
+
+	return nil
+}
+
+// bootOrderDeviceRegex matches the boot devices that validateBootOrder checks against the config.
+//
+//nolint:gochecknoglobals
+var bootOrderDeviceRegex = regexp.MustCompile(`^(ide|sata|scsi|virtio|net)\d+$`)
+
+// validateMemory checks the memory block at plan time.
+func validateMemory(_ context.Context, d *schema.ResourceDiff, _ any) error {
+	if !d.NewValueKnown(mkMemory) {
+		return nil
+	}
+
+	// unknown values read as zero, so the sizes can only be compared once both of them are known
+	for _, key := range []string{mkMemoryDedicated, mkMemoryFloating} {
+		if !d.NewValueKnown(fmt.Sprintf("%s.0.%s", mkMemory, key)) {
+			return nil
+		}
+	}
+
+	memory, _ := d.Get(mkMemory).([]any)
+	if len(memory) == 0 || memory[0] == nil {
+		return nil
+	}
+
+	block := memory[0].(map[string]any)
+	dedicated, _ := block[mkMemoryDedicated].(int)
+	floating, _ := block[mkMemoryFloating].(int)
+	hugepages, _ := block[mkMemoryHugepages].(string)
+
+	if err := vmCheckMemoryFloating(dedicated, floating); err != nil {
+		return err
+	}
+
+	return vmCheckMemoryHugepages(dedicated, hugepages)
+}
+
+// vmCheckMemoryHugepages verifies that the dedicated memory is a multiple of the hugepage size.
+// With "any", PVE picks the page size itself.
+func vmCheckMemoryHugepages(dedicated int, hugepages string) error {
+	size, err := strconv.Atoi(hugepages)
+	if err != nil || size <= 0 {
+		return nil
+	}
+
+	if dedicated%size != 0 {
+		return fmt.Errorf(
+			"memory.0.%s (%d) must be a multiple of the memory.0.%s size (%d MiB)",
+			mkMemoryDedicated, dedicated, mkMemoryHugepages, size,
+		)
+	}
+
+	return nil
+}
+
+// vmCheckMemoryFloating verifies that the balloon minimum does not exceed the dedicated memory.
+func vmCheckMemoryFloating(dedicated int, floating int) error {
+	if floating > dedicated {
+		return fmt.Errorf(
+			"memory.0.%s (%d) must not be greater than memory.0.%s (%d)",
+			mkMemoryFloating, floating, mkMemoryDedicated, dedicated,
+		)
+	}
+
+	return nil
+}
+
+func validateBootOrder(_ context.Context, d *schema.ResourceDiff, _ any) error {
+	for _, key := range []string{mkBootOrder, disk.MkDisk, mkCDROM, mkInitialization} {
+		if !d.NewValueKnown(key) {
+			return nil
+		}
+	}
+
+	// cloned and restored VMs inherit devices from the source, which are not known at plan time
+	if vmDiffHasSource(d) {
+		return nil
+	}
+
+	bootOrder, _ := d.Get(mkBootOrder).([]any)
+	if len(bootOrder) == 0 {
+		return nil
+	}
+
+	devices := map[string]struct{}{}
+
+	diskList, _ := d.Get(disk.MkDisk).([]any)
+	for _, iface := range disk.Interfaces(diskList) {
+		devices[iface] = struct{}{}
+	}
+
+	cdrom, _ := d.Get(mkCDROM).([]any)
+	for _, iface := range vmCDROMInterfaces(cdrom) {
+		devices[iface] = struct{}{}
+	}
+
+	if block, ok := d.Get(mkInitialization).([]any); ok && len(block) > 0 && block[0] != nil {
+		if iface, ok := block[0].(map[string]any)[mkInitializationInterface].(string); ok && iface != "" {
+			devices[iface] = struct{}{}
+		}
+	}
+
+	networkDevices, _ := d.Get(network.MkNetworkDevice).([]any)
+	for i, device := range networkDevices {
+		devices[fmt.Sprintf("net%d", network.DeviceIndex(i, device))] = struct{}{}
+	}
+
+	// network devices are computed when not configured, only check them when they are set explicitly
+	networkConfig := d.GetRawConfig().GetAttr(network.MkNetworkDevice)
+	networkKnown := d.NewValueKnown(network.MkNetworkDevice) && !networkConfig.IsNull()
+
+	order := make([]string, len(bootOrder))
+	for i, device := range bootOrder {
+		order[i], _ = device.(string)
+
+		if !networkKnown && strings.HasPrefix(order[i], "net") {
+			devices[order[i]] = struct{}{}
+		}
+	}
+
+	return vmCheckBootOrder(order, devices)
+}
+
+// vmCheckBootOrder checks that every disk (`ideN`, `sataN`, `scsiN`, `virtioN`) and network (`netN`) device
+// in the boot order is one of the configured devices. Other devices (e.g. `hostpciN`, `usbN`) are not checked.
+func vmCheckBootOrder(bootOrder []string, devices map[string]struct{}) error {
+	for _, device := range bootOrder {
+		if !bootOrderDeviceRegex.MatchString(device) {
+			continue
+		}
+
+		if _, ok := devices[device]; !ok {
+			return fmt.Errorf("%s references device %q, which is not configured", mkBootOrder, device)
+		}
+	}
+
+	return nil
+}
+
+// vmCheckSerialConsole checks that a `serialN` VGA type uses one of the first serialDevices serial ports.
+func vmCheckSerialConsole(vgaType string, serialDevices int) error {
+	index, found := strings.CutPrefix(vgaType, "serial")
+	if !found {
+		return nil
+	}
+
+	i, err := strconv.Atoi(index)
+	if err != nil {
+		return fmt.Errorf("invalid serial VGA type %q", vgaType)
+	}
+
+	if i >= serialDevices {
+		return fmt.Errorf(
+			"%s.0.%s %q requires at least %d %s block(s), got %d",
+			mkVGA, mkVGAType, vgaType, i+1, mkSerialDevice, serialDevices,
+		)
+	}
+
+	return nil
+}
+
+// vmCheckNUMACPUs checks that the CPU ranges of the NUMA nodes (e.g. `0-3;8`) do not overlap
+// and together assign exactly the vCPUs 0 to vcpus-1.
+func vmCheckNUMACPUs(cpuIDs []string, vcpus int) error {
+	assigned := map[int]struct{}{}
+
+	for _, ids := range cpuIDs {
+		for r := range strings.SplitSeq(ids, ";") {
+			first, last, isRange := strings.Cut(r, "-")
+			if !isRange {
+				last = first
+			}
+
+			start, err := strconv.Atoi(first)
+			if err != nil {
+				return fmt.Errorf("invalid NUMA cpus %q: %w", ids, err)
+			}
+
+			end, err := strconv.Atoi(last)
+			if err != nil {
+				return fmt.Errorf("invalid NUMA cpus %q: %w", ids, err)
+			}
+
+			if end < start {
+				return fmt.Errorf("invalid NUMA cpus %q: range %q is reversed", ids, r)
+			}
+
+			for id := start; id <= end; id++ {
+				if _, found := assigned[id]; found {
+					return fmt.Errorf("NUMA cpus overlap: cpu %d is assigned to more than one NUMA node", id)
+				}
+
+				if id >= vcpus {
+					return fmt.Errorf("NUMA cpus %q: cpu %d is out of range, the VM has %d vCPUs (cores * sockets)",
+						ids, id, vcpus)
+				}
+
+				assigned[id] = struct{}{}
+			}
+		}
+	}
+
+	if len(assigned) != vcpus {
+		return fmt.Errorf("NUMA nodes assign %d cpus, but the VM has %d vCPUs (cores * sockets)", len(assigned), vcpus)
+	}
+
+	return nil
+}
+
+func vmCreate(ctx context.Context, d *schema.ResourceData, m any) diag.Diagnostics {
+	clone := d.Get(mkClone).([]any)
+
+	// reset the default timeout for the create operation
+	ctx = context.WithoutCancel(ctx)
+
+	if len(clone) > 0 {
+		return vmCreateClone(ctx, d, m)
+	}
+
+	if restore := d.Get(mkRestore).([]any); len(restore) > 0 && restore[0] != nil {
+		return vmCreateRestore(ctx, d, m)
+	}
+
+	return vmCreateCustom(ctx, d, m)
+}
+
+// vmRestoreDiffSuppress ignores the restore block once the VM exists, it is only used on creation.
+func vmRestoreDiffSuppress(_, _, _ string, d *schema.ResourceData) bool {
+	return d.Id() != ""
+}
+
+// vmSourceBlock returns the clone or the restore block of a VM. The configuration of a cloned or restored VM
+// is inherited from its source, so only the attributes present in the state are read back.
+func vmSourceBlock(d *schema.ResourceData) []any {
+	if clone := d.Get(mkClone).([]any); len(clone) > 0 {
+		return clone
+	}
+
+	return d.Get(mkRestore).([]any)
+}
+
+// Check for an existing CloudInit IDE drive. If no such drive is found, return the specified `defaultValue`.
+func findExistingCloudInitDrive(vmConfig *vms.GetResponseData, vmID int, defaultValue string) (string, *vms.CustomStorageDevice) {
+	devs := vmConfig.StorageDevices.Filter(func(device *vms.CustomStorageDevice) bool {
+		return device.IsCloudInitDrive(vmID)
+	})
+
+	for iface, device := range devs {
+		return iface, device
+	}
+
+	return defaultValue, nil
+}
+
+// vmGetOnBoot returns the configured on_boot value, or true for a new VM without it.
+// The value configured on an existing VM is only preserved on read and import.
+func vmGetOnBoot(d *schema.ResourceData) *types.CustomBool {
+	return ptr.Or(structure.GetConfiguredBool(d, mkOnBoot), types.CustomBool(true)).Pointer()
+}
+
+// vmSetFlags adds the configured VM flags to the request body, leaving the others at their PVE defaults.
+// It reports whether any flag is configured.
+func vmSetFlags(d *schema.ResourceData, body *vms.CreateRequestBody) bool {
+	body.AllowReboot = structure.GetConfiguredBool(d, mkAllowReboot)
+	body.Freeze = structure.GetConfiguredBool(d, mkFreeze)
+	body.KVMEnabled = structure.GetConfiguredBool(d, mkKVM)
+	body.LocalTime = structure.GetConfiguredBool(d, mkLocalTime)
+
+	return body.AllowReboot != nil || body.Freeze != nil || body.KVMEnabled != nil || body.LocalTime != nil
+}
+
+// Check for existing CD-ROM drives and return their interfaces in order. A cloud-init drive is not a CD-ROM drive.
+func findExistingCDROMInterfaces(vmConfig *vms.GetResponseData, vmID int) []string {
+	devs := vmConfig.StorageDevices.Filter(func(device *vms.CustomStorageDevice) bool {
+		return device.Media != nil && *device.Media == "cdrom" && !device.IsCloudInitDrive(vmID)
+	})
+
+	return slices.Sorted(maps.Keys(devs))
+}
+
+// vmCDROMInterface returns the interface of a cdrom block, falling back to the default for backward compatibility.
+func vmCDROMInterface(cdromBlock map[string]any) string {
+	if iface, ok := cdromBlock[mkCDROMInterface].(string); ok && iface != "" {
+		return iface
+	}
+
+	return dvCDROMInterface
+}
+
+// vmCDROMInterfaces returns the interfaces of the cdrom blocks.
+func vmCDROMInterfaces(cdrom []any) []string {
+	interfaces := make([]string, 0, len(cdrom))
+
+	for _, block := range cdrom {
+		if cdromBlock, ok := block.(map[string]any); ok {
+			interfaces = append(interfaces, vmCDROMInterface(cdromBlock))
+		}
+	}
+
+	return interfaces
+}
+
+// vmGetCDROMDeviceObjects returns the CD-ROM drives of the cdrom blocks, keyed by interface.
+func vmGetCDROMDeviceObjects(cdrom []any) vms.CustomStorageDevices {
+	devices := vms.CustomStorageDevices{}
+
+	for _, block := range cdrom {
+		cdromBlock, ok := block.(map[string]any)
+		if !ok {
+			continue
+		}
+
+		cdromFileID, _ := cdromBlock[mkCDROMFileID].(string)
+		if cdromFileID == "" {
+			cdromFileID = "cdrom"
+		}
+
+		cdromMedia := "cdrom"
+
+		devices[vmCDROMInterface(cdromBlock)] = &vms.CustomStorageDevice{
+			FileVolume: cdromFileID,
+			Media:      &cdromMedia,
+		}
+	}
+
+	return devices
+}
+
+// vmCloudInitConfigDeletes returns the cloud-init settings present in the VM configuration.
+func vmCloudInitConfigDeletes(vmConfig *vms.GetResponseData) []string {
+	var keys []string
+
+	for key, present := range map[string]bool{
+		"cicustom":     vmConfig.CloudInitFiles != nil,
+		"cipassword":   vmConfig.CloudInitPassword != nil,
+		"citype":       vmConfig.CloudInitType != nil,
+		"ciupgrade":    vmConfig.CloudInitUpgrade != nil,
+		"ciuser":       vmConfig.CloudInitUsername != nil,
+		"nameserver":   vmConfig.CloudInitDNSServer != nil,
+		"searchdomain": vmConfig.CloudInitDNSDomain != nil,
+		"sshkeys":      vmConfig.CloudInitSSHKeys != nil,
+	} {
+		if present {
+			keys = append(keys, key)
+		}
+	}
+
+	for key := range vmConfig.IPConfigs {
+		keys = append(keys, key)
+	}
+
+	slices.Sort(keys)
+
+	return keys
+}
+
+// Return a pointer to the storage device configuration based on a name. The device name is assumed to be a
+// valid ide, sata, or scsi interface name.
+func getStorageDevice(vmConfig *vms.GetResponseData, deviceName string) *vms.CustomStorageDevice {
+	if dev, ok := vmConfig.StorageDevices[deviceName]; ok {
+		return dev
+	}
+
+	return nil
+}
+
+// Delete IDE interfaces that can then be used for CloudInit. The first interface will always
+// be deleted. The second will be deleted only if it isn't empty and isn't the same as the
+// first.
+func deleteIdeDrives(ctx context.Context, vmAPI *vms.Client, itf1 string, itf2 string) diag.Diagnostics {
+	ddUpdateBody := &vms.UpdateRequestBody{}
+	ddUpdateBody.Delete = append(ddUpdateBody.Delete, itf1)
+	tflog.Debug(ctx, fmt.Sprintf("Deleting IDE interface '%s'", itf1))
+
+	if itf2 != "" && itf2 != itf1 {
+		ddUpdateBody.Delete = append(ddUpdateBody.Delete, itf2)
+		tflog.Debug(ctx, fmt.Sprintf("Deleting IDE interface '%s'", itf2))
+	}
+
+	e := vmAPI.UpdateVM(ctx, ddUpdateBody)
+	if e != nil {
+		return diag.FromErr(e)
+	}
+
+	return nil
+}
+
+// Start the VM, then wait for it to actually start; it may not be started immediately if running in HA mode.
+func vmStart(ctx context.Context, vmAPI *vms.Client, d *schema.ResourceData) diag.Diagnostics {
+	tflog.Debug(ctx, "Starting VM")
+
+	startTimeoutSec := d.Get(mkTimeoutStartVM).(int)
+
+	ctx, cancel := context.WithTimeout(ctx, time.Duration(startTimeoutSec)*time.Second)
+	defer cancel()
+
+	diags := sdkresource.TaskResultDiags(vmAPI.StartVM(ctx, startTimeoutSec), "VM start")
+	if diags.HasError() {
+		return diags
+	}
+
+	return append(diags, diag.FromErr(vmAPI.WaitForVMStatus(ctx, "running"))...)
+}
+
+// Shutdown the VM, then wait for it to actually shut down (it may not be shut down immediately if
+// running in HA mode). The VM is forcefully stopped when it doesn't shut down within the timeout.
+func vmShutdown(ctx context.Context, vmAPI *vms.Client, d *schema.ResourceData, shutdownTimeoutSec int) diag.Diagnostics {
+	tflog.Debug(ctx, "Shutting down VM")
+
+	shutdownTimeout := time.Duration(shutdownTimeoutSec) * time.Second
+	stopTimeout := time.Duration(d.Get(mkTimeoutStopVM).(int)) * time.Second
+
+	return sdkresource.TaskResultDiags(vmAPI.ShutdownOrStopVM(ctx, shutdownTimeout, stopTimeout), "VM shutdown")
+}
+
+// vmGetShutdownTimeout returns the shutdown timeout of the VM in seconds. The provider's `vm_shutdown_timeout`
+// is used when `timeout_shutdown_vm` is left at its default.
+func vmGetShutdownTimeout(d *schema.ResourceData, m any) int {
+	timeout := d.Get(mkTimeoutShutdownVM).(int)
+
+	config, ok := m.(proxmoxtf.ProviderConfiguration)
+	if ok && config.VMShutdownTimeout() > 0 && timeout == dvTimeoutShutdownVM {
+		return config.VMShutdownTimeout()
+	}
+
+	return timeout
+}
+
+// Forcefully stop the VM, then wait for it to actually stop.
+func vmStop(ctx context.Context, vmAPI *vms.Client, d *schema.ResourceData) diag.Diagnostics {
+	tflog.Debug(ctx, "Stopping VM")
+
+	stopTimeout := d.Get(mkTimeoutStopVM).(int)
+
+	ctx, cancel := context.WithTimeout(ctx, time.Duration(stopTimeout)*time.Second)
+	defer cancel()
+
+	diags := sdkresource.TaskResultDiags(vmAPI.StopVM(ctx), "VM stop")
+	if diags.HasError() {
+		return diags
+	}
+
+	return append(diags, diag.FromErr(vmAPI.WaitForVMStatus(ctx, "stopped"))...)
+}
+
+type vmPowerTracker struct {
+	// shutdown timeout in seconds, see vmGetShutdownTimeout; `timeout_shutdown_vm` is used when 0
+	shutdownTimeout int
+	// VM was stopped during the update by the provider
+	stoppedByProvider bool
+	// reboot_after_update warning was already emitted for this operation
+	rebootAfterUpdateWarningEmitted bool
+}
+
+// EnsureStopped ensures the VM is stopped, shutting it down if it's running.
+func (t *vmPowerTracker) EnsureStopped(ctx context.Context, vmAPI *vms.Client, d *schema.ResourceData) diag.Diagnostics {
+	vmStatus, err := vmAPI.GetVMStatus(ctx)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	if vmStatus == nil || vmStatus.Status == "stopped" {
+		return nil
+	}
+
+	agentEnabled, diags := isAgentEnabled(ctx, vmAPI)
+	if diags.HasError() {
+		return diags
+	}
+
+	if agentEnabled {
+		shutdownTimeout := t.shutdownTimeout
+		if shutdownTimeout == 0 {
+			shutdownTimeout = d.Get(mkTimeoutShutdownVM).(int)
+		}
+
+		diags = append(diags, vmShutdown(ctx, vmAPI, d, shutdownTimeout)...)
+		if diags.HasError() {
 			return diags
 		}
 	} else {
@@ -2124,7 +3124,8 @@ func rebootAfterUpdateDisabledError(operation string) diag.Diagnostics {
 }
 
 // Restarts a VM that is currently running. If already stopped, this is a no-op.
-// Prefer API reboot when agent is available; otherwise do stop+start.
+// Prefer API reboot when agent is available, falling back to a forced stop and start when the
+// reboot times out; otherwise do stop+start.
 func vmRestartRunning(
 	ctx context.Context,
 	vmAPI *vms.Client,
@@ -2157,12 +3158,44 @@ func vmRestartRunning(
 			return diag.FromErr(err)
 		}
 
-		rebootDiags := sdkresource.TaskResultDiags(vmAPI.RebootVMAndWaitForRunning(ctx, rebootTimeoutSec), "VM reboot")
-		if rebootDiags.HasError() {
+		rebootResult := vmAPI.RebootVMAndWaitForRunning(ctx, rebootTimeoutSec)
+		rebootDiags := sdkresource.TaskResultDiags(rebootResult, "VM reboot")
+
+		rebootErr := rebootResult.Err()
+		if rebootErr == nil || ctx.Err() != nil ||
+			(!errors.Is(rebootErr, context.DeadlineExceeded) && !strings.Contains(rebootErr.Error(), "timeout")) {
 			return rebootDiags
 		}
 
-		return rebootDiags
+		// the guest did not reboot within the timeout, fall back to a forced stop and start so that
+		// the pending configuration is applied
+		tflog.Warn(ctx, "Graceful VM reboot timed out, stopping and starting the VM", map[string]any{
+			"error": rebootErr.Error(),
+		})
+
+		if vmStatus, err = vmAPI.GetVMStatus(ctx); err != nil {
+			return append(rebootDiags, diag.FromErr(err)...)
+		}
+
+		var restartDiags diag.Diagnostics
+
+		if vmStatus != nil && vmStatus.Status != "stopped" {
+			restartDiags = vmStop(ctx, vmAPI, d)
+			if restartDiags.HasError() {
+				return append(rebootDiags, restartDiags...)
+			}
+		}
+
+		restartDiags = append(restartDiags, vmStart(ctx, vmAPI, d)...)
+		if restartDiags.HasError() {
+			return append(rebootDiags, restartDiags...)
+		}
+
+		return append(restartDiags, diag.Diagnostic{
+			Severity: diag.Warning,
+			Summary:  "the VM did not reboot gracefully within 'timeout_reboot' and was stopped and started",
+			Detail:   rebootErr.Error(),
+		})
 	}
 
 	if power == nil {
@@ -2338,10 +3371,10 @@ func vmCreateClone(ctx context.Context, d *schema.ResourceData, m any) diag.Diag
 	cloneNodeName := cloneBlock[mkCloneNodeName].(string)
 	cloneVMID := cloneBlock[mkCloneVMID].(int)
 	cloneFull := cloneBlock[mkCloneFull].(bool)
+	cloneBandwidthLimit := vmBandwidthLimit(cloneBlock[mkCloneBandwidthLimit].(int))
 
 	description := d.Get(mkDescription).(string)
 	name := d.Get(mkName).(string)
-	tags := d.Get(mkTags).([]any)
 	nodeName := d.Get(mkNodeName).(string)
 	poolID := d.Get(mkPoolID).(string)
 	vmIDUntyped, hasVMID := d.GetOk(mkVMID)
@@ -2364,8 +3397,9 @@ func vmCreateClone(ctx context.Context, d *schema.ResourceData, m any) diag.Diag
 	fullCopy := types.CustomBool(cloneFull)
 
 	cloneBody := &vms.CloneRequestBody{
-		FullCopy: &fullCopy,
-		VMIDNew:  vmID,
+		BandwidthLimit: cloneBandwidthLimit,
+		FullCopy:       &fullCopy,
+		VMIDNew:        vmID,
 	}
 
 	if cloneDatastoreID != "" {
@@ -2386,6 +3420,25 @@ func vmCreateClone(ctx context.Context, d *schema.ResourceData, m any) diag.Diag
 
 	var cloneDiags diag.Diagnostics
 
+	// cloneVM clones the source VM on the given node, with a new generated ID if the previous one has been taken.
+	cloneVM := func(sourceNodeName string) diag.Diagnostics {
+		var cloneResult tasks.TaskResult
+
+		vmID, cloneResult = sdkresource.CreateWithVMID(ctx, config.GetIDGenerator(), vmID, !hasVMID,
+			func(ctx context.Context, id int) tasks.TaskResult {
+				cloneBody.VMIDNew = id
+
+				return client.Node(sourceNodeName).VM(cloneVMID).CloneVM(ctx, cloneRetries, cloneBody)
+			},
+		)
+
+		if e := d.Set(mkVMID, vmID); e != nil {
+			return diag.FromErr(e)
+		}
+
+		return sdkresource.TaskResultDiags(cloneResult, "VM clone")
+	}
+
 	if cloneNodeName != "" && cloneNodeName != nodeName {
 		// Check if any used datastores of the source VM are not shared
 		vmConfig, err := client.Node(cloneNodeName).VM(cloneVMID).GetVM(ctx)
@@ -2415,9 +3468,7 @@ func vmCreateClone(ctx context.Context, d *schema.ResourceData, m any) diag.Diag
 			//  on a different node is currently not supported by proxmox.
 			cloneBody.TargetNodeName = &nodeName
 
-			cloneResult := client.Node(cloneNodeName).VM(cloneVMID).CloneVM(ctx, cloneRetries, cloneBody)
-
-			cloneDiags = sdkresource.TaskResultDiags(cloneResult, "VM clone")
+			cloneDiags = cloneVM(cloneNodeName)
 			if cloneDiags.HasError() {
 				return cloneDiags
 			}
@@ -2428,9 +3479,7 @@ func vmCreateClone(ctx context.Context, d *schema.ResourceData, m any) diag.Diag
 			//  https://forum.proxmox.com/threads/500-cant-clone-to-non-shared-storage-local.49078/#post-229727
 
 			// Temporarily clone to local node
-			cloneResult := client.Node(cloneNodeName).VM(cloneVMID).CloneVM(ctx, cloneRetries, cloneBody)
-
-			cloneDiags = sdkresource.TaskResultDiags(cloneResult, "VM clone")
+			cloneDiags = cloneVM(cloneNodeName)
 			if cloneDiags.HasError() {
 				return cloneDiags
 			}
@@ -2445,6 +3494,7 @@ func vmCreateClone(ctx context.Context, d *schema.ResourceData, m any) diag.Diag
 			// Migrate to target node
 			withLocalDisks := types.CustomBool(true)
 			migrateBody := &vms.MigrateRequestBody{
+				BandwidthLimit: cloneBandwidthLimit,
 				TargetNode:     nodeName,
 				WithLocalDisks: &withLocalDisks,
 			}
@@ -2461,9 +3511,7 @@ func vmCreateClone(ctx context.Context, d *schema.ResourceData, m any) diag.Diag
 			}
 		}
 	} else {
-		cloneResult := client.Node(nodeName).VM(cloneVMID).CloneVM(ctx, cloneRetries, cloneBody)
-
-		cloneDiags = sdkresource.TaskResultDiags(cloneResult, "VM clone")
+		cloneDiags = cloneVM(nodeName)
 		if cloneDiags.HasError() {
 			return cloneDiags
 		}
@@ -2479,7 +3527,26 @@ func vmCreateClone(ctx context.Context, d *schema.ResourceData, m any) diag.Diag
 		return diag.FromErr(e)
 	}
 
-	// Now that the virtual machine has been cloned, we need to perform some modifications.
+	return append(cloneDiags, vmCreateCustomize(ctx, d, m, client, vmAPI, cloneBandwidthLimit)...)
+}
+
+// vmCreateCustomize applies the configuration on top of a VM that has been created from an existing one, either
+// by cloning it or by restoring it from a backup, and then starts it if requested.
+func vmCreateCustomize(
+	ctx context.Context,
+	d *schema.ResourceData,
+	m any,
+	client proxmox.Client,
+	vmAPI *vms.Client,
+	bandwidthLimit *int,
+) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	nodeName := d.Get(mkNodeName).(string)
+	tags := d.Get(mkTags).([]any)
+	vmID := vmAPI.VMID
+
+	// Now that the virtual machine has been created, we need to perform some modifications.
 	audioDevices := vmGetAudioDeviceList(d)
 
 	acpi := types.CustomBool(d.Get(mkACPI).(bool))
@@ -2496,7 +3563,6 @@ func vmCreateClone(ctx context.Context, d *schema.ResourceData, m any) diag.Diag
 	machine := d.Get(mkMachine).(string)
 	memory := d.Get(mkMemory).([]any)
 	numa := d.Get(mkNUMA).([]any)
-	onBoot := types.CustomBool(d.Get(mkOnBoot).(bool))
 	operatingSystem := d.Get(mkOperatingSystem).([]any)
 	protection := types.CustomBool(d.Get(mkProtection).(bool))
 	scsiHardware := d.Get(mkSCSIHardware).(string)
@@ -2565,6 +3631,8 @@ func vmCreateClone(ctx context.Context, d *schema.ResourceData, m any) diag.Diag
 		updateBody.KVMArguments = &kvmArguments
 	}
 
+	updateBody.SpiceEnhancements = vmGetSpiceEnhancements(d)
+
 	if bios != dvBIOS {
 		updateBody.BIOS = &bios
 	}
@@ -2577,23 +3645,7 @@ func vmCreateClone(ctx context.Context, d *schema.ResourceData, m any) diag.Diag
 		updateBody.SCSIHardware = &scsiHardware
 	}
 
-	if len(cdrom) > 0 && cdrom[0] != nil {
-		cdromBlock := cdrom[0].(map[string]any)
-
-		cdromFileID := cdromBlock[mkCDROMFileID].(string)
-		cdromInterface := cdromBlock[mkCDROMInterface].(string)
-
-		if cdromFileID == "" {
-			cdromFileID = "cdrom"
-		}
-
-		cdromMedia := "cdrom"
-
-		ideDevices[cdromInterface] = &vms.CustomStorageDevice{
-			FileVolume: cdromFileID,
-			Media:      &cdromMedia,
-		}
-	}
+	maps.Copy(ideDevices, vmGetCDROMDeviceObjects(cdrom))
 
 	if len(cpu) > 0 && cpu[0] != nil {
 		cpuBlock := cpu[0].(map[string]any)
@@ -2693,6 +3745,10 @@ func vmCreateClone(ctx context.Context, d *schema.ResourceData, m any) diag.Diag
 
 	if len(hostUSB) > 0 {
 		updateBody.USBDevices = vmGetHostUSBDeviceObjects(d)
+
+		if err := vmCheckHostUSBMappings(ctx, client, nodeName, updateBody.USBDevices); err != nil {
+			return diag.FromErr(err)
+		}
 	}
 
 	if len(cdrom) > 0 || len(initialization) > 0 {
@@ -2716,12 +3772,17 @@ func vmCreateClone(ctx context.Context, d *schema.ResourceData, m any) diag.Diag
 		memoryDedicated := memoryBlock[mkMemoryDedicated].(int)
 		memoryFloating := memoryBlock[mkMemoryFloating].(int)
 		memoryShared := memoryBlock[mkMemoryShared].(int)
+		memoryShares := memoryBlock[mkMemoryShares].(int)
 		hugepages := memoryBlock[mkMemoryHugepages].(string)
 		keepHugepages := types.CustomBool(memoryBlock[mkMemoryKeepHugepages].(bool))
 
 		updateBody.DedicatedMemory = &memoryDedicated
 		updateBody.FloatingMemory = &memoryFloating
 
+		if memoryShares != dvMemoryShares {
+			updateBody.FloatingMemoryShares = &memoryShares
+		}
+
 		if memoryShared > 0 {
 			memorySharedName := fmt.Sprintf("vm-%d-ivshmem", vmID)
 
@@ -2773,7 +3834,7 @@ func vmCreateClone(ctx context.Context, d *schema.ResourceData, m any) diag.Diag
 		}
 	}
 
-	updateBody.StartOnBoot = &onBoot
+	updateBody.StartOnBoot = vmGetOnBoot(d)
 
 	// Apply an explicit boot order to the clone; when empty, preserve the source VM's order.
 	if bootOrder := d.Get(mkBootOrder).([]any); len(bootOrder) > 0 {
@@ -2795,6 +3856,8 @@ func vmCreateClone(ctx context.Context, d *schema.ResourceData, m any) diag.Diag
 		updateBody.TabletDeviceEnabled = &tabletDevice
 	}
 
+	vmSetFlags(d, updateBody)
+
 	if protection {
 		updateBody.DeletionProtection = &protection
 	}
@@ -2842,7 +3905,7 @@ func vmCreateClone(ctx context.Context, d *schema.ResourceData, m any) diag.Diag
 
 	updateBody.Delete = del
 
-	e = vmAPI.UpdateVM(ctx, updateBody)
+	e := vmAPI.UpdateVM(ctx, updateBody)
 	if e != nil {
 		return diag.FromErr(e)
 	}
@@ -2864,9 +3927,9 @@ func vmCreateClone(ctx context.Context, d *schema.ResourceData, m any) diag.Diag
 		return diag.FromErr(e)
 	}
 
-	cloneDiags = append(cloneDiags, disk.UpdateClone(ctx, planDisks, clonedDiskInfo, vmAPI)...)
-	if cloneDiags.HasError() {
-		return cloneDiags
+	diags = append(diags, disk.UpdateClone(ctx, planDisks, clonedDiskInfo, vmAPI, bandwidthLimit)...)
+	if diags.HasError() {
+		return diags
 	}
 
 	efiDisk := d.Get(mkEFIDisk).([]any)
@@ -2907,6 +3970,7 @@ func vmCreateClone(ctx context.Context, d *schema.ResourceData, m any) diag.Diag
 		deleteOriginalDisk := types.CustomBool(true)
 
 		diskMoveBody := &vms.MoveDiskRequestBody{
+			BandwidthLimit:     bandwidthLimit,
 			DeleteOriginalDisk: &deleteOriginalDisk,
 			Disk:               diskInterface,
 			TargetStorage:      dataStoreID,
@@ -2924,9 +3988,9 @@ func vmCreateClone(ctx context.Context, d *schema.ResourceData, m any) diag.Diag
 		}
 
 		if moveDisk {
-			cloneDiags = append(cloneDiags, sdkresource.TaskResultDiags(vmAPI.MoveVMDisk(ctx, diskMoveBody), "VM disk move")...)
-			if cloneDiags.HasError() {
-				return cloneDiags
+			diags = append(diags, sdkresource.TaskResultDiags(vmAPI.MoveVMDisk(ctx, diskMoveBody), "VM disk move")...)
+			if diags.HasError() {
+				return diags
 			}
 		}
 	}
@@ -2958,6 +4022,7 @@ func vmCreateClone(ctx context.Context, d *schema.ResourceData, m any) diag.Diag
 		deleteOriginalDisk := types.CustomBool(true)
 
 		diskMoveBody := &vms.MoveDiskRequestBody{
+			BandwidthLimit:     bandwidthLimit,
 			DeleteOriginalDisk: &deleteOriginalDisk,
 			Disk:               diskInterface,
 			TargetStorage:      dataStoreID,
@@ -2974,24 +4039,129 @@ func vmCreateClone(ctx context.Context, d *schema.ResourceData, m any) diag.Diag
 			}
 		}
 
-		if moveDisk {
-			cloneDiags = append(cloneDiags, sdkresource.TaskResultDiags(vmAPI.MoveVMDisk(ctx, diskMoveBody), "VM disk move")...)
-			if cloneDiags.HasError() {
-				return cloneDiags
-			}
-		}
+		if moveDisk {
+			diags = append(diags, sdkresource.TaskResultDiags(vmAPI.MoveVMDisk(ctx, diskMoveBody), "VM disk move")...)
+			if diags.HasError() {
+				return diags
+			}
+		}
+	}
+
+	if template {
+		tflog.Info(ctx, fmt.Sprintf("Converting VM %d to template", vmID))
+
+		diags = append(diags, sdkresource.TaskResultDiags(vmAPI.ConvertToTemplate(ctx), "VM convert to template")...)
+		if diags.HasError() {
+			return diags
+		}
+	}
+
+	return append(diags, vmCreateStart(ctx, d, m)...)
+}
+
+func vmCreateRestore(ctx context.Context, d *schema.ResourceData, m any) diag.Diagnostics {
+	createTimeoutSec := d.Get(mkTimeoutCreate).(int)
+
+	ctx, cancel := context.WithTimeout(ctx, time.Duration(createTimeoutSec)*time.Second)
+	defer cancel()
+
+	config := m.(proxmoxtf.ProviderConfiguration)
+
+	client, e := config.GetClient()
+	if e != nil {
+		return diag.FromErr(e)
+	}
+
+	restoreBlock := d.Get(mkRestore).([]any)[0].(map[string]any)
+	backupFile := restoreBlock[mkRestoreBackupFile].(string)
+	restoreDatastoreID := restoreBlock[mkRestoreDatastoreID].(string)
+	unique := types.CustomBool(restoreBlock[mkRestoreUnique].(bool))
+
+	description := d.Get(mkDescription).(string)
+	name := d.Get(mkName).(string)
+	nodeName := d.Get(mkNodeName).(string)
+	poolID := d.Get(mkPoolID).(string)
+	vmIDUntyped, hasVMID := d.GetOk(mkVMID)
+	vmID := vmIDUntyped.(int)
+
+	if !hasVMID {
+		vmIDNew, err := config.GetIDGenerator().NextID(ctx)
+		if err != nil {
+			return diag.FromErr(err)
+		}
+
+		vmID = vmIDNew
+
+		err = d.Set(mkVMID, vmID)
+		if err != nil {
+			return diag.FromErr(err)
+		}
+	}
+
+	createBody := &vms.CreateRequestBody{
+		BackupFile: &backupFile,
+		Unique:     &unique,
+		VMID:       vmID,
+	}
+
+	if restoreDatastoreID != "" {
+		createBody.RestoreStorage = &restoreDatastoreID
+	}
+
+	if poolID != "" {
+		createBody.PoolID = &poolID
+	}
+
+	vmID, restoreResult := sdkresource.CreateWithVMID(ctx, config.GetIDGenerator(), vmID, !hasVMID,
+		func(ctx context.Context, id int) tasks.TaskResult {
+			createBody.VMID = id
+
+			return client.Node(nodeName).VM(0).CreateVM(ctx, createBody)
+		},
+	)
+
+	if e := d.Set(mkVMID, vmID); e != nil {
+		return diag.FromErr(e)
+	}
+
+	restoreDiags := sdkresource.TaskResultDiags(restoreResult, "VM restore")
+	if restoreDiags.HasError() {
+		return restoreDiags
+	}
+
+	d.SetId(strconv.Itoa(vmID))
+
+	vmAPI := client.Node(nodeName).VM(vmID)
+
+	// Wait for the virtual machine to be restored and its configuration lock to be released.
+	e = vmAPI.WaitForVMConfigUnlock(ctx, true)
+	if e != nil {
+		return diag.FromErr(e)
+	}
+
+	// The name and description come from the backup unless they are set, the rest of the configuration is
+	// applied the same way as for a cloned VM.
+	updateBody := &vms.UpdateRequestBody{}
+	update := false
+
+	if description != "" {
+		updateBody.Description = &description
+		update = true
 	}
 
-	if template {
-		tflog.Info(ctx, fmt.Sprintf("Converting cloned VM %d to template", vmID))
+	if name != "" {
+		updateBody.Name = &name
+		update = true
+	}
 
-		cloneDiags = append(cloneDiags, sdkresource.TaskResultDiags(vmAPI.ConvertToTemplate(ctx), "VM convert to template")...)
-		if cloneDiags.HasError() {
-			return cloneDiags
+	if update {
+		e = vmAPI.UpdateVM(ctx, updateBody)
+		if e != nil {
+			return diag.FromErr(e)
 		}
 	}
 
-	return append(cloneDiags, vmCreateStart(ctx, d, m)...)
+	return append(restoreDiags, vmCreateCustomize(ctx, d, m, client, vmAPI, nil)...)
 }
 
 func setCPUArchitecture(
@@ -3055,19 +4225,8 @@ func vmCreateCustom(ctx context.Context, d *schema.ResourceData, m any) diag.Dia
 
 	bios := d.Get(mkBIOS).(string)
 
-	cdromFileID := ""
-	cdromInterface := ""
-
 	cdrom := d.Get(mkCDROM).([]any)
-	if len(cdrom) > 0 {
-		cdromBlock := cdrom[0].(map[string]any)
-		cdromFileID = cdromBlock[mkCDROMFileID].(string)
-		cdromInterface = cdromBlock[mkCDROMInterface].(string)
-
-		if cdromFileID == "" {
-			cdromFileID = "cdrom"
-		}
-	}
+	cdromInterfaces := vmCDROMInterfaces(cdrom)
 
 	initializationFileVolume := ""
 	initializationInterface := ""
@@ -3127,6 +4286,8 @@ func vmCreateCustom(ctx context.Context, d *schema.ResourceData, m any) diag.Dia
 
 	rng := vmGetRNGDevice(d)
 
+	spiceEnhancements := vmGetSpiceEnhancements(d)
+
 	initializationConfig := vmGetCloudInitConfig(d)
 	initializationAttr := d.Get(mkInitialization)
 
@@ -3151,6 +4312,11 @@ func vmCreateCustom(ctx context.Context, d *schema.ResourceData, m any) diag.Dia
 
 	usbDeviceObjects := vmGetHostUSBDeviceObjects(d)
 
+	err = vmCheckHostUSBMappings(ctx, client, d.Get(mkNodeName).(string), usbDeviceObjects)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
 	keyboardLayout := d.Get(mkKeyboardLayout).(string)
 
 	memoryBlock, err := structure.GetSchemaBlock(
@@ -3167,6 +4333,7 @@ func vmCreateCustom(ctx context.Context, d *schema.ResourceData, m any) diag.Dia
 	memoryDedicated := memoryBlock[mkMemoryDedicated].(int)
 	memoryFloating := memoryBlock[mkMemoryFloating].(int)
 	memoryShared := memoryBlock[mkMemoryShared].(int)
+	memoryShares := memoryBlock[mkMemoryShares].(int)
 	memoryHugepages := memoryBlock[mkMemoryHugepages].(string)
 	memoryKeepHugepages := types.CustomBool(memoryBlock[mkMemoryKeepHugepages].(bool))
 
@@ -3203,7 +4370,6 @@ func vmCreateCustom(ctx context.Context, d *schema.ResourceData, m any) diag.Dia
 
 	startupOrder := vmGetStartupOrder(d)
 
-	onBoot := types.CustomBool(d.Get(mkOnBoot).(bool))
 	tabletDevice := types.CustomBool(d.Get(mkTabletDevice).(bool))
 	template := types.CustomBool(false)
 
@@ -3236,8 +4402,8 @@ func vmCreateCustom(ctx context.Context, d *schema.ResourceData, m any) diag.Dia
 	bootOrder := d.Get(mkBootOrder).([]any)
 
 	if len(bootOrder) == 0 {
-		if cdromInterface != "" {
-			bootOrderConverted = []string{cdromInterface}
+		if len(cdromInterfaces) > 0 {
+			bootOrderConverted = []string{cdromInterfaces[0]}
 		}
 
 		if _, ok := diskDeviceObjects["ide0"]; ok {
@@ -3271,8 +4437,6 @@ func vmCreateCustom(ctx context.Context, d *schema.ResourceData, m any) diag.Dia
 		cpuFlagsConverted[fi] = flag.(string)
 	}
 
-	cdromMedia := "cdrom"
-
 	if initializationInterface != "" {
 		device := &vms.CustomStorageDevice{
 			FileVolume: initializationFileVolume,
@@ -3285,12 +4449,7 @@ func vmCreateCustom(ctx context.Context, d *schema.ResourceData, m any) diag.Dia
 		diskDeviceObjects[initializationInterface] = device
 	}
 
-	if cdromInterface != "" {
-		diskDeviceObjects[cdromInterface] = &vms.CustomStorageDevice{
-			FileVolume: cdromFileID,
-			Media:      &cdromMedia,
-		}
-	}
+	maps.Copy(diskDeviceObjects, vmGetCDROMDeviceObjects(cdrom))
 
 	var memorySharedObject *vms.CustomSharedMemory
 
@@ -3365,8 +4524,9 @@ func vmCreateCustom(ctx context.Context, d *schema.ResourceData, m any) diag.Dia
 		SCSIHardware:         &scsiHardware,
 		SerialDevices:        serialDevices,
 		SharedMemory:         memorySharedObject,
-		StartOnBoot:          &onBoot,
+		StartOnBoot:          vmGetOnBoot(d),
 		SMBIOS:               smbios,
+		SpiceEnhancements:    spiceEnhancements,
 		StartupOrder:         startupOrder,
 		TabletDeviceEnabled:  &tabletDevice,
 		Template:             &template,
@@ -3378,6 +4538,8 @@ func vmCreateCustom(ctx context.Context, d *schema.ResourceData, m any) diag.Dia
 		CustomStorageDevices: diskDeviceObjects,
 	}
 
+	vmSetFlags(d, createBody)
+
 	if err = setCPUArchitecture(ctx, cpuArchitecture, client, createBody); err != nil {
 		return diag.FromErr(err)
 	}
@@ -3395,6 +4557,10 @@ func vmCreateCustom(ctx context.Context, d *schema.ResourceData, m any) diag.Dia
 		createBody.CPULimit = &cpuLimit
 	}
 
+	if memoryShares != dvMemoryShares {
+		createBody.FloatingMemoryShares = &memoryShares
+	}
+
 	if cpuUnits > 0 {
 		createBody.CPUUnits = new(int64(cpuUnits))
 	}
@@ -3441,7 +4607,19 @@ func vmCreateCustom(ctx context.Context, d *schema.ResourceData, m any) diag.Dia
 		createBody.HookScript = &hookScript
 	}
 
-	customDiags := sdkresource.TaskResultDiags(client.Node(nodeName).VM(0).CreateVM(ctx, createBody), "VM create")
+	vmID, createResult := sdkresource.CreateWithVMID(ctx, config.GetIDGenerator(), vmID, !hasVMID,
+		func(ctx context.Context, id int) tasks.TaskResult {
+			createBody.VMID = id
+
+			return client.Node(nodeName).VM(0).CreateVM(ctx, createBody)
+		},
+	)
+
+	if e := d.Set(mkVMID, vmID); e != nil {
+		return diag.FromErr(e)
+	}
+
+	customDiags := sdkresource.TaskResultDiags(createResult, "VM create")
 	if customDiags.HasError() {
 		return customDiags
 	}
@@ -3510,7 +4688,7 @@ func vmCreateStart(ctx context.Context, d *schema.ResourceData, m any) diag.Diag
 
 	vmAPI := client.Node(nodeName).VM(vmID)
 
-	power := &vmPowerTracker{}
+	power := &vmPowerTracker{shutdownTimeout: vmGetShutdownTimeout(d, m)}
 	var createDiags diag.Diagnostics
 
 	// Start the virtual machine and wait for it to reach a running state before continuing.
@@ -3564,6 +4742,23 @@ func vmGetAMDSEVObject(d *schema.ResourceData) *vms.CustomAMDSEV {
 	return amdsev
 }
 
+func vmGetSpiceEnhancements(d *schema.ResourceData) *vms.CustomSpiceEnhancements {
+	spiceBlock := d.Get(mkSpiceEnhancements).([]any)
+	if len(spiceBlock) == 0 || spiceBlock[0] == nil {
+		return nil
+	}
+
+	block := spiceBlock[0].(map[string]any)
+
+	folderSharing := types.CustomBool(block[mkSpiceEnhancementsFolderSharing].(bool))
+	videoStreaming := block[mkSpiceEnhancementsVideoStreaming].(string)
+
+	return &vms.CustomSpiceEnhancements{
+		FolderSharing:  &folderSharing,
+		VideoStreaming: &videoStreaming,
+	}
+}
+
 func vmGetAudioDeviceList(d *schema.ResourceData) vms.CustomAudioDevices {
 	devices := d.Get(mkAudioDevice).([]any)
 	list := make(vms.CustomAudioDevices, len(devices))
@@ -4049,6 +5244,24 @@ func vmGetSerialDeviceList(d *schema.ResourceData) vms.CustomSerialDevices {
 	return list
 }
 
+// vmDecodeSMBIOSValue returns an SMBIOS value, which PVE stores base64 encoded when the base64 flag is set.
+func vmDecodeSMBIOSValue(value *string, isBase64 bool, defaultValue string) (string, error) {
+	if value == nil {
+		return defaultValue, nil
+	}
+
+	if !isBase64 {
+		return *value, nil
+	}
+
+	b, err := base64.StdEncoding.DecodeString(*value)
+	if err != nil {
+		return defaultValue, fmt.Errorf("error decoding SMBIOS value %q: %w", *value, err)
+	}
+
+	return string(b), nil
+}
+
 func vmGetSMBIOS(d *schema.ResourceData) *vms.CustomSMBIOS {
 	smbiosSections := d.Get(mkSMBIOS).([]any)
 
@@ -4059,7 +5272,7 @@ func vmGetSMBIOS(d *schema.ResourceData) *vms.CustomSMBIOS {
 		manufacturer, _ := smbiosBlock[mkSMBIOSManufacturer].(string)
 		product, _ := smbiosBlock[mkSMBIOSProduct].(string)
 		serial, _ := smbiosBlock[mkSMBIOSSerial].(string)
-		sku, _ := smbiosBlock[mkSMBIOS].(string)
+		sku, _ := smbiosBlock[mkSMBIOSSKU].(string)
 		version, _ := smbiosBlock[mkSMBIOSVersion].(string)
 		uid, _ := smbiosBlock[mkSMBIOSUUID].(string)
 
@@ -4266,6 +5479,26 @@ func hotplugContains(hotplug, feature string) bool {
 	return false
 }
 
+// vmNetworkDevicesPlugged reports whether the planned network devices add or remove
+// interfaces compared to the ones currently configured on the VM.
+func vmNetworkDevicesPlugged(existing map[string]struct{}, planned vms.CustomNetworkDevices) bool {
+	enabled := 0
+
+	for i, nd := range planned {
+		if !nd.Enabled {
+			continue
+		}
+
+		enabled++
+
+		if _, ok := existing[fmt.Sprintf("net%d", i)]; !ok {
+			return true
+		}
+	}
+
+	return enabled != len(existing)
+}
+
 // isHotpluggable reads the hotplug setting from resource data and checks whether a feature is enabled.
 func isHotpluggable(d *schema.ResourceData, feature string) bool {
 	hotplug, ok := d.GetOk(mkHotplug)
@@ -4397,7 +5630,7 @@ func vmReadCustom(
 	}
 
 	nodeName := d.Get(mkNodeName).(string)
-	clone := d.Get(mkClone).([]any)
+	clone := vmSourceBlock(d)
 
 	// Compare the agent configuration to the one stored in the state.
 	currentAgent := d.Get(mkAgent).([]any)
@@ -4550,7 +5783,7 @@ func vmReadCustom(
 			if ad.Driver != nil {
 				m[mkAudioDeviceDriver] = *ad.Driver
 			} else {
-				m[mkAudioDeviceDriver] = ""
+				m[mkAudioDeviceDriver] = dvAudioDeviceDriver
 			}
 
 			m[mkAudioDeviceEnabled] = true
@@ -4570,40 +5803,49 @@ func vmReadCustom(
 		diags = append(diags, diag.FromErr(err)...)
 	}
 
-	// Compare the IDE devices to the CD-ROM configurations stored in the state.
-	currentInterface := dvCDROMInterface
-
+	// Compare the storage devices to the CD-ROM configurations stored in the state.
 	currentCDROM := d.Get(mkCDROM).([]any)
-	if len(currentCDROM) > 0 && currentCDROM[0] != nil {
-		currentBlock := currentCDROM[0].(map[string]any)
-		currentInterface = currentBlock[mkCDROMInterface].(string)
-	}
+	currentInterfaces := vmCDROMInterfaces(currentCDROM)
 
-	cdromIDEDevice := getStorageDevice(vmConfig, currentInterface)
+	if len(currentInterfaces) == 0 {
+		if len(clone) == 0 {
+			// nothing in the state yet (e.g. on import), pick up the drives from whichever interfaces they are attached to
+			currentInterfaces = findExistingCDROMInterfaces(vmConfig, vmID)
+		} else {
+			currentInterfaces = []string{dvCDROMInterface}
+		}
+	}
 
-	if cdromIDEDevice != nil {
-		cdrom := make([]any, 1)
-		cdromBlock := map[string]any{}
+	currentBlocks := make(map[string]map[string]any, len(currentCDROM))
 
-		if len(clone) == 0 || len(currentCDROM) > 0 {
-			cdromBlock[mkCDROMFileID] = cdromIDEDevice.FileVolume
-			cdromBlock[mkCDROMInterface] = currentInterface
+	for _, block := range currentCDROM {
+		if currentBlock, ok := block.(map[string]any); ok {
+			currentBlocks[vmCDROMInterface(currentBlock)] = currentBlock
+		}
+	}
 
-			if len(currentCDROM) > 0 && currentCDROM[0] != nil {
-				currentBlock := currentCDROM[0].(map[string]any)
+	cdrom := make([]any, 0, len(currentInterfaces))
 
-				if currentBlock[mkCDROMFileID] == "" {
-					cdromBlock[mkCDROMFileID] = ""
-				}
-			}
+	for _, currentInterface := range currentInterfaces {
+		cdromDevice := getStorageDevice(vmConfig, currentInterface)
+		if cdromDevice == nil {
+			continue
+		}
 
-			cdrom[0] = cdromBlock
+		cdromBlock := map[string]any{
+			mkCDROMFileID:    cdromDevice.FileVolume,
+			mkCDROMInterface: currentInterface,
+		}
 
-			err := d.Set(mkCDROM, cdrom)
-			diags = append(diags, diag.FromErr(err)...)
+		if currentBlock, ok := currentBlocks[currentInterface]; ok && currentBlock[mkCDROMFileID] == "" {
+			cdromBlock[mkCDROMFileID] = ""
 		}
-	} else {
-		err := d.Set(mkCDROM, []any{})
+
+		cdrom = append(cdrom, cdromBlock)
+	}
+
+	if len(cdrom) == 0 || len(clone) == 0 || len(currentCDROM) > 0 {
+		err := d.Set(mkCDROM, cdrom)
 		diags = append(diags, diag.FromErr(err)...)
 	}
 
@@ -4859,6 +6101,39 @@ func vmReadCustom(
 		}
 	}
 
+	// Compare the SPICE enhancements to the ones stored in the state.
+	currentSpiceEnhancements := d.Get(mkSpiceEnhancements).([]any)
+
+	if vmConfig.SpiceEnhancements != nil {
+		spiceEnhancements := map[string]any{
+			mkSpiceEnhancementsFolderSharing:  dvSpiceEnhancementsFolderSharing,
+			mkSpiceEnhancementsVideoStreaming: dvSpiceEnhancementsVideoStreaming,
+		}
+
+		if vmConfig.SpiceEnhancements.FolderSharing != nil {
+			spiceEnhancements[mkSpiceEnhancementsFolderSharing] = bool(*vmConfig.SpiceEnhancements.FolderSharing)
+		}
+
+		if vmConfig.SpiceEnhancements.VideoStreaming != nil {
+			spiceEnhancements[mkSpiceEnhancementsVideoStreaming] = *vmConfig.SpiceEnhancements.VideoStreaming
+		}
+
+		if len(clone) > 0 {
+			if len(currentSpiceEnhancements) > 0 {
+				err := d.Set(mkSpiceEnhancements, []any{spiceEnhancements})
+				diags = append(diags, diag.FromErr(err)...)
+			}
+		} else if len(currentSpiceEnhancements) > 0 ||
+			spiceEnhancements[mkSpiceEnhancementsFolderSharing] != dvSpiceEnhancementsFolderSharing ||
+			spiceEnhancements[mkSpiceEnhancementsVideoStreaming] != dvSpiceEnhancementsVideoStreaming {
+			err := d.Set(mkSpiceEnhancements, []any{spiceEnhancements})
+			diags = append(diags, diag.FromErr(err)...)
+		}
+	} else if len(currentSpiceEnhancements) > 0 {
+		err := d.Set(mkSpiceEnhancements, []any{})
+		diags = append(diags, diag.FromErr(err)...)
+	}
+
 	currentPCIList := d.Get(mkHostPCI).([]any)
 	pciMap := map[string]any{}
 
@@ -5143,7 +6418,14 @@ func vmReadCustom(
 		}
 
 		if vmConfig.CloudInitPassword != nil {
-			initializationUserAccount[mkInitializationUserAccountPassword] = *vmConfig.CloudInitPassword
+			currentPassword, _ := d.Get(
+				mkInitialization + ".0." + mkInitializationUserAccount + ".0." + mkInitializationUserAccountPassword,
+			).(string)
+
+			initializationUserAccount[mkInitializationUserAccountPassword] = vmCloudInitPasswordForState(
+				currentPassword,
+				*vmConfig.CloudInitPassword,
+			)
 		} else {
 			initializationUserAccount[mkInitializationUserAccountPassword] = ""
 		}
@@ -5274,6 +6556,12 @@ func vmReadCustom(
 		memory[mkMemoryShared] = 0
 	}
 
+	if vmConfig.FloatingMemoryShares != nil {
+		memory[mkMemoryShares] = *vmConfig.FloatingMemoryShares
+	} else {
+		memory[mkMemoryShares] = dvMemoryShares
+	}
+
 	if vmConfig.Hugepages != nil {
 		memory[mkMemoryHugepages] = *vmConfig.Hugepages
 	} else {
@@ -5297,6 +6585,7 @@ func vmReadCustom(
 		memory[mkMemoryDedicated] != dvMemoryDedicated ||
 		memory[mkMemoryFloating] != dvMemoryFloating ||
 		memory[mkMemoryShared] != dvMemoryShared ||
+		memory[mkMemoryShares] != dvMemoryShares ||
 		memory[mkMemoryHugepages] != dvMemoryHugepages ||
 		memory[mkMemoryKeepHugepages] != dvMemoryKeepHugepages {
 		err := d.Set(mkMemory, []any{memory})
@@ -5384,54 +6673,27 @@ func vmReadCustom(
 
 	if vmConfig.SMBIOS != nil {
 		smbios = map[string]any{}
+		smbiosBase64 := bool(ptr.Or(vmConfig.SMBIOS.Base64, false))
 
-		if vmConfig.SMBIOS.Family != nil {
-			b, err := base64.StdEncoding.DecodeString(*vmConfig.SMBIOS.Family)
-			diags = append(diags, diag.FromErr(err)...)
-			smbios[mkSMBIOSFamily] = string(b)
-		} else {
-			smbios[mkSMBIOSFamily] = dvSMBIOSFamily
-		}
+		var err error
 
-		if vmConfig.SMBIOS.Manufacturer != nil {
-			b, err := base64.StdEncoding.DecodeString(*vmConfig.SMBIOS.Manufacturer)
-			diags = append(diags, diag.FromErr(err)...)
-			smbios[mkSMBIOSManufacturer] = string(b)
-		} else {
-			smbios[mkSMBIOSManufacturer] = dvSMBIOSManufacturer
-		}
+		smbios[mkSMBIOSFamily], err = vmDecodeSMBIOSValue(vmConfig.SMBIOS.Family, smbiosBase64, dvSMBIOSFamily)
+		diags = append(diags, diag.FromErr(err)...)
 
-		if vmConfig.SMBIOS.Product != nil {
-			b, err := base64.StdEncoding.DecodeString(*vmConfig.SMBIOS.Product)
-			diags = append(diags, diag.FromErr(err)...)
-			smbios[mkSMBIOSProduct] = string(b)
-		} else {
-			smbios[mkSMBIOSProduct] = dvSMBIOSProduct
-		}
+		smbios[mkSMBIOSManufacturer], err = vmDecodeSMBIOSValue(vmConfig.SMBIOS.Manufacturer, smbiosBase64, dvSMBIOSManufacturer)
+		diags = append(diags, diag.FromErr(err)...)
 
-		if vmConfig.SMBIOS.Serial != nil {
-			b, err := base64.StdEncoding.DecodeString(*vmConfig.SMBIOS.Serial)
-			diags = append(diags, diag.FromErr(err)...)
-			smbios[mkSMBIOSSerial] = string(b)
-		} else {
-			smbios[mkSMBIOSSerial] = dvSMBIOSSerial
-		}
+		smbios[mkSMBIOSProduct], err = vmDecodeSMBIOSValue(vmConfig.SMBIOS.Product, smbiosBase64, dvSMBIOSProduct)
+		diags = append(diags, diag.FromErr(err)...)
 
-		if vmConfig.SMBIOS.SKU != nil {
-			b, err := base64.StdEncoding.DecodeString(*vmConfig.SMBIOS.SKU)
-			diags = append(diags, diag.FromErr(err)...)
-			smbios[mkSMBIOSSKU] = string(b)
-		} else {
-			smbios[mkSMBIOSSKU] = dvSMBIOSSKU
-		}
+		smbios[mkSMBIOSSerial], err = vmDecodeSMBIOSValue(vmConfig.SMBIOS.Serial, smbiosBase64, dvSMBIOSSerial)
+		diags = append(diags, diag.FromErr(err)...)
 
-		if vmConfig.SMBIOS.Version != nil {
-			b, err := base64.StdEncoding.DecodeString(*vmConfig.SMBIOS.Version)
-			diags = append(diags, diag.FromErr(err)...)
-			smbios[mkSMBIOSVersion] = string(b)
-		} else {
-			smbios[mkSMBIOSVersion] = dvSMBIOSVersion
-		}
+		smbios[mkSMBIOSSKU], err = vmDecodeSMBIOSValue(vmConfig.SMBIOS.SKU, smbiosBase64, dvSMBIOSSKU)
+		diags = append(diags, diag.FromErr(err)...)
+
+		smbios[mkSMBIOSVersion], err = vmDecodeSMBIOSValue(vmConfig.SMBIOS.Version, smbiosBase64, dvSMBIOSVersion)
+		diags = append(diags, diag.FromErr(err)...)
 
 		if vmConfig.SMBIOS.UUID != nil {
 			smbios[mkSMBIOSUUID] = *vmConfig.SMBIOS.UUID
@@ -5654,7 +6916,7 @@ func vmReadPrimitiveValues(
 
 	var err error
 
-	clone := d.Get(mkClone).([]any)
+	clone := vmSourceBlock(d)
 	currentACPI := d.Get(mkACPI).(bool)
 
 	if len(clone) == 0 || !currentACPI {
@@ -5682,6 +6944,21 @@ func vmReadPrimitiveValues(
 		diags = append(diags, diag.FromErr(err)...)
 	}
 
+	// The flags are computed, unset flags report the PVE defaults.
+	err = d.Set(mkAllowReboot, bool(ptr.Or(vmConfig.AllowReboot, true)))
+	diags = append(diags, diag.FromErr(err)...)
+
+	err = d.Set(mkFreeze, bool(ptr.Or(vmConfig.Freeze, false)))
+	diags = append(diags, diag.FromErr(err)...)
+
+	err = d.Set(mkKVM, bool(ptr.Or(vmConfig.KVMEnabled, true)))
+	diags = append(diags, diag.FromErr(err)...)
+
+	// PVE enables the local time by default for Microsoft Windows guests.
+	localTime := vmConfig.OSType != nil && strings.HasPrefix(*vmConfig.OSType, "w")
+	err = d.Set(mkLocalTime, bool(ptr.Or(vmConfig.LocalTime, types.CustomBool(localTime))))
+	diags = append(diags, diag.FromErr(err)...)
+
 	currentBIOS := d.Get(mkBIOS).(string)
 
 	if len(clone) == 0 || currentBIOS != dvBIOS {
@@ -5722,7 +6999,8 @@ func vmReadPrimitiveValues(
 
 	currentTags := d.Get(mkTags).([]any)
 
-	err = d.Set(mkOnBoot, vmConfig.StartOnBoot)
+	// PVE omits onboot when it is disabled
+	err = d.Set(mkOnBoot, bool(ptr.Or(vmConfig.StartOnBoot, false)))
 	if err != nil {
 		diags = append(diags, diag.FromErr(err)...)
 	}
@@ -5833,18 +7111,9 @@ func vmReadPrimitiveValues(
 		diags = append(diags, diag.FromErr(err)...)
 	}
 
-	currentTemplate := d.Get(mkTemplate).(bool)
-
-	if len(clone) == 0 || currentTemplate {
-		if vmConfig.Template != nil {
-			err = d.Set(mkTemplate, bool(*vmConfig.Template))
-		} else {
-			// Default value of "template" is "0" according to the API documentation.
-			err = d.Set(mkTemplate, false)
-		}
-
-		diags = append(diags, diag.FromErr(err)...)
-	}
+	// Default value of "template" is "0" according to the API documentation.
+	err = d.Set(mkTemplate, bool(ptr.Or(vmConfig.Template, false)))
+	diags = append(diags, diag.FromErr(err)...)
 
 	return diags
 }
@@ -6140,7 +7409,7 @@ func vmUpdate(ctx context.Context, d *schema.ResourceData, m any) diag.Diagnosti
 		return diag.FromErr(e)
 	}
 
-	power := &vmPowerTracker{}
+	power := &vmPowerTracker{shutdownTimeout: vmGetShutdownTimeout(d, m)}
 
 	e = vmUpdatePool(ctx, d, client.Pool(), vmID)
 	if e != nil {
@@ -6169,7 +7438,9 @@ func vmUpdate(ctx context.Context, d *schema.ResourceData, m any) diag.Diagnosti
 		migrateCtx, cancel := context.WithTimeout(ctx, time.Duration(migrateTimeoutSec)*time.Second)
 		defer cancel()
 
-		if migrateDiags := migrateVM(migrateCtx, client, vmID, oldNodeName, nodeName); migrateDiags.HasError() {
+		migrationOptions := vmGetMigrationOptions(d)
+
+		if migrateDiags := migrateVM(migrateCtx, client, vmID, oldNodeName, nodeName, migrationOptions); migrateDiags.HasError() {
 			return migrateDiags
 		}
 
@@ -6197,7 +7468,12 @@ func vmUpdate(ctx context.Context, d *schema.ResourceData, m any) diag.Diagnosti
 
 	if d.HasChange(mkKVMArguments) {
 		kvmArguments := d.Get(mkKVMArguments).(string)
-		updateBody.KVMArguments = &kvmArguments
+		if kvmArguments != "" {
+			updateBody.KVMArguments = &kvmArguments
+		} else {
+			del = append(del, "args")
+		}
+
 		rebootRequired = true
 	}
 
@@ -6262,6 +7538,12 @@ func vmUpdate(ctx context.Context, d *schema.ResourceData, m any) diag.Diagnosti
 		rebootRequired = true
 	}
 
+	if d.HasChanges(mkAllowReboot, mkFreeze, mkKVM, mkLocalTime) {
+		vmSetFlags(d, updateBody)
+
+		rebootRequired = true
+	}
+
 	// Prepare the new agent configuration.
 	if d.HasChange(mkAgent) {
 		agentBlock, err := structure.GetSchemaBlock(
@@ -6325,56 +7607,33 @@ func vmUpdate(ctx context.Context, d *schema.ResourceData, m any) diag.Diagnosti
 			bootOrderConverted[i] = device.(string)
 		}
 
-		updateBody.Boot = &vms.CustomBoot{
-			Order: &bootOrderConverted,
+		if len(bootOrderConverted) == 0 {
+			del = append(del, "boot")
+		} else {
+			updateBody.Boot = &vms.CustomBoot{
+				Order: &bootOrderConverted,
+			}
 		}
+
 		rebootRequired = true
 	}
 
 	// Prepare the new CD-ROM configuration.
 
+	// Changing the media of an existing CD-ROM drive is applied to a running VM right away.
 	if d.HasChange(mkCDROM) {
-		cdromBlock, err := structure.GetSchemaBlock(
-			resource,
-			d,
-			[]string{mkCDROM},
-			0,
-			true,
-		)
-		if err != nil {
-			return diag.FromErr(err)
-		}
-
-		cdromFileID := cdromBlock[mkCDROMFileID].(string)
-		cdromInterface := cdromBlock[mkCDROMInterface].(string)
+		old, cdrom := d.GetChange(mkCDROM)
+		cdromDevices := vmGetCDROMDeviceObjects(cdrom.([]any))
 
-		old, _ := d.GetChange(mkCDROM)
-
-		if len(old.([]any)) > 0 && old.([]any)[0] != nil {
-			oldList := old.([]any)[0]
-			oldBlock := oldList.(map[string]any)
-
-			// If the interface is not set, use the default, for backward compatibility.
-			oldInterface, ok := oldBlock[mkCDROMInterface].(string)
-			if !ok || oldInterface == "" {
-				oldInterface = dvCDROMInterface
-			}
-
-			if oldInterface != cdromInterface {
+		for _, oldInterface := range vmCDROMInterfaces(old.([]any)) {
+			if _, ok := cdromDevices[oldInterface]; !ok {
 				del = append(del, oldInterface)
 			}
 		}
 
-		if cdromFileID == "" {
-			cdromFileID = "cdrom"
+		for iface, device := range cdromDevices {
+			updateBody.AddCustomStorageDevice(iface, *device)
 		}
-
-		cdromMedia := "cdrom"
-
-		updateBody.AddCustomStorageDevice(cdromInterface, vms.CustomStorageDevice{
-			FileVolume: cdromFileID,
-			Media:      &cdromMedia,
-		})
 	}
 
 	// Prepare the new CPU configuration.
@@ -6415,17 +7674,16 @@ func vmUpdate(ctx context.Context, d *schema.ResourceData, m any) diag.Diagnosti
 
 		// Only vcpus (hotplugged) changes are hotpluggable for CPU, and only when
 		// "cpu" is in the VM's hotplug setting. Changing cores or sockets always requires a reboot.
+		// CPU affinity, limit and units are applied to the cgroup of the running VM process,
+		// so they never require a reboot.
 		hotpluggedChanged := d.HasChange(mkCPU + ".0." + mkCPUHotplugged)
 		noOtherChanges := !d.HasChange(mkCPU+".0."+mkCPUCores) &&
 			!d.HasChange(mkCPU+".0."+mkCPUSockets) &&
 			cpuType == oldCPUType && cpuArchitecture == oldCPUArchitecture &&
 			bool(cpuNUMA) == oldCPUNUMA &&
-			!d.HasChange(mkCPU+".0."+mkCPUFlags) &&
-			!d.HasChange(mkCPU+".0."+mkCPUAffinity) &&
-			!d.HasChange(mkCPU+".0."+mkCPULimit) &&
-			!d.HasChange(mkCPU+".0."+mkCPUUnits)
+			!d.HasChange(mkCPU+".0."+mkCPUFlags)
 
-		onlyHotpluggableChange := hotpluggedChanged && noOtherChanges && isHotpluggable(d, "cpu")
+		onlyHotpluggableChange := noOtherChanges && (!hotpluggedChanged || isHotpluggable(d, "cpu"))
 
 		if err = setCPUArchitecture(ctx, cpuArchitecture, client, updateBody); err != nil {
 			return diag.FromErr(err)
@@ -6490,7 +7748,16 @@ func vmUpdate(ctx context.Context, d *schema.ResourceData, m any) diag.Diagnosti
 	// Compare the current API state (allDiskInfo) against the plan (planDisks) to detect
 	// removed disks. Devices present on the VM but absent from the plan are sent to the
 	// Proxmox API via the "delete" parameter. The same pattern is used for network devices.
+	// Only disks tracked in the prior state are considered, so disks attached outside of
+	// this resource (e.g. by proxmox_vm_disk) are left alone.
 	if d.HasChange(disk.MkDisk) {
+		stateDiskList, _ := d.GetChange(disk.MkDisk)
+
+		stateDisks, err := disk.GetDiskDeviceObjects(d, resource, stateDiskList.([]any))
+		if err != nil {
+			return diag.FromErr(err)
+		}
+
 		bootOrder := d.Get(mkBootOrder).([]any)
 
 		bootDeviceSet := make(map[string]struct{}, len(bootOrder))
@@ -6499,6 +7766,10 @@ func vmUpdate(ctx context.Context, d *schema.ResourceData, m any) diag.Diagnosti
 		}
 
 		for currentInterface, deviceInfo := range allDiskInfo {
+			if _, managed := stateDisks[currentInterface]; !managed {
+				continue
+			}
+
 			if _, present := planDisks[currentInterface]; !present {
 				// skip devices that are not managed by the disk block:
 				// CDROMs (managed by cdrom block), cloud-init drives (managed by initialization block)
@@ -6581,7 +7852,23 @@ func vmUpdate(ctx context.Context, d *schema.ResourceData, m any) diag.Diagnosti
 	if d.HasChange(mkRNG) {
 		rngDevice := vmGetRNGDevice(d)
 
-		updateBody.RNGDevice = rngDevice
+		if rngDevice == nil {
+			del = append(del, "rng0")
+		} else {
+			updateBody.RNGDevice = rngDevice
+		}
+
+		rebootRequired = true
+	}
+
+	if d.HasChange(mkSpiceEnhancements) {
+		spiceEnhancements := vmGetSpiceEnhancements(d)
+
+		if spiceEnhancements == nil {
+			del = append(del, "spice_enhancements")
+		} else {
+			updateBody.SpiceEnhancements = spiceEnhancements
+		}
 
 		rebootRequired = true
 	}
@@ -6596,6 +7883,15 @@ func vmUpdate(ctx context.Context, d *schema.ResourceData, m any) diag.Diagnosti
 
 		initialization := d.Get(mkInitialization).([]any)
 
+		// The block was removed, drop the cloud-init drive along with its settings.
+		if len(initialization) == 0 || initialization[0] == nil {
+			if existingInterface, _ := findExistingCloudInitDrive(vmConfig, vmID, ""); existingInterface != "" {
+				del = append(del, existingInterface)
+			}
+
+			del = append(del, vmCloudInitConfigDeletes(vmConfig)...)
+		}
+
 		if updateBody.CloudInitConfig != nil && len(initialization) > 0 && initialization[0] != nil {
 			var fileVolume string
 
@@ -6679,7 +7975,7 @@ func vmUpdate(ctx context.Context, d *schema.ResourceData, m any) diag.Diagnosti
 			// RebuildCloudInitDisk is called after the update to regenerate the ISO.
 		}
 
-		cloudInitRebuildRequired = true
+		cloudInitRebuildRequired = len(initialization) > 0 && initialization[0] != nil
 		rebootRequired = true
 	}
 
@@ -6727,6 +8023,10 @@ func vmUpdate(ctx context.Context, d *schema.ResourceData, m any) diag.Diagnosti
 			updateBody.USBDevices = usbDevices
 		}
 
+		if err := vmCheckHostUSBMappings(ctx, client, d.Get(mkNodeName).(string), usbDevices); err != nil {
+			return diag.FromErr(err)
+		}
+
 		del = vmAppendMissingDeviceDeletes(
 			del,
 			"usb",
@@ -6735,7 +8035,10 @@ func vmUpdate(ctx context.Context, d *schema.ResourceData, m any) diag.Diagnosti
 			preMigrationDeletePlan,
 		)
 
-		rebootRequired = true
+		// USB devices are plugged and unplugged live when USB hotplug is enabled
+		if !isHotpluggable(d, "usb") {
+			rebootRequired = true
+		}
 	}
 
 	// Prepare the new memory configuration.
@@ -6754,6 +8057,7 @@ func vmUpdate(ctx context.Context, d *schema.ResourceData, m any) diag.Diagnosti
 		memoryDedicated := memoryBlock[mkMemoryDedicated].(int)
 		memoryFloating := memoryBlock[mkMemoryFloating].(int)
 		memoryShared := memoryBlock[mkMemoryShared].(int)
+		memoryShares := memoryBlock[mkMemoryShares].(int)
 		memoryHugepages := memoryBlock[mkMemoryHugepages].(string)
 		memoryKeepHugepages := types.CustomBool(memoryBlock[mkMemoryKeepHugepages].(bool))
 
@@ -6768,20 +8072,29 @@ func vmUpdate(ctx context.Context, d *schema.ResourceData, m any) diag.Diagnosti
 		oldMemoryFloating := getIntFromBlock(oldMemoryBlock, mkMemoryFloating, 0)
 		oldMemoryShared := getIntFromBlock(oldMemoryBlock, mkMemoryShared, 0)
 
-		memoryIncreased := (memoryDedicated > oldMemoryDedicated) ||
-			(memoryFloating > oldMemoryFloating) ||
-			(memoryShared > oldMemoryShared)
-		noNonHotpluggableChanges := memoryDedicated >= oldMemoryDedicated &&
-			memoryFloating >= oldMemoryFloating &&
-			memoryShared >= oldMemoryShared &&
+		// PVE applies a new balloon target and shares to a running VM, only adding or
+		// removing the balloon device itself (floating = 0) requires a restart
+		balloonToggled := (memoryFloating == 0) != (oldMemoryFloating == 0)
+		sizeChanged := memoryDedicated != oldMemoryDedicated || memoryShared != oldMemoryShared
+		sizeIncreased := memoryDedicated >= oldMemoryDedicated && memoryShared >= oldMemoryShared
+		noNonHotpluggableChanges := !balloonToggled &&
 			!d.HasChange(mkMemory+".0."+mkMemoryHugepages) &&
 			!d.HasChange(mkMemory+".0."+mkMemoryKeepHugepages)
 
-		onlyHotpluggableChange := memoryIncreased && noNonHotpluggableChanges && isHotpluggable(d, "memory")
+		onlyHotpluggableChange := noNonHotpluggableChanges &&
+			(!sizeChanged || (sizeIncreased && isHotpluggable(d, "memory")))
 
 		updateBody.DedicatedMemory = &memoryDedicated
 		updateBody.FloatingMemory = &memoryFloating
 
+		if d.HasChange(mkMemory + ".0." + mkMemoryShares) {
+			if memoryShares != dvMemoryShares {
+				updateBody.FloatingMemoryShares = &memoryShares
+			} else {
+				del = append(del, "shares")
+			}
+		}
+
 		if memoryShared > 0 {
 			memorySharedName := fmt.Sprintf("vm-%d-ivshmem", vmID)
 
@@ -6818,6 +8131,8 @@ func vmUpdate(ctx context.Context, d *schema.ResourceData, m any) diag.Diagnosti
 	// devices present on the VM but absent from the plan are sent to the Proxmox
 	// API via the "delete" parameter (e.g. delete=net1,net2).
 
+	var networkUpdateWarnings diag.Diagnostics
+
 	if d.HasChange(network.MkNetworkDevice) {
 		updateBody.NetworkDevices, err = network.GetNetworkDeviceObjects(d)
 		if err != nil {
@@ -6836,14 +8151,31 @@ func vmUpdate(ctx context.Context, d *schema.ResourceData, m any) diag.Diagnosti
 			plannedDevices[fmt.Sprintf("net%d", i)] = struct{}{}
 		}
 
+		existingDevices := network.ExistingNetworkDeviceIndices(vmConfig)
+
 		// Delete any device that exists on the VM but is not in the plan.
-		for existingKey := range network.ExistingNetworkDeviceIndices(vmConfig) {
+		for existingKey := range existingDevices {
 			if _, present := plannedDevices[existingKey]; !present {
 				del = append(del, existingKey)
 			}
 		}
 
-		// Network devices can be hotplugged, no reboot required
+		// Network devices can be hotplugged, no reboot required unless network hotplug is disabled
+		// and interfaces are added or removed, in which case PVE keeps them pending until the next reboot.
+		if vmNetworkDevicesPlugged(existingDevices, updateBody.NetworkDevices) && !isHotpluggable(d, "network") {
+			rebootRequired = true
+
+			// the provider reboots the VM itself when allowed to, only warn when the change is left pending.
+			if d.Get(mkStarted).(bool) && !d.Get(mkTemplate).(bool) && !d.Get(mkRebootAfterUpdate).(bool) {
+				networkUpdateWarnings = append(networkUpdateWarnings, diag.Diagnostic{
+					Severity: diag.Warning,
+					Summary:  "network devices cannot be hotplugged",
+					Detail: fmt.Sprintf("The 'hotplug' option of VM %d does not include 'network', so added or "+
+						"removed network devices are only applied after a reboot. Add 'network' to 'hotplug' "+
+						"to plug and unplug network devices on a running VM.", vmID),
+				})
+			}
+		}
 	}
 
 	// Prepare the new operating system configuration.
@@ -6882,6 +8214,8 @@ func vmUpdate(ctx context.Context, d *schema.ResourceData, m any) diag.Diagnosti
 		if updateBody.SMBIOS == nil {
 			del = append(del, "smbios1")
 		}
+
+		rebootRequired = true
 	}
 
 	if d.HasChange(mkStartup) {
@@ -6996,8 +8330,6 @@ func vmUpdate(ctx context.Context, d *schema.ResourceData, m any) diag.Diagnosti
 			}
 
 			rebootRequired = false
-		} else if oldTemplate && !newTemplate {
-			return diag.Errorf("Cannot convert a template back to a regular VM")
 		}
 	}
 
@@ -7014,6 +8346,7 @@ func vmUpdate(ctx context.Context, d *schema.ResourceData, m any) diag.Diagnosti
 	var updateDiags diag.Diagnostics
 
 	updateDiags = append(updateDiags, diskUpdateWarnings...)
+	updateDiags = append(updateDiags, networkUpdateWarnings...)
 
 	diskChanges, diskDiags := vmPlanDiskLocationAndSizeChanges(ctx, vmAPI, d)
 
@@ -7132,6 +8465,7 @@ func vmPlanDiskLocationAndSizeChanges(
 	}
 
 	changes := &vmDiskLocationAndSizeChanges{}
+	bandwidthLimit := vmBandwidthLimit(vmGetMigrationOptions(d).bandwidthLimit)
 
 	diskOldEntries := map[string]*vms.CustomStorageDevice{}
 	diskNewEntries := map[string]*vms.CustomStorageDevice{}
@@ -7256,6 +8590,7 @@ func vmPlanDiskLocationAndSizeChanges(
 				changes.moveBodies = append(
 					changes.moveBodies,
 					&vms.MoveDiskRequestBody{
+						BandwidthLimit:     bandwidthLimit,
 						DeleteOriginalDisk: &deleteOriginalDisk,
 						Disk:               oldIface,
 						TargetStorage:      *diskNewEntries[oldIface].DatastoreID,
@@ -7417,14 +8752,10 @@ func vmRefreshPendingDiskConfig(
 }
 
 func vmDelete(ctx context.Context, d *schema.ResourceData, m any) diag.Diagnostics {
-	timeout := d.Get(mkTimeoutStopVM).(int)
-	shutdownTimeout := d.Get(mkTimeoutShutdownVM).(int)
+	// a shutdown that doesn't complete in time is followed by a forced stop
+	timeout := d.Get(mkTimeoutStopVM).(int) + vmGetShutdownTimeout(d, m)
 
-	if shutdownTimeout > timeout {
-		timeout = shutdownTimeout
-	}
-
-	power := &vmPowerTracker{}
+	power := &vmPowerTracker{shutdownTimeout: vmGetShutdownTimeout(d, m)}
 
 	// reset the default timeout for the delete operation
 	ctx = context.WithoutCancel(ctx)
@@ -7448,6 +8779,23 @@ func vmDelete(ctx context.Context, d *schema.ResourceData, m any) diag.Diagnosti
 
 	vmAPI := client.Node(nodeName).VM(vmID)
 
+	// Proxmox VE rejects the removal of a protected VM, clear the flag first when forced to.
+	if d.Get(mkProtection).(bool) {
+		if !config.ForceDelete() {
+			return diag.Errorf(
+				"VM %d has the protection flag set and cannot be deleted, set `protection = false` and apply "+
+					"the change before destroying it, or enable `force_delete` in the provider configuration",
+				vmID,
+			)
+		}
+
+		protection := types.CustomBool(false)
+
+		if e := vmAPI.UpdateVM(ctx, &vms.UpdateRequestBody{DeletionProtection: &protection}); e != nil {
+			return diag.Errorf("failed to clear the protection flag of VM %d: %s", vmID, e)
+		}
+	}
+
 	// Stop or shut down the virtual machine before deleting it.
 	status, err := vmAPI.GetVMStatus(ctx)
 	if err != nil {
@@ -7667,11 +9015,54 @@ func findPoolForVM(ctx context.Context, poolsAPI *pools.Client, vmID int) (strin
 	return "", nil
 }
 
+// vmMigrationOptions holds the user-provided options of the VM migrate endpoint.
+type vmMigrationOptions struct {
+	targetStorage  string
+	withLocalDisks bool
+	bandwidthLimit int
+}
+
+// vmDefaultMigrationOptions returns the migration options used when the migration block is not set.
+func vmDefaultMigrationOptions() vmMigrationOptions {
+	return vmMigrationOptions{withLocalDisks: dvMigrationWithLocalDisks}
+}
+
+// vmGetMigrationOptions reads the migration options from the resource data.
+func vmGetMigrationOptions(d *schema.ResourceData) vmMigrationOptions {
+	opts := vmDefaultMigrationOptions()
+
+	migration := d.Get(mkMigration).([]any)
+	if len(migration) > 0 && migration[0] != nil {
+		migrationBlock := migration[0].(map[string]any)
+
+		opts.targetStorage = migrationBlock[mkMigrationTargetStorage].(string)
+		opts.withLocalDisks = migrationBlock[mkMigrationWithLocalDisks].(bool)
+		opts.bandwidthLimit = migrationBlock[mkMigrationBandwidthLimit].(int)
+	}
+
+	return opts
+}
+
+// vmBandwidthLimit returns the bandwidth limit in KiB/s of a clone, migration or disk move, nil when unlimited.
+func vmBandwidthLimit(limit int) *int {
+	if limit <= 0 {
+		return nil
+	}
+
+	return &limit
+}
+
 // migrateVM migrates a VM to a new node, handling HA-managed VMs appropriately.
 // For running HA-managed VMs, it uses the HA migrate endpoint which properly sequences the migration.
 // For stopped HA-managed VMs, it temporarily removes from HA, migrates, then re-adds to HA
 // because Proxmox HA migration for stopped VMs only sets a preference without actually moving.
-func migrateVM(ctx context.Context, client proxmox.Client, vmID int, sourceNode, targetNode string) diag.Diagnostics {
+func migrateVM(
+	ctx context.Context,
+	client proxmox.Client,
+	vmID int,
+	sourceNode, targetNode string,
+	opts vmMigrationOptions,
+) diag.Diagnostics {
 	vmAPI := client.Node(sourceNode).VM(vmID)
 
 	migrationState, err := vmGetMigrationState(ctx, client, vmAPI, vmID)
@@ -7681,17 +9072,25 @@ func migrateVM(ctx context.Context, client proxmox.Client, vmID int, sourceNode,
 
 	// for running HA-managed VMs, use HA migration
 	if migrationState.isRunning && migrationState.isHAManaged {
+		// the HA manager migrates the VM on its own, the migrate endpoint options can't be passed to it
+		if opts != vmDefaultMigrationOptions() {
+			return diag.Errorf(
+				"VM %d is HA-managed and running, the %q options are not supported by the HA migration",
+				vmID, mkMigration,
+			)
+		}
+
 		return diag.FromErr(migrateHAVM(ctx, client, vmID, migrationState.haResourceID, targetNode))
 	}
 
 	// for stopped HA-managed VMs, temporarily remove from HA to allow standard migration
 	// (Proxmox intercepts standard migrate for HA VMs and only sets a preference)
 	if !migrationState.isRunning && migrationState.isHAManaged {
-		return migrateStoppedHAVM(ctx, client, vmID, migrationState.haResourceID, sourceNode, targetNode)
+		return migrateStoppedHAVM(ctx, client, vmID, migrationState.haResourceID, sourceNode, targetNode, opts)
 	}
 
 	// for non-HA VMs (running or stopped), use standard migration
-	return migrateNonHAVM(ctx, client, vmID, sourceNode, targetNode)
+	return migrateNonHAVM(ctx, client, vmID, sourceNode, targetNode, migrationState.isRunning, opts)
 }
 
 // migrateHAVM migrates an HA-managed VM using the HA resource migrate endpoint.
@@ -7740,6 +9139,7 @@ func migrateStoppedHAVM(
 	vmID int,
 	haResourceID types.HAResourceID,
 	sourceNode, targetNode string,
+	opts vmMigrationOptions,
 ) diag.Diagnostics {
 	tflog.Info(ctx, "migrating stopped HA-managed VM (temporarily removing from HA)", map[string]any{
 		"vm_id":       vmID,
@@ -7770,7 +9170,7 @@ func migrateStoppedHAVM(
 		"target_node": targetNode,
 	})
 
-	migrateDiags := migrateNonHAVM(ctx, client, vmID, sourceNode, targetNode)
+	migrateDiags := migrateNonHAVM(ctx, client, vmID, sourceNode, targetNode, false, opts)
 	if migrateDiags.HasError() {
 		// try to re-add to HA even if migration failed
 		if haErr := readdToHA(ctx, haClient, haResourceID, haConfig); haErr != nil {
@@ -7822,14 +9222,21 @@ func migrateNonHAVM(
 	client proxmox.Client,
 	vmID int,
 	sourceNode, targetNode string,
+	online bool,
+	opts vmMigrationOptions,
 ) diag.Diagnostics {
 	vmAPI := client.Node(sourceNode).VM(vmID)
 
-	trueValue := types.CustomBool(true)
+	// running VMs are live-migrated, stopped VMs are migrated offline
 	migrateBody := &vms.MigrateRequestBody{
+		BandwidthLimit:  vmBandwidthLimit(opts.bandwidthLimit),
 		TargetNode:      targetNode,
-		WithLocalDisks:  &trueValue,
-		OnlineMigration: &trueValue,
+		WithLocalDisks:  new(types.CustomBool(opts.withLocalDisks)),
+		OnlineMigration: new(types.CustomBool(online)),
+	}
+
+	if opts.targetStorage != "" {
+		migrateBody.TargetStorage = &opts.targetStorage
 	}
 
 	return sdkresource.TaskResultDiags(vmAPI.MigrateVM(ctx, migrateBody), "VM migrate")
@@ -7,11 +7,15 @@
 package resource
 
 import (
+	"net/url"
 	"testing"
 
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
 	"github.com/stretchr/testify/require"
 
+	"github.com/bpg/terraform-provider-proxmox/proxmox/nodes/vms"
+	proxmoxtypes "github.com/bpg/terraform-provider-proxmox/proxmox/types/hardwaremapping"
 	"github.com/bpg/terraform-provider-proxmox/proxmoxtf/resource/vm/disk"
 	"github.com/bpg/terraform-provider-proxmox/proxmoxtf/resource/vm/network"
 	"github.com/bpg/terraform-provider-proxmox/proxmoxtf/test"
@@ -169,6 +173,7 @@ func TestVMSchema(t *testing.T) {
 	test.AssertOptionalArguments(t, s, []string{
 		mkACPI,
 		mkAgent,
+		mkAllowReboot,
 		mkAudioDevice,
 		mkBIOS,
 		mkBootOrder,
@@ -178,17 +183,21 @@ func TestVMSchema(t *testing.T) {
 		mkDescription,
 		disk.MkDisk,
 		mkEFIDisk,
+		mkFreeze,
 		mkInitialization,
 		mkHostPCI,
 		mkHostUSB,
 		mkKeyboardLayout,
+		mkKVM,
 		mkKVMArguments,
+		mkLocalTime,
 		mkMachine,
 		mkMemory,
 		mkName,
 		network.MkNetworkDevice,
 		mkOperatingSystem,
 		mkPoolID,
+		mkRestore,
 		mkSerialDevice,
 		mkStarted,
 		mkTabletDevice,
@@ -201,6 +210,7 @@ func TestVMSchema(t *testing.T) {
 	test.AssertValueTypes(t, s, map[string]schema.ValueType{
 		mkACPI:            schema.TypeBool,
 		mkAgent:           schema.TypeList,
+		mkAllowReboot:     schema.TypeBool,
 		mkAudioDevice:     schema.TypeList,
 		mkBIOS:            schema.TypeString,
 		mkBootOrder:       schema.TypeList,
@@ -209,11 +219,14 @@ func TestVMSchema(t *testing.T) {
 		mkDescription:     schema.TypeString,
 		disk.MkDisk:       schema.TypeList,
 		mkEFIDisk:         schema.TypeList,
+		mkFreeze:          schema.TypeBool,
 		mkHostPCI:         schema.TypeList,
 		mkHostUSB:         schema.TypeList,
 		mkInitialization:  schema.TypeList,
 		mkKeyboardLayout:  schema.TypeString,
+		mkKVM:             schema.TypeBool,
 		mkKVMArguments:    schema.TypeString,
+		mkLocalTime:       schema.TypeBool,
 		mkMachine:         schema.TypeString,
 		mkMemory:          schema.TypeList,
 		mkName:            schema.TypeString,
@@ -274,14 +287,33 @@ func TestVMSchema(t *testing.T) {
 	})
 
 	test.AssertOptionalArguments(t, cloneSchema, []string{
+		mkCloneBandwidthLimit,
 		mkCloneDatastoreID,
 		mkCloneNodeName,
 	})
 
 	test.AssertValueTypes(t, cloneSchema, map[string]schema.ValueType{
-		mkCloneDatastoreID: schema.TypeString,
-		mkCloneNodeName:    schema.TypeString,
-		mkCloneVMID:        schema.TypeInt,
+		mkCloneBandwidthLimit: schema.TypeInt,
+		mkCloneDatastoreID:    schema.TypeString,
+		mkCloneNodeName:       schema.TypeString,
+		mkCloneVMID:           schema.TypeInt,
+	})
+
+	restoreSchema := test.AssertNestedSchemaExistence(t, s, mkRestore)
+
+	test.AssertRequiredArguments(t, restoreSchema, []string{
+		mkRestoreBackupFile,
+	})
+
+	test.AssertOptionalArguments(t, restoreSchema, []string{
+		mkRestoreDatastoreID,
+		mkRestoreUnique,
+	})
+
+	test.AssertValueTypes(t, restoreSchema, map[string]schema.ValueType{
+		mkRestoreBackupFile:  schema.TypeString,
+		mkRestoreDatastoreID: schema.TypeString,
+		mkRestoreUnique:      schema.TypeBool,
 	})
 
 	cpuSchema := test.AssertNestedSchemaExistence(t, s, mkCPU)
@@ -466,12 +498,14 @@ func TestVMSchema(t *testing.T) {
 		mkMemoryDedicated,
 		mkMemoryFloating,
 		mkMemoryShared,
+		mkMemoryShares,
 	})
 
 	test.AssertValueTypes(t, memorySchema, map[string]schema.ValueType{
 		mkMemoryDedicated: schema.TypeInt,
 		mkMemoryFloating:  schema.TypeInt,
 		mkMemoryShared:    schema.TypeInt,
+		mkMemoryShares:    schema.TypeInt,
 	})
 
 	numaSchema := test.AssertNestedSchemaExistence(t, s, mkNUMA)
@@ -583,6 +617,168 @@ func TestHotplugContains(t *testing.T) {
 	}
 }
 
+func TestVMNetworkDevicesPlugged(t *testing.T) {
+	t.Parallel()
+
+	existing := map[string]struct{}{"net0": {}, "net1": {}}
+
+	tests := []struct {
+		name    string
+		planned vms.CustomNetworkDevices
+		want    bool
+	}{
+		{"unchanged", vms.CustomNetworkDevices{{Enabled: true}, {Enabled: true}}, false},
+		{"device added", vms.CustomNetworkDevices{{Enabled: true}, {Enabled: true}, {Enabled: true}}, true},
+		{"device removed", vms.CustomNetworkDevices{{Enabled: true}}, true},
+		{"device disabled", vms.CustomNetworkDevices{{Enabled: true}, {Enabled: false}}, true},
+		{"all removed", nil, true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			got := vmNetworkDevicesPlugged(existing, tt.planned)
+			require.Equal(t, tt.want, got)
+		})
+	}
+}
+
+func TestVMCloudInitPasswordForState(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name    string
+		current string
+		fromAPI string
+		want    string
+	}{
+		{"plaintext is kept", "secret", MaskedPassword, "secret"},
+		{"pre-hashed is kept", "$6$salt$hash", MaskedPassword, "$6$salt$hash"},
+		{"import keeps placeholder", "", MaskedPassword, MaskedPassword},
+		{"placeholder stays placeholder", MaskedPassword, MaskedPassword, MaskedPassword},
+		{"unmasked API value wins", "secret", "other", "other"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			require.Equal(t, tt.want, vmCloudInitPasswordForState(tt.current, tt.fromAPI))
+		})
+	}
+}
+
+func TestVMCheckNUMACPUs(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name    string
+		cpuIDs  []string
+		vcpus   int
+		wantErr bool
+	}{
+		{"single node", []string{"0-3"}, 4, false},
+		{"two nodes", []string{"0-1", "2-3"}, 4, false},
+		{"split ranges", []string{"0;2", "1;3"}, 4, false},
+		{"overlap", []string{"0-2", "2-3"}, 4, true},
+		{"missing cpus", []string{"0-1"}, 4, true},
+		{"out of range", []string{"0-3", "4"}, 4, true},
+		{"reversed range", []string{"3-0"}, 4, true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			err := vmCheckNUMACPUs(tt.cpuIDs, tt.vcpus)
+			if tt.wantErr {
+				require.Error(t, err)
+			} else {
+				require.NoError(t, err)
+			}
+		})
+	}
+}
+
+// TestVMValidateNUMATopologyUnknownCPU verifies the NUMA topology is only checked during plan once the
+// CPU cores and sockets are known, e.g. not when they come from another resource that is not created yet.
+func TestVMValidateNUMATopologyUnknownCPU(t *testing.T) {
+	t.Parallel()
+
+	// the value Terraform uses for unknown values in the legacy SDK config representation
+	const unknown = "74D93920-ED26-11E3-AC10-0800200C9A66"
+
+	tests := []struct {
+		name    string
+		cores   any
+		wantErr bool
+	}{
+		{"known cores", 2, true},
+		{"unknown cores", unknown, false},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			config := terraform.NewResourceConfigRaw(map[string]any{
+				mkNodeName: "pve",
+				mkCPU: []any{
+					map[string]any{mkCPUCores: tt.cores},
+				},
+				mkNUMA: []any{
+					map[string]any{
+						mkNUMADevice: "numa0",
+						mkNUMACPUIDs: "0-3",
+						mkNUMAMemory: 1024,
+					},
+				},
+			})
+
+			// only run the NUMA check, the other CustomizeDiff functions need the raw config of a real plan
+			r := &schema.Resource{Schema: VM().Schema, CustomizeDiff: validateNUMATopology}
+
+			_, err := r.Diff(t.Context(), nil, config, nil)
+			if tt.wantErr {
+				require.ErrorContains(t, err, "NUMA")
+			} else {
+				require.NoError(t, err)
+			}
+		})
+	}
+}
+
+func TestVMCheckSerialConsole(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name          string
+		vgaType       string
+		serialDevices int
+		wantErr       bool
+	}{
+		{"not a serial console", "std", 0, false},
+		{"first serial device", "serial0", 1, false},
+		{"last serial device", "serial3", 4, false},
+		{"no serial device", "serial0", 0, true},
+		{"missing serial device", "serial2", 2, true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			err := vmCheckSerialConsole(tt.vgaType, tt.serialDevices)
+			if tt.wantErr {
+				require.Error(t, err)
+			} else {
+				require.NoError(t, err)
+			}
+		})
+	}
+}
+
 func Test_parseImportIDWIthNodeName(t *testing.T) {
 	t.Parallel()
 
@@ -615,3 +811,377 @@ func Test_parseImportIDWIthNodeName(t *testing.T) {
 		})
 	}
 }
+
+func TestFindExistingCDROMInterfaces(t *testing.T) {
+	t.Parallel()
+
+	cdrom := func(volume string) *vms.CustomStorageDevice {
+		return &vms.CustomStorageDevice{FileVolume: volume, Media: new("cdrom")}
+	}
+
+	tests := []struct {
+		name    string
+		devices vms.CustomStorageDevices
+		want    []string
+	}{
+		{"no drives", vms.CustomStorageDevices{}, []string{}},
+		{"single drive", vms.CustomStorageDevices{"ide3": cdrom("none")}, []string{"ide3"}},
+		{"multiple drives", vms.CustomStorageDevices{"sata1": cdrom("none"), "ide2": cdrom("local:iso/a.iso")}, []string{"ide2", "sata1"}},
+		{"cloud-init drive", vms.CustomStorageDevices{"ide0": cdrom("local-lvm:vm-100-cloudinit")}, []string{}},
+		{"disk only", vms.CustomStorageDevices{"scsi0": {FileVolume: "local-lvm:vm-100-disk-0"}}, []string{}},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			vmConfig := &vms.GetResponseData{StorageDevices: tt.devices}
+			require.ElementsMatch(t, tt.want, findExistingCDROMInterfaces(vmConfig, 100))
+		})
+	}
+}
+
+func TestVMCheckBootOrder(t *testing.T) {
+	t.Parallel()
+
+	devices := map[string]struct{}{"scsi0": {}, "ide2": {}, "net0": {}}
+
+	tests := []struct {
+		name      string
+		bootOrder []string
+		wantErr   bool
+	}{
+		{"configured devices", []string{"scsi0", "ide2", "net0"}, false},
+		{"unchecked devices", []string{"hostpci0", "usb1", "scsi0"}, false},
+		{"removed disk", []string{"scsi1", "net0"}, true},
+		{"missing network device", []string{"scsi0", "net1"}, true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			err := vmCheckBootOrder(tt.bootOrder, devices)
+			if tt.wantErr {
+				require.Error(t, err)
+			} else {
+				require.NoError(t, err)
+			}
+		})
+	}
+}
+
+func TestVMCheckMemoryFloating(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name      string
+		dedicated int
+		floating  int
+		wantErr   bool
+	}{
+		{"ballooning disabled", 2048, 0, false},
+		{"ballooning minimum", 2048, 1024, false},
+		{"equal to dedicated", 2048, 2048, false},
+		{"greater than dedicated", 2048, 4096, true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			err := vmCheckMemoryFloating(tt.dedicated, tt.floating)
+			if tt.wantErr {
+				require.Error(t, err)
+			} else {
+				require.NoError(t, err)
+			}
+		})
+	}
+}
+
+func TestVMCheckCDROMInterfaces(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name    string
+		cdrom   []string
+		disks   []string
+		wantErr bool
+	}{
+		{"no drives", nil, []string{"scsi0"}, false},
+		{"multiple drives", []string{"ide2", "ide3", "sata0"}, []string{"scsi0"}, false},
+		{"shared between drives", []string{"ide2", "ide2"}, []string{"scsi0"}, true},
+		{"used by a disk", []string{"ide3", "sata0"}, []string{"sata0"}, true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			err := vmCheckCDROMInterfaces(tt.cdrom, tt.disks)
+			if tt.wantErr {
+				require.Error(t, err)
+			} else {
+				require.NoError(t, err)
+			}
+		})
+	}
+}
+
+func TestVMCheckTemplateDiskSizes(t *testing.T) {
+	t.Parallel()
+
+	oldSizes := map[string]int{"scsi0": 8, "scsi1": 16}
+
+	require.NoError(t, vmCheckTemplateDiskSizes(oldSizes, map[string]int{"scsi0": 8, "scsi1": 16}))
+	require.NoError(t, vmCheckTemplateDiskSizes(oldSizes, map[string]int{"scsi0": 8, "virtio0": 32}))
+	require.Error(t, vmCheckTemplateDiskSizes(oldSizes, map[string]int{"scsi0": 10, "scsi1": 16}))
+}
+
+func TestVMCheckMemoryHugepages(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name      string
+		dedicated int
+		hugepages string
+		wantErr   bool
+	}{
+		{"disabled", 1023, "", false},
+		{"any", 1023, "any", false},
+		{"2 MiB pages", 2048, "2", false},
+		{"odd size with 2 MiB pages", 2047, "2", true},
+		{"1 GiB pages", 4096, "1024", false},
+		{"partial 1 GiB page", 4608, "1024", true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			err := vmCheckMemoryHugepages(tt.dedicated, tt.hugepages)
+			if tt.wantErr {
+				require.Error(t, err)
+			} else {
+				require.NoError(t, err)
+			}
+		})
+	}
+}
+
+func TestVMCheckCPULimit(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name    string
+		limit   float64
+		cores   int
+		sockets int
+		wantErr bool
+	}{
+		{"no limit", 0, 1, 1, false},
+		{"fractional limit", 0.5, 1, 1, false},
+		{"all cores", 4, 2, 2, false},
+		{"more than cores", 2.5, 2, 1, true},
+		{"more than sockets times cores", 5, 2, 2, true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			err := vmCheckCPULimit(tt.limit, tt.cores, tt.sockets)
+			if tt.wantErr {
+				require.Error(t, err)
+			} else {
+				require.NoError(t, err)
+			}
+		})
+	}
+}
+
+func TestVMCheckHostUSBDevice(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name       string
+		hasHost    bool
+		hasMapping bool
+		wantErr    bool
+	}{
+		{"host", true, false, false},
+		{"mapping", false, true, false},
+		{"both", true, true, true},
+		{"neither", false, false, true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			err := vmCheckHostUSBDevice(0, tt.hasHost, tt.hasMapping)
+			if tt.wantErr {
+				require.Error(t, err)
+			} else {
+				require.NoError(t, err)
+			}
+		})
+	}
+}
+
+func TestVMCheckHostUSBMappingNode(t *testing.T) {
+	t.Parallel()
+
+	deviceMaps := []proxmoxtypes.Map{{Node: "pve1"}, {Node: "pve2"}}
+
+	require.NoError(t, vmCheckHostUSBMappingNode("usbdisk", "pve2", deviceMaps))
+	require.Error(t, vmCheckHostUSBMappingNode("usbdisk", "pve3", deviceMaps))
+	require.Error(t, vmCheckHostUSBMappingNode("usbdisk", "pve1", nil))
+}
+
+func TestVMCheckCloudInitInterface(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name    string
+		iface   string
+		disks   []string
+		cdrom   []string
+		wantErr bool
+	}{
+		{"detected interface", "", []string{"ide2"}, []string{"ide3"}, false},
+		{"free interface", "ide0", []string{"scsi0"}, []string{"ide2"}, false},
+		{"used by a disk", "scsi0", []string{"scsi0"}, []string{"ide3"}, true},
+		{"used by a cdrom", "ide2", []string{"scsi0"}, []string{"ide3", "ide2"}, true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			err := vmCheckCloudInitInterface(tt.iface, tt.disks, tt.cdrom)
+			if tt.wantErr {
+				require.Error(t, err)
+			} else {
+				require.NoError(t, err)
+			}
+		})
+	}
+}
+
+func TestVMCloudInitConfigDeletes(t *testing.T) {
+	t.Parallel()
+
+	require.Empty(t, vmCloudInitConfigDeletes(&vms.GetResponseData{}))
+
+	vmConfig := &vms.GetResponseData{
+		CloudInitUsername:  new("ubuntu"),
+		CloudInitDNSServer: new("1.1.1.1"),
+		IPConfigs: vms.CustomCloudInitIPConfigMap{
+			"ipconfig0": &vms.CustomCloudInitIPConfig{},
+		},
+	}
+
+	require.Equal(t, []string{"ciuser", "ipconfig0", "nameserver"}, vmCloudInitConfigDeletes(vmConfig))
+}
+
+func TestVMBandwidthLimit(t *testing.T) {
+	t.Parallel()
+
+	require.Nil(t, vmBandwidthLimit(0))
+	require.Nil(t, vmBandwidthLimit(-1))
+	require.Equal(t, new(51200), vmBandwidthLimit(51200))
+
+	d := schema.TestResourceDataRaw(t, VM().Schema, map[string]any{
+		mkNodeName: "pve",
+		mkMigration: []any{
+			map[string]any{mkMigrationBandwidthLimit: 1024},
+		},
+	})
+
+	require.Equal(t, 1024, vmGetMigrationOptions(d).bandwidthLimit)
+}
+
+func TestVMGetSMBIOS(t *testing.T) {
+	t.Parallel()
+
+	d := schema.TestResourceDataRaw(t, VM().Schema, map[string]any{
+		mkNodeName: "pve",
+		mkSMBIOS: []any{
+			map[string]any{
+				mkSMBIOSSerial: "ABC-123",
+				mkSMBIOSSKU:    "sku-1",
+				mkSMBIOSUUID:   "5b0f2702-1b2c-4c1a-9a7e-3f4cbb7f7a11",
+			},
+		},
+	})
+
+	smbios := vmGetSMBIOS(d)
+	require.NotNil(t, smbios)
+	require.Equal(t, "QUJDLTEyMw==", *smbios.Serial)
+	require.Equal(t, "c2t1LTE=", *smbios.SKU)
+	require.Equal(t, "5b0f2702-1b2c-4c1a-9a7e-3f4cbb7f7a11", *smbios.UUID)
+	require.Nil(t, smbios.Family)
+}
+
+func TestVMGetSpiceEnhancements(t *testing.T) {
+	t.Parallel()
+
+	d := schema.TestResourceDataRaw(t, VM().Schema, map[string]any{
+		mkNodeName: "pve",
+	})
+
+	require.Nil(t, vmGetSpiceEnhancements(d))
+
+	d = schema.TestResourceDataRaw(t, VM().Schema, map[string]any{
+		mkNodeName: "pve",
+		mkSpiceEnhancements: []any{
+			map[string]any{
+				mkSpiceEnhancementsFolderSharing:  true,
+				mkSpiceEnhancementsVideoStreaming: "all",
+			},
+		},
+	})
+
+	spice := vmGetSpiceEnhancements(d)
+	require.NotNil(t, spice)
+	require.True(t, bool(*spice.FolderSharing))
+	require.Equal(t, "all", *spice.VideoStreaming)
+
+	v := url.Values{}
+	require.NoError(t, spice.EncodeValues("spice_enhancements", &v))
+	require.Equal(t, "foldersharing=1,videostreaming=all", v.Get("spice_enhancements"))
+}
+
+func TestVMDecodeSMBIOSValue(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name     string
+		value    *string
+		isBase64 bool
+		want     string
+		wantErr  bool
+	}{
+		{"unset", nil, true, "", false},
+		{"base64 encoded", new("QUJDLTEyMw=="), true, "ABC-123", false},
+		{"plain", new("ABC-123"), false, "ABC-123", false},
+		{"invalid base64", new("ABC-123"), true, "", true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			got, err := vmDecodeSMBIOSValue(tt.value, tt.isBase64, "")
+			if tt.wantErr {
+				require.Error(t, err)
+				return
+			}
+
+			require.NoError(t, err)
+			require.Equal(t, tt.want, got)
+		})
+	}
+}
@@ -0,0 +1,43 @@
+/*
+ * This Source Code Form is subject to the terms of the Mozilla Public
+ * License, v. 2.0. If a copy of the MPL was not distributed with this
+ * file, You can obtain one at https://mozilla.org/MPL/2.0/.
+ */
+
+package resource
+
+import (
+	"context"
+
+	"github.com/bpg/terraform-provider-proxmox/proxmox/cluster"
+	"github.com/bpg/terraform-provider-proxmox/proxmox/nodes/tasks"
+)
+
+// CreateWithVMID runs a VM or container create, clone or restore task with the given identifier.
+// When the identifier has been generated, the task is run again with a newly generated identifier
+// if it fails because the identifier has been taken in the meantime. An identifier set by the user
+// is never changed. It returns the identifier and the result of the last task.
+func CreateWithVMID(
+	ctx context.Context,
+	idGenerator cluster.IDGenerator,
+	vmID int,
+	generated bool,
+	create func(ctx context.Context, id int) tasks.TaskResult,
+) (int, tasks.TaskResult) {
+	if !generated {
+		return vmID, create(ctx, vmID)
+	}
+
+	var result tasks.TaskResult
+
+	vmID, err := idGenerator.CreateWithRetry(ctx, vmID, func(ctx context.Context, id int) error {
+		result = create(ctx, id)
+
+		return result.Err()
+	})
+	if err != nil {
+		result = tasks.TaskFailedWithWarnings(err, result.Warnings())
+	}
+
+	return vmID, result
+}
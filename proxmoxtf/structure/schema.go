@@ -14,6 +14,7 @@ import (
 
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 
+	"github.com/bpg/terraform-provider-proxmox/proxmox/types"
 	"github.com/bpg/terraform-provider-proxmox/utils"
 )
 
@@ -29,6 +30,17 @@ func MergeSchema(dst, src map[string]*schema.Schema) {
 	}
 }
 
+// GetConfiguredBool returns the value of an optional and computed boolean attribute,
+// or nil when the attribute is not set in the configuration.
+func GetConfiguredBool(d *schema.ResourceData, key string) *types.CustomBool {
+	v := d.GetRawConfig().GetAttr(key)
+	if v.IsNull() || !v.IsKnown() {
+		return nil
+	}
+
+	return types.CustomBool(v.True()).Pointer()
+}
+
 // GetSchemaBlock returns a map[string]interface{} of a nested resource by key(s) from a schema.ResourceData.
 func GetSchemaBlock(
 	r *schema.Resource,